@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"encoding/base64"
+	"expvar"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/grpcreflect"
@@ -15,6 +17,7 @@ import (
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/postgres"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/api/admin"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/api/auth"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/api/calendar"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/api/profile"
@@ -24,6 +27,7 @@ import (
 	baikalclient "github.com/jadwalapp/symmetrical-spoon/falak/pkg/baikal/client"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/email/emailer"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/email/template"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/admin/v1/adminv1connect"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/auth/v1/authv1connect"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/calendar/v1/calendarv1connect"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/profile/v1/profilev1connect"
@@ -34,9 +38,12 @@ import (
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/httpclient"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/httpj"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/interceptors"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/keyring"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/lokilogger"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/calendarsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/notificationsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/tokens"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/util"
@@ -58,6 +65,9 @@ import (
 	"github.com/ThreeDotsLabs/watermill-amqp/v3/pkg/amqp"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
+
+	// the final image is built from scratch so it has no timezone database
+	_ "time/tzdata"
 )
 
 const dbDriverName = "pgx"
@@ -128,6 +138,39 @@ func main() {
 	dbStore := store.New(dbConn)
 	// ======== DATABASE ========
 
+	// ======== KEYRING ========
+	var keyringImpl keyring.Keyring
+	switch config.KeyringProvider {
+	case string(keyring.Provider_File):
+		keyringImpl, err = keyring.NewFileKeyring(config.KeyringMasterKeysFile)
+	default:
+		keyringImpl, err = keyring.NewEnvKeyring(config.KeyringMasterKeys)
+	}
+	if err != nil {
+		log.Fatal().Msgf("cannot create keyring: %v", err)
+	}
+	// ======== KEYRING ========
+
+	// ======== ENCRYPTION SVC ========
+	legacyKeys := encryptionsvc.LegacyKeys{
+		CalDAVPassword:   config.CalDAVPasswordEncryptionKey,
+		WhatsappMessages: config.WhatsappMessagesEncryptionKey,
+	}
+	encryptionSvc := encryptionsvc.NewSvc(*dbStore, keyringImpl, legacyKeys)
+
+	legacyEncryptionCtx := context.Background()
+	legacyEncryptionCtx = log.Logger.WithContext(legacyEncryptionCtx)
+
+	// the legacy data is re-encrypted in the background so a large backlog of it does not hold up the boot, the
+	// encryption service decrypts the legacy values with the legacy keys until they are re-encrypted
+	go func() {
+		err := encryptionsvc.ReencryptLegacyData(legacyEncryptionCtx, *dbStore, encryptionSvc, legacyKeys)
+		if err != nil {
+			log.Ctx(legacyEncryptionCtx).Err(err).Msg("failed running encryptionsvc.ReencryptLegacyData")
+		}
+	}()
+	// ======== ENCRYPTION SVC ========
+
 	// ======== TOKENS ========
 	publicKey, err := tokens.ParseRSAPublicKey(config.JWTPublicKey)
 	if err != nil {
@@ -229,13 +272,63 @@ func main() {
 	// ======== LLM CLI ========
 
 	// ======== MSG ANALYZER ========
-	msgAnalyzer := wasappmsganalyzer.NewAnalyzer(&llmCli, config.OpenAiModelName)
+	promptRollout, err := wasappmsganalyzer.ParsePromptRollout(config.WasappAnalyzerPromptRollout)
+	if err != nil {
+		log.Fatal().Msgf("failed to parse the analyzer prompt rollout: %v", err)
+	}
+
+	redaction, err := wasappmsganalyzer.ParseRedaction(config.WasappRedaction)
+	if err != nil {
+		log.Fatal().Msgf("failed to parse the redaction: %v", err)
+	}
+
+	analysisRecorder := wasappmsgconsumer.NewAnalysisRecorder(*dbStore)
+	msgAnalyzerRegistry := wasappmsganalyzer.NewRegistry()
+	msgAnalyzerRegistry.SetRecorder(analysisRecorder)
+	msgAnalyzerRegistry.Register(wasappmsganalyzer.Backend_LLM, wasappmsganalyzer.NewRedactingAnalyzer(
+		wasappmsganalyzer.NewAnalyzer(&llmCli, config.OpenAiModelName, config.OpenAiStructuredOutputs, promptRollout),
+		redaction,
+	))
+	msgAnalyzerRegistry.Register(wasappmsganalyzer.Backend_Rules, wasappmsganalyzer.NewRulesAnalyzer())
+	msgAnalyzer, err := msgAnalyzerRegistry.Chain(
+		wasappmsganalyzer.ParseBackends(config.WasappAnalyzerBackends),
+		time.Duration(config.WasappAnalyzerTimeoutSeconds)*time.Second,
+	)
+	if err != nil {
+		log.Fatal().Msgf("failed to create the msg analyzer: %v", err)
+	}
+	msgSummarizer := wasappmsganalyzer.NewRecordingSummarizer(
+		wasappmsganalyzer.NewRedactingSummarizer(wasappmsganalyzer.NewSummarizer(&llmCli, config.OpenAiModelName), redaction),
+		analysisRecorder,
+	)
+
+	llmQuotaMode, err := wasappmsgconsumer.ParseQuotaMode(config.WasappLlmQuotaMode)
+	if err != nil {
+		log.Fatal().Msgf("failed to parse the LLM quota mode: %v", err)
+	}
+	degradedMsgAnalyzer, err := msgAnalyzerRegistry.Chain(
+		[]wasappmsganalyzer.Backend{wasappmsganalyzer.Backend_Rules},
+		time.Duration(config.WasappAnalyzerTimeoutSeconds)*time.Second,
+	)
+	if err != nil {
+		log.Fatal().Msgf("failed to create the degraded msg analyzer: %v", err)
+	}
+	llmQuota := wasappmsgconsumer.LlmQuota{
+		DailyTokens: config.WasappLlmDailyTokenQuota,
+		Mode:        llmQuotaMode,
+		EveryNth:    config.WasappLlmQuotaEveryNth,
+		Degraded:    degradedMsgAnalyzer,
+	}
 	// ======== MSG ANALYZER ========
 
 	// ======== CALENDAR SERVICE ========
 	calendarService := calendarsvc.NewSvc(config.BaikalHost, *dbStore)
 	// ======== CALENDAR SERVICE ========
 
+	// ======== PRAYER SERVICE ========
+	prayerService := prayersvc.NewSvc(*dbStore)
+	// ======== PRAYER SERVICE ========
+
 	// ======== WASAPP CALENDAR PRODUCER ========
 	wasappCalendarProducer := wasappcalendar.NewProducer(amqpPublisher, config.WasappCalendarEventsQueueName)
 	// ======== WASAPP CALENDAR PRODUCER ========
@@ -271,7 +364,7 @@ func main() {
 	calendarConsumerCtx := context.Background()
 	calendarConsumerCtx = log.Logger.WithContext(calendarConsumerCtx)
 
-	calendarConsumer := wasappcalendar.NewConsumer(amqpSubscriber, config.WasappCalendarEventsQueueName, *dbStore, calendarService, config.CalDAVPasswordEncryptionKey, notificationSvc)
+	calendarConsumer := wasappcalendar.NewConsumer(amqpSubscriber, config.WasappCalendarEventsQueueName, *dbStore, calendarService, encryptionSvc, notificationSvc, prayerService)
 	err = calendarConsumer.Start(calendarConsumerCtx)
 	if err != nil {
 		log.Fatal().Msgf("failed to start calendar consumer: %v", err)
@@ -288,8 +381,20 @@ func main() {
 		config.WasappMessagesQueueName,
 		*dbStore,
 		msgAnalyzer,
+		msgSummarizer,
+		wasappmsgconsumer.ChatWindow{
+			MaxMessages: config.WasappWindowMaxMessages,
+			MaxAge:      time.Duration(config.WasappWindowMaxHours) * time.Hour,
+		},
+		wasappmsgconsumer.ChatDebounce{
+			Quiet:    time.Duration(config.WasappDebounceQuietSeconds) * time.Second,
+			MaxDelay: time.Duration(config.WasappDebounceMaxDelaySeconds) * time.Second,
+		},
+		llmQuota,
 		wasappCalendarProducer,
-		config.WhatsappMessagesEncryptionKey,
+		encryptionSvc,
+		prayerService,
+		notificationSvc,
 	)
 	err = wasappConsumer.Start(wasappConsumerCtx)
 	if err != nil {
@@ -298,6 +403,30 @@ func main() {
 	defer wasappConsumer.Stop(wasappConsumerCtx)
 	// ======== WASAPP CONSUMER ========
 
+	// ======== WASAPP CHAT PRUNER ========
+	wasappPrunerCtx := context.Background()
+	wasappPrunerCtx = log.Logger.WithContext(wasappPrunerCtx)
+
+	wasappPruner := wasappmsgconsumer.NewPruner(*dbStore, time.Duration(config.WasappChatInactiveDays)*24*time.Hour)
+	err = wasappPruner.Start(wasappPrunerCtx)
+	if err != nil {
+		log.Fatal().Msgf("failed to start wasapp chat pruner: %v", err)
+	}
+	defer wasappPruner.Stop(wasappPrunerCtx)
+	// ======== WASAPP CHAT PRUNER ========
+
+	// ======== WASAPP MESSAGE RETENTION ========
+	wasappRetentionCtx := context.Background()
+	wasappRetentionCtx = log.Logger.WithContext(wasappRetentionCtx)
+
+	wasappRetentionPruner := wasappmsgconsumer.NewRetentionPruner(*dbStore, time.Duration(config.WasappMessageRetentionHours)*time.Hour)
+	err = wasappRetentionPruner.Start(wasappRetentionCtx)
+	if err != nil {
+		log.Fatal().Msgf("failed to start wasapp message retention pruner: %v", err)
+	}
+	defer wasappRetentionPruner.Stop(wasappRetentionCtx)
+	// ======== WASAPP MESSAGE RETENTION ========
+
 	// ======== PROTOVALIDATE ========
 	pv, err := protovalidate.New()
 	if err != nil {
@@ -311,7 +440,7 @@ func main() {
 	// ======== GEO LOCATION CLIENT ========
 
 	// ======== HTTPJ SERVICE ========
-	httpjRouter := httpj.NewRouter(*dbStore, config.CalDAVPasswordEncryptionKey, config.CaldavHost, config.IsProd)
+	httpjRouter := httpj.NewRouter(*dbStore, encryptionSvc, config.CaldavHost, config.IsProd, prayerService)
 	// ======== HTTPJ SERVICE ========
 
 	// ======== INTERCEPTORS ========
@@ -320,6 +449,10 @@ func main() {
 		interceptors.EnsureValidTokenInterceptor(tokens, apiMetadata),
 		interceptors.LangInterceptor(apiMetadata),
 	)
+	interceptorsForAdmin := connect.WithInterceptors(
+		interceptors.LoggingInterceptor(lokiClient),
+		interceptors.EnsureAdminKeyInterceptor(config.AdminApiKey),
+	)
 	// ======== INTERCEPTORS ========
 
 	// ======== SERVER ========
@@ -328,27 +461,39 @@ func main() {
 	mux.HandleFunc("/httpj", httpjRouter.HandleRoot)
 	mux.HandleFunc("/httpj/mobile-config/caldav", httpjRouter.HandleMobileConfigCaldav)
 	mux.HandleFunc("/httpj/mobile-config/webcal", httpjRouter.HandleMobileConfigWebcal)
+	mux.HandleFunc("/httpj/webcal/occasions", httpjRouter.HandleWebcalOccasions)
+	mux.HandleFunc("/httpj/webcal/prayer", httpjRouter.HandleWebcalPrayer)
+
+	// the counters published with expvar, like what the message retention purged
+	mux.Handle("/debug/vars", interceptors.EnsureAdminKeyHandler(config.AdminApiKey, expvar.Handler()))
 
 	reflector := grpcreflect.NewStaticReflector(
 		authv1connect.AuthServiceName,
 		profilev1connect.ProfileServiceName,
 		calendarv1connect.CalendarServiceName,
 		whatsappv1connect.WhatsappServiceName,
+		adminv1connect.AdminServiceName,
 	)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
 
-	authServer := auth.NewService(pv, *dbStore, tokens, emailerImpl, templates, apiMetadata, googleSvc, baikalCli, config.CalDAVPasswordEncryptionKey)
+	authServer := auth.NewService(pv, *dbStore, tokens, emailerImpl, templates, apiMetadata, googleSvc, baikalCli, encryptionSvc)
 	mux.Handle(authv1connect.NewAuthServiceHandler(authServer, interceptorsForServer))
 
-	profileServer := profile.NewService(pv, *dbStore, apiMetadata)
+	profileServer := profile.NewService(pv, *dbStore, apiMetadata, config.WasappLlmDailyTokenQuota, config.WasappMessageRetentionHours)
 	mux.Handle(profilev1connect.NewProfileServiceHandler(profileServer, interceptorsForServer))
 
-	calendarServer := calendar.NewService(pv, *dbStore, apiMetadata, geoLocClient, config.CalDAVPasswordEncryptionKey)
+	calendarServer := calendar.NewService(pv, *dbStore, apiMetadata, geoLocClient, encryptionSvc, calendarService, prayerService, config.FalakHost)
 	mux.Handle(calendarv1connect.NewCalendarServiceHandler(calendarServer, interceptorsForServer))
 
-	whatsappServer := whatsapp.NewService(pv, apiMetadata, wasappCli)
-	mux.Handle(whatsappv1connect.NewWhatsappServiceHandler(whatsappServer, interceptorsForServer))
+	whatsappServer := whatsapp.NewService(pv, *dbStore, apiMetadata, wasappCli, wasappCalendarProducer, encryptionSvc)
+	mux.Handle(whatsappv1connect.NewWhatsappServiceHandler(whatsappServer, interceptorsForServer, connect.WithReadMaxBytes(whatsapp.ReadMaxBytes)))
+
+	adminServer := admin.NewService(pv, *dbStore, admin.TokenPrices{
+		PromptPerMillion:     config.OpenAiPromptTokenPrice,
+		CompletionPerMillion: config.OpenAiCompletionTokenPrice,
+	}, encryptionSvc)
+	mux.Handle(adminv1connect.NewAdminServiceHandler(adminServer, interceptorsForAdmin))
 
 	addr := fmt.Sprintf("0.0.0.0:%s", config.Port)
 	log.Info().Msgf("listening on %s", addr)
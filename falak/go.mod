@@ -7,6 +7,7 @@ require (
 	connectrpc.com/connect v1.18.1
 	connectrpc.com/grpcreflect v1.3.0
 	github.com/Snawoot/go-http-digest-auth-client v1.1.3
+	github.com/ThreeDotsLabs/watermill v1.4.6
 	github.com/ThreeDotsLabs/watermill-amqp/v3 v3.0.1
 	github.com/bufbuild/protovalidate-go v0.9.3
 	github.com/domodwyer/mailyak v3.1.1+incompatible
@@ -18,7 +19,6 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.7.4
 	github.com/openai/openai-go v0.1.0-beta.10
-	github.com/rabbitmq/amqp091-go v1.10.0
 	github.com/resendlabs/resend-go v1.7.0
 	github.com/rs/zerolog v1.34.0
 	github.com/sideshow/apns2 v0.25.0
@@ -26,12 +26,12 @@ require (
 	golang.org/x/net v0.39.0
 	golang.org/x/term v0.31.0
 	google.golang.org/protobuf v1.36.6
+	gopkg.in/yaml.v3 v3.0.1
 	howett.net/plist v1.0.1
 )
 
 require (
 	cel.dev/expr v0.23.1 // indirect
-	github.com/ThreeDotsLabs/watermill v1.4.6 // indirect
 	github.com/antlr4-go/antlr/v4 v4.13.1 // indirect
 	github.com/cenkalti/backoff/v3 v3.2.2 // indirect
 	github.com/docker/docker v27.3.1+incompatible // indirect
@@ -51,6 +51,7 @@ require (
 	github.com/oklog/ulid v1.3.1 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.4 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
+	github.com/rabbitmq/amqp091-go v1.10.0 // indirect
 	github.com/sagikazarmark/locafero v0.9.0 // indirect
 	github.com/sourcegraph/conc v0.3.0 // indirect
 	github.com/spf13/afero v1.14.0 // indirect
@@ -72,5 +73,4 @@ require (
 	golang.org/x/text v0.24.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20250422160041-2d3770c4ea7f // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250422160041-2d3770c4ea7f // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
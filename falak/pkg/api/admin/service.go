@@ -0,0 +1,172 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/google/uuid"
+	adminv1 "github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/admin/v1"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/admin/v1/adminv1connect"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	internalError error = connect.NewError(connect.CodeInternal, errors.New("something went wrong"))
+)
+
+// TokenPrices are what the LLM charges in USD for a million tokens, they are used to estimate the cost of the usage
+type TokenPrices struct {
+	PromptPerMillion     float64
+	CompletionPerMillion float64
+}
+
+type service struct {
+	pv            protovalidate.Validator
+	store         store.Queries
+	tokenPrices   TokenPrices
+	encryptionSvc encryptionsvc.Svc
+}
+
+func (s *service) GetLlmUsage(ctx context.Context, r *connect.Request[adminv1.GetLlmUsageRequest]) (*connect.Response[adminv1.GetLlmUsageResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	fromDay, toDay, err := parseDayRange(r.Msg.FromDate, r.Msg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+
+	params := store.ListWasappLlmUsageParams{
+		FromDay: fromDay,
+		ToDay:   toDay,
+	}
+	if r.Msg.CustomerId != nil {
+		params.CustomerID = uuid.NullUUID{UUID: uuid.MustParse(*r.Msg.CustomerId), Valid: true}
+	}
+	usages, err := s.store.ListWasappLlmUsage(ctx, params)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running ListWasappLlmUsage")
+		return nil, internalError
+	}
+
+	resp := &adminv1.GetLlmUsageResponse{
+		Usages: make([]*adminv1.LlmUsage, len(usages)),
+	}
+	for idx, usage := range usages {
+		resp.Usages[idx] = s.llmUsageToProto(usage)
+		resp.TotalPromptTokens += usage.PromptTokens
+		resp.TotalCompletionTokens += usage.CompletionTokens
+		resp.TotalEstimatedCostUsd += resp.Usages[idx].EstimatedCostUsd
+	}
+
+	return &connect.Response[adminv1.GetLlmUsageResponse]{
+		Msg: resp,
+	}, nil
+}
+
+func (s *service) GetRetentionStats(ctx context.Context, r *connect.Request[adminv1.GetRetentionStatsRequest]) (*connect.Response[adminv1.GetRetentionStatsResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	fromDay, toDay, err := parseDayRange(r.Msg.FromDate, r.Msg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.store.ListWasappRetentionStats(ctx, store.ListWasappRetentionStatsParams{
+		FromDay: fromDay,
+		ToDay:   toDay,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running ListWasappRetentionStats")
+		return nil, internalError
+	}
+
+	resp := &adminv1.GetRetentionStatsResponse{
+		Stats: make([]*adminv1.RetentionStat, len(stats)),
+	}
+	for idx, stat := range stats {
+		resp.Stats[idx] = retentionStatToProto(stat)
+		resp.TotalMessagesPurged += stat.MessagesPurged
+		resp.TotalSummariesPurged += stat.SummariesPurged
+		resp.TotalChatsPurged += stat.ChatsPurged
+	}
+
+	return &connect.Response[adminv1.GetRetentionStatsResponse]{
+		Msg: resp,
+	}, nil
+}
+
+func (s *service) ShredCustomerData(ctx context.Context, r *connect.Request[adminv1.ShredCustomerDataRequest]) (*connect.Response[adminv1.ShredCustomerDataResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	customerID := uuid.MustParse(r.Msg.CustomerId)
+
+	// the data key goes first, so the data is unreadable even if deleting it fails
+	err := s.encryptionSvc.ShredCustomerKey(ctx, customerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running ShredCustomerKey")
+		return nil, internalError
+	}
+
+	err = s.store.DeleteChatsByCustomerId(ctx, customerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running DeleteChatsByCustomerId")
+		return nil, internalError
+	}
+
+	err = s.store.DeleteWasappChatImportsByCustomerId(ctx, customerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running DeleteWasappChatImportsByCustomerId")
+		return nil, internalError
+	}
+
+	// the events found in the chats and the failed analyses of them are kept in the clear, so they go too
+	err = s.store.DeleteDetectedEventsByCustomerId(ctx, customerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running DeleteDetectedEventsByCustomerId")
+		return nil, internalError
+	}
+
+	err = s.store.DeleteWasappChatEventsByCustomerId(ctx, customerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running DeleteWasappChatEventsByCustomerId")
+		return nil, internalError
+	}
+
+	err = s.store.DeleteWasappAnalysisFailuresByCustomerId(ctx, customerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running DeleteWasappAnalysisFailuresByCustomerId")
+		return nil, internalError
+	}
+
+	err = s.store.DeleteCalDavAccountByCustomerId(ctx, customerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running DeleteCalDavAccountByCustomerId")
+		return nil, internalError
+	}
+
+	return &connect.Response[adminv1.ShredCustomerDataResponse]{
+		Msg: &adminv1.ShredCustomerDataResponse{},
+	}, nil
+}
+
+func NewService(pv protovalidate.Validator, store store.Queries, tokenPrices TokenPrices, encryptionSvc encryptionsvc.Svc) adminv1connect.AdminServiceHandler {
+	return &service{
+		pv:            pv,
+		store:         store,
+		tokenPrices:   tokenPrices,
+		encryptionSvc: encryptionSvc,
+	}
+}
@@ -0,0 +1,59 @@
+package admin
+
+import (
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+
+	adminv1 "github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/admin/v1"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+)
+
+func (s *service) llmUsageToProto(usage store.ListWasappLlmUsageRow) *adminv1.LlmUsage {
+	var averageLatencyMs int64
+	if usage.Calls > 0 {
+		averageLatencyMs = usage.LatencyMs / int64(usage.Calls)
+	}
+
+	return &adminv1.LlmUsage{
+		CustomerId:       usage.CustomerID.String(),
+		CustomerEmail:    usage.Email,
+		Date:             usage.Day.Format(time.DateOnly),
+		Model:            usage.Model,
+		Calls:            usage.Calls,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		AverageLatencyMs: averageLatencyMs,
+		EstimatedCostUsd: s.tokenPrices.cost(usage.PromptTokens, usage.CompletionTokens),
+	}
+}
+
+func retentionStatToProto(stat store.WasappRetentionStat) *adminv1.RetentionStat {
+	return &adminv1.RetentionStat{
+		Date:            stat.Day.Format(time.DateOnly),
+		MessagesPurged:  stat.MessagesPurged,
+		SummariesPurged: stat.SummariesPurged,
+		ChatsPurged:     stat.ChatsPurged,
+	}
+}
+
+// parseDayRange parses the first and last days of a range given as YYYY-MM-DD, the error is one to return as is
+func parseDayRange(fromDate, toDate string) (time.Time, time.Time, error) {
+	fromDay, err := time.Parse(time.DateOnly, fromDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, connect.NewError(connect.CodeInvalidArgument, errors.New("from_date is not a valid date"))
+	}
+	toDay, err := time.Parse(time.DateOnly, toDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, connect.NewError(connect.CodeInvalidArgument, errors.New("to_date is not a valid date"))
+	}
+	if toDay.Before(fromDay) {
+		return time.Time{}, time.Time{}, connect.NewError(connect.CodeInvalidArgument, errors.New("to_date is before from_date"))
+	}
+	return fromDay, toDay, nil
+}
+
+func (p TokenPrices) cost(promptTokens, completionTokens int64) float64 {
+	return (float64(promptTokens)*p.PromptPerMillion + float64(completionTokens)*p.CompletionPerMillion) / 1_000_000
+}
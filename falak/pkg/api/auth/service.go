@@ -17,6 +17,7 @@ import (
 	authv1 "github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/auth/v1"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/auth/v1/authv1connect"
 	googlesvc "github.com/jadwalapp/symmetrical-spoon/falak/pkg/google"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/tokens"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/util"
@@ -36,15 +37,15 @@ var (
 )
 
 type service struct {
-	pv                          protovalidate.Validator
-	store                       store.Queries
-	tokens                      tokens.Tokens
-	emailer                     emailer.Emailer
-	templates                   template.Templates
-	apiMetadata                 apimetadata.ApiMetadata
-	googleSvc                   googlesvc.GoogleSvc
-	baikalCli                   baikalclient.Client
-	calDAVPasswordEncryptionKey string
+	pv            protovalidate.Validator
+	store         store.Queries
+	tokens        tokens.Tokens
+	emailer       emailer.Emailer
+	templates     template.Templates
+	apiMetadata   apimetadata.ApiMetadata
+	googleSvc     googlesvc.GoogleSvc
+	baikalCli     baikalclient.Client
+	encryptionSvc encryptionsvc.Svc
 }
 
 func (s *service) InitiateEmail(ctx context.Context, r *connect.Request[authv1.InitiateEmailRequest]) (*connect.Response[authv1.InitiateEmailResponse], error) {
@@ -172,6 +173,12 @@ func (s *service) CompleteEmail(ctx context.Context, r *connect.Request[authv1.C
 
 		randomPassword := uuid.New().String()
 
+		encryptedPassword, err := s.encryptionSvc.Encrypt(ctx, customer.ID, randomPassword)
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed running encryptionSvc.Encrypt")
+			return nil, internalError
+		}
+
 		_, err = s.baikalCli.CreateUser(ctx, &baikalclient.CreateUserRequest{
 			Username: customer.Email,
 			Email:    customer.Email,
@@ -183,11 +190,10 @@ func (s *service) CompleteEmail(ctx context.Context, r *connect.Request[authv1.C
 		}
 
 		_, err = s.store.CreateCalDavAccount(ctx, store.CreateCalDavAccountParams{
-			CustomerID:    customer.ID,
-			Email:         customer.Email,
-			Username:      customer.Email,
-			Password:      randomPassword,
-			EncryptionKey: s.calDAVPasswordEncryptionKey,
+			CustomerID: customer.ID,
+			Email:      customer.Email,
+			Username:   customer.Email,
+			Password:   encryptedPassword,
 		})
 		if err != nil {
 			log.Ctx(ctx).Err(err).Msg("failed running store.CreateCalDavAccount")
@@ -271,6 +277,12 @@ func (s *service) UseGoogle(ctx context.Context, r *connect.Request[authv1.UseGo
 	if isNewCustomer.Valid && isNewCustomer.Bool {
 		randomPassword := uuid.New().String()
 
+		encryptedPassword, err := s.encryptionSvc.Encrypt(ctx, customer.ID, randomPassword)
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed running encryptionSvc.Encrypt")
+			return nil, internalError
+		}
+
 		_, err = s.baikalCli.CreateUser(ctx, &baikalclient.CreateUserRequest{
 			Username: customer.Email,
 			Email:    customer.Email,
@@ -282,11 +294,10 @@ func (s *service) UseGoogle(ctx context.Context, r *connect.Request[authv1.UseGo
 		}
 
 		_, err = s.store.CreateCalDavAccount(ctx, store.CreateCalDavAccountParams{
-			CustomerID:    customer.ID,
-			Email:         customer.Email,
-			Username:      customer.Email,
-			Password:      randomPassword,
-			EncryptionKey: s.calDAVPasswordEncryptionKey,
+			CustomerID: customer.ID,
+			Email:      customer.Email,
+			Username:   customer.Email,
+			Password:   encryptedPassword,
 		})
 		if err != nil {
 			log.Ctx(ctx).Err(err).Msg("failed running store.CreateCalDavAccount")
@@ -393,16 +404,16 @@ func (s *service) RefreshTokens(ctx context.Context, r *connect.Request[authv1.R
 	}, nil
 }
 
-func NewService(pv protovalidate.Validator, store store.Queries, tokens tokens.Tokens, emailer emailer.Emailer, templates template.Templates, apiMetadata apimetadata.ApiMetadata, googleSvc googlesvc.GoogleSvc, baikalCli baikalclient.Client, calDAVPasswordEncryptionKey string) authv1connect.AuthServiceHandler {
+func NewService(pv protovalidate.Validator, store store.Queries, tokens tokens.Tokens, emailer emailer.Emailer, templates template.Templates, apiMetadata apimetadata.ApiMetadata, googleSvc googlesvc.GoogleSvc, baikalCli baikalclient.Client, encryptionSvc encryptionsvc.Svc) authv1connect.AuthServiceHandler {
 	return &service{
-		pv:                          pv,
-		store:                       store,
-		tokens:                      tokens,
-		emailer:                     emailer,
-		templates:                   templates,
-		apiMetadata:                 apiMetadata,
-		googleSvc:                   googleSvc,
-		baikalCli:                   baikalCli,
-		calDAVPasswordEncryptionKey: calDAVPasswordEncryptionKey,
+		pv:            pv,
+		store:         store,
+		tokens:        tokens,
+		emailer:       emailer,
+		templates:     templates,
+		apiMetadata:   apiMetadata,
+		googleSvc:     googleSvc,
+		baikalCli:     baikalCli,
+		encryptionSvc: encryptionSvc,
 	}
 }
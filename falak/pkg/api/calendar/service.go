@@ -2,8 +2,10 @@ package calendar
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/bufbuild/protovalidate-go"
@@ -11,6 +13,10 @@ import (
 	calendarv1 "github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/calendar/v1"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/calendar/v1/calendarv1connect"
 	geolocationclient "github.com/jadwalapp/symmetrical-spoon/falak/pkg/geolocation/client"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/occasions"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/calendarsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
 	"github.com/rs/zerolog/log"
 )
@@ -20,11 +26,14 @@ var (
 )
 
 type service struct {
-	pv                          protovalidate.Validator
-	store                       store.Queries
-	apiMetadata                 apimetadata.ApiMetadata
-	geoLocationClient           geolocationclient.Client
-	calDavPasswordEncryptionKey string
+	pv                protovalidate.Validator
+	store             store.Queries
+	apiMetadata       apimetadata.ApiMetadata
+	geoLocationClient geolocationclient.Client
+	encryptionSvc     encryptionsvc.Svc
+	calendarSvc       calendarsvc.Svc
+	prayerSvc         prayersvc.Svc
+	falakHost         string
 
 	calendarv1connect.UnimplementedCalendarServiceHandler
 }
@@ -47,19 +56,22 @@ func (s *service) GetCalDavAccount(ctx context.Context, r *connect.Request[calen
 		return nil, internalError
 	}
 
-	calDavAccount, err := s.store.GetCalDavAccountByCustomerId(ctx, store.GetCalDavAccountByCustomerIdParams{
-		CustomerID:    customer.ID,
-		EncryptionKey: s.calDavPasswordEncryptionKey,
-	})
+	calDavAccount, err := s.store.GetCalDavAccountByCustomerId(ctx, customer.ID)
 	if err != nil {
 		log.Ctx(ctx).Err(err).Msg("failed running GetCalDavAccountByCustomerId")
 		return nil, internalError
 	}
 
+	password, err := s.encryptionSvc.Decrypt(ctx, customer.ID, calDavAccount.Password)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running encryptionSvc.Decrypt")
+		return nil, internalError
+	}
+
 	return &connect.Response[calendarv1.GetCalDavAccountResponse]{
 		Msg: &calendarv1.GetCalDavAccountResponse{
 			Username: calDavAccount.Username,
-			Password: calDavAccount.DecryptedPassword,
+			Password: password,
 		},
 	}, nil
 }
@@ -70,8 +82,51 @@ func (s *service) SchedulePrayerTimes(ctx context.Context, r *connect.Request[ca
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	lang, ok := s.apiMetadata.GetLang(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetLang")
+		return nil, internalError
+	}
+
+	// customers who set their prayer settings get our own feed, it has Jumu'ah and follows their masjid's time
+	_, err := s.prayerSvc.GetCustomerSettings(ctx, tokenClaims.Payload.CustomerId)
+	switch {
+	case err == nil:
+		token, hashedToken, err := generateTokenWithHash()
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed running generateTokenWithHash")
+			return nil, internalError
+		}
+
+		_, err = s.store.CreateCalendarFeed(ctx, store.CreateCalendarFeedParams{
+			CustomerID: tokenClaims.Payload.CustomerId,
+			FeedType:   store.CalendarFeedTypePrayer,
+			TokenHash:  hashedToken,
+			Lang:       string(lang),
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed running CreateCalendarFeed")
+			return nil, internalError
+		}
+
+		return &connect.Response[calendarv1.SchedulePrayerTimesResponse]{
+			Msg: &calendarv1.SchedulePrayerTimesResponse{
+				IcalUrl: fmt.Sprintf("https://%s/httpj/webcal/prayer?s=%s", s.falakHost, token.String()),
+			},
+		}, nil
+	case errors.Is(err, prayersvc.ErrNoSettings):
+	default:
+		log.Ctx(ctx).Err(err).Msg("failed running GetCustomerSettings")
+		return nil, internalError
+	}
+
 	cfConnectingHeader := r.Header().Get("CF-Connecting-IP")
-	fmt.Println("headers: %v", r.Header())
 
 	geoResp, err := s.geoLocationClient.GetGeoLocationInfo(ctx, &geolocationclient.GetGeoLocationInfoRequest{
 		Ip: cfConnectingHeader,
@@ -80,7 +135,7 @@ func (s *service) SchedulePrayerTimes(ctx context.Context, r *connect.Request[ca
 		log.Ctx(ctx).Err(err).Msg("failed running GetGeoLocationInfo")
 		return nil, internalError
 	}
-	fmt.Println("geoResp.Country: %s", geoResp.Country)
+	log.Ctx(ctx).Debug().Str("country", geoResp.Country).Msg("got geo location info")
 	// icalUrl := fmt.Sprintf("https://prayerwebcal.dsultan.com/ics/%s_%s", geoResp.Country, geoResp.City)
 	icalUrl := fmt.Sprintf("https://prayerwebcal.dsultan.com/ics/%s_%s", "Saudi_Arabia", "Riyadh")
 
@@ -91,12 +146,265 @@ func (s *service) SchedulePrayerTimes(ctx context.Context, r *connect.Request[ca
 	}, nil
 }
 
-func NewService(pv protovalidate.Validator, store store.Queries, apiMetadata apimetadata.ApiMetadata, geoLocationClient geolocationclient.Client, calDAVPasswordEncryptionKey string) calendarv1connect.CalendarServiceHandler {
+func (s *service) UpdatePrayerSettings(ctx context.Context, r *connect.Request[calendarv1.UpdatePrayerSettingsRequest]) (*connect.Response[calendarv1.UpdatePrayerSettingsResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	if _, err := time.LoadLocation(r.Msg.Settings.Timezone); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid timezone")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid timezone"))
+	}
+
+	setting, err := s.store.UpsertPrayerSetting(ctx, store.UpsertPrayerSettingParams{
+		CustomerID:        tokenClaims.Payload.CustomerId,
+		Latitude:          r.Msg.Settings.Latitude,
+		Longitude:         r.Msg.Settings.Longitude,
+		Timezone:          r.Msg.Settings.Timezone,
+		CalculationMethod: string(calculationMethods[r.Msg.Settings.CalculationMethod]),
+		AsrJuristic:       string(asrJuristics[r.Msg.Settings.AsrJuristic]),
+		JumuahKhutbahTime: sql.NullString{
+			String: r.Msg.Settings.GetJumuahKhutbahTime(),
+			Valid:  r.Msg.Settings.JumuahKhutbahTime != nil,
+		},
+		JumuahLeaveByMinutes: sql.NullInt32{
+			Int32: r.Msg.Settings.GetJumuahLeaveByMinutes(),
+			Valid: r.Msg.Settings.JumuahLeaveByMinutes != nil,
+		},
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running UpsertPrayerSetting")
+		return nil, internalError
+	}
+
+	return &connect.Response[calendarv1.UpdatePrayerSettingsResponse]{
+		Msg: &calendarv1.UpdatePrayerSettingsResponse{
+			Settings: prayerSettingToProto(setting),
+		},
+	}, nil
+}
+
+func (s *service) GetPrayerSettings(ctx context.Context, r *connect.Request[calendarv1.GetPrayerSettingsRequest]) (*connect.Response[calendarv1.GetPrayerSettingsResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	setting, err := s.store.GetPrayerSettingByCustomerId(ctx, tokenClaims.Payload.CustomerId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("prayer settings are not set"))
+		}
+
+		log.Ctx(ctx).Err(err).Msg("failed running GetPrayerSettingByCustomerId")
+		return nil, internalError
+	}
+
+	return &connect.Response[calendarv1.GetPrayerSettingsResponse]{
+		Msg: &calendarv1.GetPrayerSettingsResponse{
+			Settings: prayerSettingToProto(setting),
+		},
+	}, nil
+}
+
+func (s *service) GetOccasionsFeed(ctx context.Context, r *connect.Request[calendarv1.GetOccasionsFeedRequest]) (*connect.Response[calendarv1.GetOccasionsFeedResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	lang, ok := s.apiMetadata.GetLang(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetLang")
+		return nil, internalError
+	}
+
+	token, hashedToken, err := generateTokenWithHash()
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running generateTokenWithHash")
+		return nil, internalError
+	}
+
+	// every subscription gets its own token, so the ones made before, on other devices too, keep working
+	_, err = s.store.CreateCalendarFeed(ctx, store.CreateCalendarFeedParams{
+		CustomerID: tokenClaims.Payload.CustomerId,
+		FeedType:   store.CalendarFeedTypeOccasions,
+		TokenHash:  hashedToken,
+		Lang:       string(lang),
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running CreateCalendarFeed")
+		return nil, internalError
+	}
+
+	return &connect.Response[calendarv1.GetOccasionsFeedResponse]{
+		Msg: &calendarv1.GetOccasionsFeedResponse{
+			WebcalUrl: fmt.Sprintf("webcal://%s/httpj/webcal/occasions?s=%s", s.falakHost, token.String()),
+		},
+	}, nil
+}
+
+func (s *service) SyncOccasionsCalendar(ctx context.Context, r *connect.Request[calendarv1.SyncOccasionsCalendarRequest]) (*connect.Response[calendarv1.SyncOccasionsCalendarResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+	customerID := tokenClaims.Payload.CustomerId
+
+	lang, ok := s.apiMetadata.GetLang(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetLang")
+		return nil, internalError
+	}
+
+	genReq := &occasions.GenerateRequest{
+		// a month back so the occasions that span days and already started are kept until they are over
+		From: time.Now().AddDate(0, -1, 0),
+		To:   time.Now().AddDate(1, 0, 0),
+		Lang: lang,
+	}
+	prayerSettings, err := s.prayerSvc.GetCustomerSettings(ctx, customerID)
+	switch {
+	case err == nil:
+		genReq.Timezone = prayerSettings.Location.Timezone
+		genReq.PrayerLocation = &prayerSettings.Location
+		genReq.PrayerConfig = prayerSettings.Config
+	case errors.Is(err, prayersvc.ErrNoSettings):
+		log.Ctx(ctx).Debug().Msg("customer has no prayer settings, syncing occasions without suhoor and iftar")
+	default:
+		log.Ctx(ctx).Err(err).Msg("failed running GetCustomerSettings")
+		return nil, internalError
+	}
+
+	hijriDateAnnotation := store.DefaultCustomerPreference().HijriDateAnnotation
+	preference, err := s.store.GetCustomerPreferenceByCustomerId(ctx, customerID)
+	if err == nil {
+		hijriDateAnnotation = preference.HijriDateAnnotation
+	} else if err != sql.ErrNoRows {
+		log.Ctx(ctx).Err(err).Msg("failed running GetCustomerPreferenceByCustomerId")
+		return nil, internalError
+	}
+
+	events, err := occasions.Generate(genReq)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running occasions.Generate")
+		return nil, internalError
+	}
+
+	calDavAccount, err := s.store.GetCalDavAccountByCustomerId(ctx, customerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running GetCalDavAccountByCustomerId")
+		return nil, internalError
+	}
+
+	password, err := s.encryptionSvc.Decrypt(ctx, customerID, calDavAccount.Password)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running encryptionSvc.Decrypt")
+		return nil, internalError
+	}
+
+	err = s.calendarSvc.InitCalendar(ctx, &calendarsvc.InitCalendarRequest{
+		CustomerID:  customerID,
+		Username:    calDavAccount.Username,
+		Password:    password,
+		PathSuffix:  occasions.CalendarPathSuffix,
+		DisplayName: occasions.CalendarName(lang),
+		Color:       occasions.CalendarColor,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running InitCalendar")
+		return nil, internalError
+	}
+
+	for _, event := range events {
+		err = s.calendarSvc.AddEvent(ctx, &calendarsvc.AddEventRequest{
+			CustomerID:  customerID,
+			PathSuffix:  occasions.CalendarPathSuffix,
+			Summary:     event.Title,
+			Description: event.Description,
+			StartTime:   event.Start,
+			EndTime:     event.End,
+			UID:         event.UID,
+			AllDay:      event.AllDay,
+			HijriDate:   hijriDateAnnotation != store.HijriDateAnnotationOff,
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).Str("uid", event.UID).Msg("failed running AddEvent")
+			return nil, internalError
+		}
+	}
+
+	// the occasions calendar only has the events generated here, so the ones that were not generated this time are
+	// either over for a month or no longer apply
+	generatedUIDs := make(map[string]bool, len(events))
+	for _, event := range events {
+		generatedUIDs[event.UID] = true
+	}
+	uids, err := s.calendarSvc.ListEventUIDs(ctx, &calendarsvc.ListEventUIDsRequest{
+		CustomerID: customerID,
+		PathSuffix: occasions.CalendarPathSuffix,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running ListEventUIDs")
+		return nil, internalError
+	}
+	for _, uid := range uids {
+		if generatedUIDs[uid] {
+			continue
+		}
+
+		err = s.calendarSvc.DeleteEvent(ctx, &calendarsvc.DeleteEventRequest{
+			CustomerID: customerID,
+			PathSuffix: occasions.CalendarPathSuffix,
+			UID:        uid,
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).Str("uid", uid).Msg("failed running DeleteEvent")
+			return nil, internalError
+		}
+	}
+
+	return &connect.Response[calendarv1.SyncOccasionsCalendarResponse]{
+		Msg: &calendarv1.SyncOccasionsCalendarResponse{
+			EventsCount: int32(len(events)),
+		},
+	}, nil
+}
+
+func NewService(pv protovalidate.Validator, store store.Queries, apiMetadata apimetadata.ApiMetadata, geoLocationClient geolocationclient.Client, encryptionSvc encryptionsvc.Svc, calendarSvc calendarsvc.Svc, prayerSvc prayersvc.Svc, falakHost string) calendarv1connect.CalendarServiceHandler {
 	return &service{
-		pv:                          pv,
-		store:                       store,
-		apiMetadata:                 apiMetadata,
-		geoLocationClient:           geoLocationClient,
-		calDavPasswordEncryptionKey: calDAVPasswordEncryptionKey,
+		pv:                pv,
+		store:             store,
+		apiMetadata:       apiMetadata,
+		geoLocationClient: geoLocationClient,
+		encryptionSvc:     encryptionSvc,
+		calendarSvc:       calendarSvc,
+		prayerSvc:         prayerSvc,
+		falakHost:         falakHost,
 	}
 }
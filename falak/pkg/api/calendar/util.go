@@ -1,7 +1,67 @@
 package calendar
 
-import "strings"
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	calendarv1 "github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/calendar/v1"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayer"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/util"
+)
 
 func replaceSpaces(input string) string {
 	return strings.ReplaceAll(input, " ", "_")
 }
+
+var calculationMethods = map[calendarv1.PrayerCalculationMethod]prayer.Method{
+	calendarv1.PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_UMM_AL_QURA:         prayer.Method_UmmAlQura,
+	calendarv1.PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_MUSLIM_WORLD_LEAGUE: prayer.Method_MuslimWorldLeague,
+	calendarv1.PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_ISNA:                prayer.Method_ISNA,
+	calendarv1.PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_EGYPT:               prayer.Method_Egypt,
+	calendarv1.PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_KARACHI:             prayer.Method_Karachi,
+	calendarv1.PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_KUWAIT:              prayer.Method_Kuwait,
+	calendarv1.PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_QATAR:               prayer.Method_Qatar,
+}
+
+var asrJuristics = map[calendarv1.AsrJuristic]prayer.AsrJuristic{
+	calendarv1.AsrJuristic_ASR_JURISTIC_SHAFI:  prayer.AsrJuristic_Shafi,
+	calendarv1.AsrJuristic_ASR_JURISTIC_HANAFI: prayer.AsrJuristic_Hanafi,
+}
+
+func prayerSettingToProto(setting store.PrayerSetting) *calendarv1.PrayerSettings {
+	res := &calendarv1.PrayerSettings{
+		Latitude:  setting.Latitude,
+		Longitude: setting.Longitude,
+		Timezone:  setting.Timezone,
+
+		JumuahLeaveByMinutes: &setting.JumuahLeaveByMinutes,
+	}
+	if setting.JumuahKhutbahTime.Valid {
+		res.JumuahKhutbahTime = &setting.JumuahKhutbahTime.String
+	}
+	for k, v := range calculationMethods {
+		if string(v) == setting.CalculationMethod {
+			res.CalculationMethod = k
+		}
+	}
+	for k, v := range asrJuristics {
+		if string(v) == setting.AsrJuristic {
+			res.AsrJuristic = k
+		}
+	}
+	return res
+}
+
+func generateTokenWithHash() (uuid.UUID, string, error) {
+	token, err := uuid.NewRandom()
+	if err != nil {
+		return uuid.UUID{}, "", errors.Join(err, errors.New("failed to generate a random uuid"))
+	}
+
+	hashedToken := util.HashStringToBase64SHA256(token.String())
+
+	return token, hashedToken, nil
+}
@@ -2,6 +2,7 @@ package profile
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
 	"connectrpc.com/connect"
@@ -21,6 +22,11 @@ type service struct {
 	pv          protovalidate.Validator
 	store       store.Queries
 	apiMetadata apimetadata.ApiMetadata
+	// dailyTokenQuota is the LLM tokens a customer can use in a day before their messages are analyzed in a cheaper
+	// way, there is no quota when it is not positive
+	dailyTokenQuota int64
+	// defaultMessageRetentionHours is how long WhatsApp messages are kept for customers who did not pick it
+	defaultMessageRetentionHours int32
 }
 
 func (s *service) GetProfile(ctx context.Context, r *connect.Request[profilev1.GetProfileRequest]) (*connect.Response[profilev1.GetProfileResponse], error) {
@@ -41,10 +47,33 @@ func (s *service) GetProfile(ctx context.Context, r *connect.Request[profilev1.G
 		return nil, internalError
 	}
 
+	tokensToday, err := s.store.GetWasappLlmTokensForDays(ctx, store.GetWasappLlmTokensForDaysParams{
+		CustomerID: customer.ID,
+		Days:       1,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running GetWasappLlmTokensForDays for today")
+		return nil, internalError
+	}
+
+	tokensLast30Days, err := s.store.GetWasappLlmTokensForDays(ctx, store.GetWasappLlmTokensForDaysParams{
+		CustomerID: customer.ID,
+		Days:       30,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running GetWasappLlmTokensForDays for the last 30 days")
+		return nil, internalError
+	}
+
 	return &connect.Response[profilev1.GetProfileResponse]{
 		Msg: &profilev1.GetProfileResponse{
 			Name:  customer.Name,
 			Email: customer.Email,
+			LlmUsage: &profilev1.LlmUsage{
+				TokensToday:       tokensToday,
+				DailyTokenQuota:   max(s.dailyTokenQuota, 0),
+				TokensLast_30Days: tokensLast30Days,
+			},
 		},
 	}, nil
 }
@@ -73,10 +102,92 @@ func (s *service) AddDevice(ctx context.Context, r *connect.Request[profilev1.Ad
 	return &connect.Response[profilev1.AddDeviceResponse]{}, nil
 }
 
-func NewService(pv protovalidate.Validator, store store.Queries, apiMetadata apimetadata.ApiMetadata) profilev1connect.ProfileServiceHandler {
+func (s *service) GetPreferences(ctx context.Context, r *connect.Request[profilev1.GetPreferencesRequest]) (*connect.Response[profilev1.GetPreferencesResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	preference, err := s.store.GetCustomerPreferenceByCustomerId(ctx, tokenClaims.Payload.CustomerId)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Ctx(ctx).Err(err).Msg("failed running GetCustomerPreferenceByCustomerId")
+			return nil, internalError
+		}
+		preference = store.DefaultCustomerPreference()
+	}
+
+	return &connect.Response[profilev1.GetPreferencesResponse]{
+		Msg: &profilev1.GetPreferencesResponse{
+			Preferences:                  preferenceToProto(preference),
+			DefaultMessageRetentionHours: s.defaultMessageRetentionHours,
+		},
+	}, nil
+}
+
+func (s *service) UpdatePreferences(ctx context.Context, r *connect.Request[profilev1.UpdatePreferencesRequest]) (*connect.Response[profilev1.UpdatePreferencesResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	params := store.UpsertCustomerPreferenceParams{
+		CustomerID: tokenClaims.Payload.CustomerId,
+	}
+	if r.Msg.Preferences.HijriDateAnnotation != nil {
+		params.HijriDateAnnotation = store.NullHijriDateAnnotation{HijriDateAnnotation: hijriDateAnnotations[*r.Msg.Preferences.HijriDateAnnotation], Valid: true}
+	}
+	if r.Msg.Preferences.PrayerConflictMode != nil {
+		params.PrayerConflictMode = store.NullPrayerConflictMode{PrayerConflictMode: prayerConflictModes[*r.Msg.Preferences.PrayerConflictMode], Valid: true}
+	}
+	if r.Msg.Preferences.EventApprovalMode != nil {
+		params.EventApprovalMode = store.NullEventApprovalMode{EventApprovalMode: eventApprovalModes[*r.Msg.Preferences.EventApprovalMode], Valid: true}
+	}
+	if r.Msg.Preferences.TentativeHolds != nil {
+		params.TentativeHolds = sql.NullBool{Bool: *r.Msg.Preferences.TentativeHolds, Valid: true}
+	}
+	if r.Msg.Preferences.GroupAgreementRule != nil {
+		params.GroupAgreementRule = store.NullGroupAgreementRule{GroupAgreementRule: groupAgreementRules[*r.Msg.Preferences.GroupAgreementRule], Valid: true}
+	}
+	if r.Msg.Preferences.MessageRetentionHours != nil {
+		if r.Msg.ResetMessageRetentionHours {
+			log.Ctx(ctx).Error().Msg("message retention hours were set and reset together")
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("message_retention_hours can not be set along with reset_message_retention_hours"))
+		}
+		params.WasappMessageRetentionHours = sql.NullInt32{Int32: *r.Msg.Preferences.MessageRetentionHours, Valid: true}
+	}
+	params.ResetWasappMessageRetentionHours = r.Msg.ResetMessageRetentionHours
+	preference, err := s.store.UpsertCustomerPreference(ctx, params)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running UpsertCustomerPreference")
+		return nil, internalError
+	}
+
+	return &connect.Response[profilev1.UpdatePreferencesResponse]{
+		Msg: &profilev1.UpdatePreferencesResponse{
+			Preferences: preferenceToProto(preference),
+		},
+	}, nil
+}
+
+func NewService(pv protovalidate.Validator, store store.Queries, apiMetadata apimetadata.ApiMetadata, dailyTokenQuota int64, defaultMessageRetentionHours int) profilev1connect.ProfileServiceHandler {
 	return &service{
-		pv:          pv,
-		store:       store,
-		apiMetadata: apiMetadata,
+		pv:                           pv,
+		store:                        store,
+		apiMetadata:                  apiMetadata,
+		dailyTokenQuota:              dailyTokenQuota,
+		defaultMessageRetentionHours: defaultMessageRetention(defaultMessageRetentionHours),
 	}
 }
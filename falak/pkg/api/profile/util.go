@@ -0,0 +1,69 @@
+package profile
+
+import (
+	profilev1 "github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/profile/v1"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+)
+
+var hijriDateAnnotations = map[profilev1.HijriDateAnnotation]store.HijriDateAnnotation{
+	profilev1.HijriDateAnnotation_HIJRI_DATE_ANNOTATION_OFF:     store.HijriDateAnnotationOff,
+	profilev1.HijriDateAnnotation_HIJRI_DATE_ANNOTATION_ARABIC:  store.HijriDateAnnotationArabic,
+	profilev1.HijriDateAnnotation_HIJRI_DATE_ANNOTATION_ENGLISH: store.HijriDateAnnotationEnglish,
+}
+
+var prayerConflictModes = map[profilev1.PrayerConflictMode]store.PrayerConflictMode{
+	profilev1.PrayerConflictMode_PRAYER_CONFLICT_MODE_OFF:    store.PrayerConflictModeOff,
+	profilev1.PrayerConflictMode_PRAYER_CONFLICT_MODE_WARN:   store.PrayerConflictModeWarn,
+	profilev1.PrayerConflictMode_PRAYER_CONFLICT_MODE_BUFFER: store.PrayerConflictModeBuffer,
+	profilev1.PrayerConflictMode_PRAYER_CONFLICT_MODE_SHIFT:  store.PrayerConflictModeShift,
+}
+
+var eventApprovalModes = map[profilev1.EventApprovalMode]store.EventApprovalMode{
+	profilev1.EventApprovalMode_EVENT_APPROVAL_MODE_AUTO: store.EventApprovalModeAuto,
+	profilev1.EventApprovalMode_EVENT_APPROVAL_MODE_ASK:  store.EventApprovalModeAsk,
+}
+
+var groupAgreementRules = map[profilev1.GroupAgreementRule]store.GroupAgreementRule{
+	profilev1.GroupAgreementRule_GROUP_AGREEMENT_RULE_CUSTOMER:  store.GroupAgreementRuleCustomer,
+	profilev1.GroupAgreementRule_GROUP_AGREEMENT_RULE_MAJORITY:  store.GroupAgreementRuleMajority,
+	profilev1.GroupAgreementRule_GROUP_AGREEMENT_RULE_ORGANIZER: store.GroupAgreementRuleOrganizer,
+}
+
+// defaultMessageRetention returns the configured default retention of WhatsApp messages, or the store's default when
+// none is configured
+func defaultMessageRetention(configuredHours int) int32 {
+	if configuredHours <= 0 {
+		return store.DefaultWasappMessageRetentionHours
+	}
+	return int32(configuredHours)
+}
+
+func preferenceToProto(preference store.CustomerPreference) *profilev1.Preferences {
+	res := &profilev1.Preferences{
+		TentativeHolds: &preference.TentativeHolds,
+	}
+	if preference.WasappMessageRetentionHours.Valid {
+		res.MessageRetentionHours = &preference.WasappMessageRetentionHours.Int32
+	}
+	for k, v := range hijriDateAnnotations {
+		if v == preference.HijriDateAnnotation {
+			res.HijriDateAnnotation = k.Enum()
+		}
+	}
+	for k, v := range prayerConflictModes {
+		if v == preference.PrayerConflictMode {
+			res.PrayerConflictMode = k.Enum()
+		}
+	}
+	for k, v := range eventApprovalModes {
+		if v == preference.EventApprovalMode {
+			res.EventApprovalMode = k.Enum()
+		}
+	}
+	for k, v := range groupAgreementRules {
+		if v == preference.GroupAgreementRule {
+			res.GroupAgreementRule = k.Enum()
+		}
+	}
+	return res
+}
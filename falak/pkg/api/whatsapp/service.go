@@ -2,14 +2,23 @@ package whatsapp
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/bufbuild/protovalidate-go"
+	"github.com/google/uuid"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/apimetadata"
 	whatsappv1 "github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/whatsapp/v1"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/whatsapp/v1/whatsappv1connect"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
+	wasappchatexport "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/chatexport"
 	wasappclient "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/client"
+	wasappmsgconsumer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msgconsumer"
 	"github.com/rs/zerolog/log"
 )
 
@@ -19,8 +28,12 @@ var (
 
 type service struct {
 	pv          protovalidate.Validator
+	store       store.Queries
 	apiMetadata apimetadata.ApiMetadata
 	wasappCli   wasappclient.Client
+
+	calendarProducer wasappcalendar.Producer
+	encryptionSvc    encryptionsvc.Svc
 }
 
 func (s *service) ConnectWhatsappAccount(ctx context.Context, r *connect.Request[whatsappv1.ConnectWhatsappAccountRequest]) (*connect.Response[whatsappv1.ConnectWhatsappAccountResponse], error) {
@@ -118,10 +131,596 @@ func (s *service) GetWhatsappAccount(ctx context.Context, r *connect.Request[wha
 	}, nil
 }
 
-func NewService(pv protovalidate.Validator, apiMetadata apimetadata.ApiMetadata, wasappCli wasappclient.Client) whatsappv1connect.WhatsappServiceHandler {
+func (s *service) ListDetectedEvents(ctx context.Context, r *connect.Request[whatsappv1.ListDetectedEventsRequest]) (*connect.Response[whatsappv1.ListDetectedEventsResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	err := s.store.ExpirePendingDetectedEvents(ctx, tokenClaims.Payload.CustomerId)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running ExpirePendingDetectedEvents")
+		return nil, internalError
+	}
+
+	limit := r.Msg.Limit
+	if limit == 0 {
+		limit = defaultDetectedEventsLimit
+	}
+
+	detectedEvents, err := s.store.ListDetectedEventsByCustomerId(ctx, store.ListDetectedEventsByCustomerIdParams{
+		CustomerID: tokenClaims.Payload.CustomerId,
+		Limit:      limit,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running ListDetectedEventsByCustomerId")
+		return nil, internalError
+	}
+
+	events := make([]*whatsappv1.DetectedEvent, len(detectedEvents))
+	for idx, detectedEvent := range detectedEvents {
+		events[idx] = detectedEventToProto(detectedEvent)
+	}
+
+	return &connect.Response[whatsappv1.ListDetectedEventsResponse]{
+		Msg: &whatsappv1.ListDetectedEventsResponse{
+			Events: events,
+		},
+	}, nil
+}
+
+func (s *service) ConfirmDetectedEvent(ctx context.Context, r *connect.Request[whatsappv1.ConfirmDetectedEventRequest]) (*connect.Response[whatsappv1.ConfirmDetectedEventResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	detectedEvent, err := s.getPendingDetectedEvent(ctx, tokenClaims.Payload.CustomerId, r.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.confirmDetectedEvent(ctx, &detectedEvent)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running confirmDetectedEvent")
+		return nil, internalError
+	}
+
+	return &connect.Response[whatsappv1.ConfirmDetectedEventResponse]{
+		Msg: &whatsappv1.ConfirmDetectedEventResponse{
+			Event: detectedEventToProto(detectedEvent),
+		},
+	}, nil
+}
+
+func (s *service) RejectDetectedEvent(ctx context.Context, r *connect.Request[whatsappv1.RejectDetectedEventRequest]) (*connect.Response[whatsappv1.RejectDetectedEventResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	detectedEvent, err := s.getPendingDetectedEvent(ctx, tokenClaims.Payload.CustomerId, r.Msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.rejectDetectedEvent(ctx, &detectedEvent)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running rejectDetectedEvent")
+		return nil, internalError
+	}
+
+	return &connect.Response[whatsappv1.RejectDetectedEventResponse]{
+		Msg: &whatsappv1.RejectDetectedEventResponse{
+			Event: detectedEventToProto(detectedEvent),
+		},
+	}, nil
+}
+
+func (s *service) ListChats(ctx context.Context, r *connect.Request[whatsappv1.ListChatsRequest]) (*connect.Response[whatsappv1.ListChatsResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	mode, err := s.monitoringMode(ctx, tokenClaims.Payload.CustomerId)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running monitoringMode")
+		return nil, internalError
+	}
+
+	limit := r.Msg.Limit
+	if limit == 0 {
+		limit = defaultChatsLimit
+	}
+
+	chatSettings, err := s.store.ListWasappChatSettings(ctx, store.ListWasappChatSettingsParams{
+		CustomerID: tokenClaims.Payload.CustomerId,
+		Limit:      limit,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running ListWasappChatSettings")
+		return nil, internalError
+	}
+
+	chats := make([]*whatsappv1.Chat, len(chatSettings))
+	for idx, chatSetting := range chatSettings {
+		chats[idx] = chatSettingToProto(chatSetting, mode)
+	}
+
+	return &connect.Response[whatsappv1.ListChatsResponse]{
+		Msg: &whatsappv1.ListChatsResponse{
+			Chats:                 chats,
+			DefaultMonitoringMode: monitoringModesToProto[mode],
+		},
+	}, nil
+}
+
+func (s *service) SetChatMonitoring(ctx context.Context, r *connect.Request[whatsappv1.SetChatMonitoringRequest]) (*connect.Response[whatsappv1.SetChatMonitoringResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	mode, err := s.monitoringMode(ctx, tokenClaims.Payload.CustomerId)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running monitoringMode")
+		return nil, internalError
+	}
+
+	monitoring := chatMonitorings[r.Msg.Monitoring]
+	var mutedUntil sql.NullTime
+	if monitoring == store.ChatMonitoringMuted && r.Msg.MutedUntil != nil {
+		mutedUntil = sql.NullTime{Time: r.Msg.MutedUntil.AsTime(), Valid: true}
+	}
+
+	chatSetting, err := s.store.UpsertWasappChatSetting(ctx, store.UpsertWasappChatSettingParams{
+		CustomerID: tokenClaims.Payload.CustomerId,
+		ChatID:     r.Msg.ChatId,
+		Monitoring: monitoring,
+		MutedUntil: mutedUntil,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running UpsertWasappChatSetting")
+		return nil, internalError
+	}
+
+	// a muted chat keeps its messages to pick up where it left off, a chat that is not monitored at all loses them
+	if monitoring != store.ChatMonitoringMuted && !wasappmsgconsumer.IsChatMonitored(monitoring, mutedUntil, mode, time.Now()) {
+		err = s.store.DeleteChat(ctx, store.DeleteChatParams{
+			ChatID:     chatSetting.ChatID,
+			CustomerID: chatSetting.CustomerID,
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed running DeleteChat")
+			return nil, internalError
+		}
+	}
+
+	return &connect.Response[whatsappv1.SetChatMonitoringResponse]{
+		Msg: &whatsappv1.SetChatMonitoringResponse{
+			Chat: chatSettingToProto(chatSetting, mode),
+		},
+	}, nil
+}
+
+func (s *service) SetDefaultMonitoringMode(ctx context.Context, r *connect.Request[whatsappv1.SetDefaultMonitoringModeRequest]) (*connect.Response[whatsappv1.SetDefaultMonitoringModeResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	preference, err := s.store.SetWasappMonitoringMode(ctx, store.SetWasappMonitoringModeParams{
+		CustomerID:           tokenClaims.Payload.CustomerId,
+		WasappMonitoringMode: monitoringModes[r.Msg.Mode],
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running SetWasappMonitoringMode")
+		return nil, internalError
+	}
+
+	if preference.WasappMonitoringMode == store.WasappMonitoringModeAllowlist {
+		purgedCount, err := s.store.DeleteChatsNotIncluded(ctx, tokenClaims.Payload.CustomerId)
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed running DeleteChatsNotIncluded")
+			return nil, internalError
+		}
+		log.Ctx(ctx).Info().
+			Int64("purged_count", purgedCount).
+			Msg("purged the chats that are not on the allowlist")
+	}
+
+	return &connect.Response[whatsappv1.SetDefaultMonitoringModeResponse]{
+		Msg: &whatsappv1.SetDefaultMonitoringModeResponse{
+			Mode: monitoringModesToProto[preference.WasappMonitoringMode],
+		},
+	}, nil
+}
+
+func (s *service) ImportChatExport(ctx context.Context, r *connect.Request[whatsappv1.ImportChatExportRequest]) (*connect.Response[whatsappv1.ImportChatExportResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	text, err := wasappchatexport.ReadText(r.Msg.FileName, r.Msg.Content)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running wasappchatexport.ReadText")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// the messages are only read to make sure the file is a chat export, they are read in the customer's timezone
+	// when the chat is analyzed
+	msgs, err := wasappchatexport.Parse(text, time.UTC, r.Msg.Locale)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running wasappchatexport.Parse")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	chatName := r.Msg.ChatName
+	if chatName == "" {
+		chatName = wasappchatexport.ChatName(r.Msg.FileName)
+	}
+
+	senderName := r.Msg.SenderName
+	if senderName == "" {
+		resp, err := s.wasappCli.GetStatus(ctx, &wasappclient.GetStatusRequest{
+			CustomerId: tokenClaims.Payload.CustomerId.String(),
+		})
+		if err != nil {
+			// the chat is still analyzed without knowing which messages are the customer's
+			log.Ctx(ctx).Warn().Err(err).Msg("failed running wasappCli.GetStatus, the sender name of the customer is not known")
+		} else {
+			senderName = resp.ClientDetails.Name
+		}
+	}
+
+	export, err := s.encryptionSvc.Encrypt(ctx, tokenClaims.Payload.CustomerId, text)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running encryptionSvc.Encrypt")
+		return nil, internalError
+	}
+
+	chatImport, err := s.store.CreateWasappChatImport(ctx, store.CreateWasappChatImportParams{
+		CustomerID:    tokenClaims.Payload.CustomerId,
+		ChatName:      chatName,
+		IsGroup:       isGroupExport(msgs),
+		SenderName:    senderName,
+		MessagesCount: int32(len(msgs)),
+		Export:        sql.NullString{String: export, Valid: true},
+		Locale:        r.Msg.Locale,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running CreateWasappChatImport")
+		return nil, internalError
+	}
+
+	return &connect.Response[whatsappv1.ImportChatExportResponse]{
+		Msg: &whatsappv1.ImportChatExportResponse{
+			ChatImport: chatImportToProto(chatImport),
+		},
+	}, nil
+}
+
+func (s *service) GetChatImport(ctx context.Context, r *connect.Request[whatsappv1.GetChatImportRequest]) (*connect.Response[whatsappv1.GetChatImportResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	id, err := uuid.Parse(r.Msg.Id)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed parsing chat import id")
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid id"))
+	}
+
+	chatImport, err := s.store.GetWasappChatImport(ctx, store.GetWasappChatImportParams{
+		ID:         id,
+		CustomerID: tokenClaims.Payload.CustomerId,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("chat import not found"))
+		}
+
+		log.Ctx(ctx).Err(err).Msg("failed running GetWasappChatImport")
+		return nil, internalError
+	}
+
+	err = s.store.ExpirePendingDetectedEvents(ctx, tokenClaims.Payload.CustomerId)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running ExpirePendingDetectedEvents")
+		return nil, internalError
+	}
+
+	detectedEvents, err := s.store.ListDetectedEventsByChatImportId(ctx, store.ListDetectedEventsByChatImportIdParams{
+		CustomerID:   tokenClaims.Payload.CustomerId,
+		ChatImportID: uuid.NullUUID{UUID: chatImport.ID, Valid: true},
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running ListDetectedEventsByChatImportId")
+		return nil, internalError
+	}
+
+	events := make([]*whatsappv1.DetectedEvent, len(detectedEvents))
+	for idx, detectedEvent := range detectedEvents {
+		events[idx] = detectedEventToProto(detectedEvent)
+	}
+
+	return &connect.Response[whatsappv1.GetChatImportResponse]{
+		Msg: &whatsappv1.GetChatImportResponse{
+			ChatImport: chatImportToProto(chatImport),
+			Events:     events,
+		},
+	}, nil
+}
+
+func (s *service) ConfirmDetectedEvents(ctx context.Context, r *connect.Request[whatsappv1.ConfirmDetectedEventsRequest]) (*connect.Response[whatsappv1.ConfirmDetectedEventsResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	detectedEvents, err := s.getDetectedEvents(ctx, tokenClaims.Payload.CustomerId, r.Msg.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*whatsappv1.DetectedEvent, len(detectedEvents))
+	for idx := range detectedEvents {
+		if detectedEvents[idx].State == store.DetectedEventStatePending {
+			err = s.confirmDetectedEvent(ctx, &detectedEvents[idx])
+			if err != nil {
+				log.Ctx(ctx).Err(err).Msg("failed running confirmDetectedEvent")
+				return nil, internalError
+			}
+		}
+		events[idx] = detectedEventToProto(detectedEvents[idx])
+	}
+
+	return &connect.Response[whatsappv1.ConfirmDetectedEventsResponse]{
+		Msg: &whatsappv1.ConfirmDetectedEventsResponse{
+			Events: events,
+		},
+	}, nil
+}
+
+func (s *service) RejectDetectedEvents(ctx context.Context, r *connect.Request[whatsappv1.RejectDetectedEventsRequest]) (*connect.Response[whatsappv1.RejectDetectedEventsResponse], error) {
+	if err := s.pv.Validate(r.Msg); err != nil {
+		log.Ctx(ctx).Err(err).Msg("invalid request")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tokenClaims, ok := s.apiMetadata.GetClaims(ctx)
+	if !ok {
+		log.Ctx(ctx).Error().Msg("failed running GetClaims")
+		return nil, internalError
+	}
+
+	detectedEvents, err := s.getDetectedEvents(ctx, tokenClaims.Payload.CustomerId, r.Msg.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*whatsappv1.DetectedEvent, len(detectedEvents))
+	for idx := range detectedEvents {
+		if detectedEvents[idx].State == store.DetectedEventStatePending {
+			err = s.rejectDetectedEvent(ctx, &detectedEvents[idx])
+			if err != nil {
+				log.Ctx(ctx).Err(err).Msg("failed running rejectDetectedEvent")
+				return nil, internalError
+			}
+		}
+		events[idx] = detectedEventToProto(detectedEvents[idx])
+	}
+
+	return &connect.Response[whatsappv1.RejectDetectedEventsResponse]{
+		Msg: &whatsappv1.RejectDetectedEventsResponse{
+			Events: events,
+		},
+	}, nil
+}
+
+// monitoringMode returns which chats of the customer are monitored when they are not set otherwise
+func (s *service) monitoringMode(ctx context.Context, customerID uuid.UUID) (store.WasappMonitoringMode, error) {
+	preference, err := s.store.GetCustomerPreferenceByCustomerId(ctx, customerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return store.DefaultCustomerPreference().WasappMonitoringMode, nil
+		}
+		return "", fmt.Errorf("failed running GetCustomerPreferenceByCustomerId: %w", err)
+	}
+	return preference.WasappMonitoringMode, nil
+}
+
+// getPendingDetectedEvent returns the detected event if it is still waiting for the customer, the returned error is
+// ready to be sent back to the client. Events that started while pending are marked as expired.
+func (s *service) getPendingDetectedEvent(ctx context.Context, customerID uuid.UUID, rawID string) (store.DetectedEvent, error) {
+	detectedEvent, err := s.getDetectedEvent(ctx, customerID, rawID)
+	if err != nil {
+		return store.DetectedEvent{}, err
+	}
+
+	if detectedEvent.State != store.DetectedEventStatePending {
+		return store.DetectedEvent{}, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("detected event is %s", detectedEvent.State))
+	}
+
+	return detectedEvent, nil
+}
+
+// getDetectedEvents returns the detected events in the order of rawIDs, the returned error is ready to be sent back
+// to the client
+func (s *service) getDetectedEvents(ctx context.Context, customerID uuid.UUID, rawIDs []string) ([]store.DetectedEvent, error) {
+	detectedEvents := make([]store.DetectedEvent, len(rawIDs))
+	for idx, rawID := range rawIDs {
+		detectedEvent, err := s.getDetectedEvent(ctx, customerID, rawID)
+		if err != nil {
+			return nil, err
+		}
+		detectedEvents[idx] = detectedEvent
+	}
+	return detectedEvents, nil
+}
+
+// getDetectedEvent returns the detected event, the returned error is ready to be sent back to the client. Events that
+// started while pending are marked as expired.
+func (s *service) getDetectedEvent(ctx context.Context, customerID uuid.UUID, rawID string) (store.DetectedEvent, error) {
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed parsing detected event id")
+		return store.DetectedEvent{}, connect.NewError(connect.CodeInvalidArgument, errors.New("invalid id"))
+	}
+
+	detectedEvent, err := s.store.GetDetectedEventById(ctx, store.GetDetectedEventByIdParams{
+		ID:         id,
+		CustomerID: customerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return store.DetectedEvent{}, connect.NewError(connect.CodeNotFound, errors.New("detected event not found"))
+		}
+
+		log.Ctx(ctx).Err(err).Msg("failed running GetDetectedEventById")
+		return store.DetectedEvent{}, internalError
+	}
+
+	if detectedEvent.State == store.DetectedEventStatePending && !detectedEvent.StartTime.Time.After(time.Now()) {
+		err = s.store.SetDetectedEventStateById(ctx, store.SetDetectedEventStateByIdParams{
+			ID:    detectedEvent.ID,
+			State: store.DetectedEventStateExpired,
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed running SetDetectedEventStateById")
+			return store.DetectedEvent{}, internalError
+		}
+		detectedEvent.State = store.DetectedEventStateExpired
+	}
+
+	return detectedEvent, nil
+}
+
+// confirmDetectedEvent sends the pending detected event to the WhatsApp calendar. The event is marked as proposed
+// before it is sent, so only one of the confirmations that come in at the same time sends it, the others get the
+// event as it is now.
+func (s *service) confirmDetectedEvent(ctx context.Context, detectedEvent *store.DetectedEvent) error {
+	eventData, err := detectedEventToCalendarEvent(*detectedEvent)
+	if err != nil {
+		return fmt.Errorf("failed running detectedEventToCalendarEvent: %w", err)
+	}
+
+	// it becomes added once the calendar consumer puts it in the calendar
+	proposedEvent, err := s.store.ProposePendingDetectedEvent(ctx, store.ProposePendingDetectedEventParams{
+		ID:         detectedEvent.ID,
+		CustomerID: detectedEvent.CustomerID,
+	})
+	if err == sql.ErrNoRows {
+		currentEvent, err := s.store.GetDetectedEventById(ctx, store.GetDetectedEventByIdParams{
+			ID:         detectedEvent.ID,
+			CustomerID: detectedEvent.CustomerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed running GetDetectedEventById: %w", err)
+		}
+		*detectedEvent = currentEvent
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed running ProposePendingDetectedEvent: %w", err)
+	}
+
+	err = s.calendarProducer.PublishEvent(ctx, eventData)
+	if err != nil {
+		// it is left pending so it can be confirmed again
+		revertErr := s.store.SetDetectedEventStateById(ctx, store.SetDetectedEventStateByIdParams{
+			ID:    detectedEvent.ID,
+			State: store.DetectedEventStatePending,
+		})
+		if revertErr != nil {
+			log.Ctx(ctx).Err(revertErr).Msg("failed running SetDetectedEventStateById")
+		}
+		return fmt.Errorf("failed running calendarProducer.PublishEvent: %w", err)
+	}
+
+	*detectedEvent = proposedEvent
+	return nil
+}
+
+// rejectDetectedEvent dismisses the pending detected event
+func (s *service) rejectDetectedEvent(ctx context.Context, detectedEvent *store.DetectedEvent) error {
+	// the chat keeps its link to the event so the agreement that led to it is not asked about again
+	err := s.store.SetDetectedEventStateById(ctx, store.SetDetectedEventStateByIdParams{
+		ID:    detectedEvent.ID,
+		State: store.DetectedEventStateRejected,
+	})
+	if err != nil {
+		return fmt.Errorf("failed running SetDetectedEventStateById: %w", err)
+	}
+	detectedEvent.State = store.DetectedEventStateRejected
+	return nil
+}
+
+func NewService(pv protovalidate.Validator, store store.Queries, apiMetadata apimetadata.ApiMetadata, wasappCli wasappclient.Client, calendarProducer wasappcalendar.Producer, encryptionSvc encryptionsvc.Svc) whatsappv1connect.WhatsappServiceHandler {
 	return &service{
 		pv:          pv,
+		store:       store,
 		apiMetadata: apiMetadata,
 		wasappCli:   wasappCli,
+
+		calendarProducer: calendarProducer,
+		encryptionSvc:    encryptionSvc,
 	}
 }
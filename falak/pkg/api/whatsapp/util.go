@@ -0,0 +1,160 @@
+package whatsapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	whatsappv1 "github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/whatsapp/v1"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
+	wasappchatexport "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/chatexport"
+	wasappclient "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/client"
+	wasappmsgconsumer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msgconsumer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultDetectedEventsLimit = 50
+	defaultChatsLimit          = 100
+)
+
+// ReadMaxBytes is the largest request the service reads, it is the largest chat export ImportChatExport takes along
+// with room for the rest of the request. Larger requests are turned away before they are read into memory.
+const ReadMaxBytes = 32<<20 + 64<<10
+
+var detectedEventStates = map[store.DetectedEventState]whatsappv1.DetectedEventState{
+	store.DetectedEventStateProposed:  whatsappv1.DetectedEventState_DETECTED_EVENT_STATE_PROPOSED,
+	store.DetectedEventStateAdded:     whatsappv1.DetectedEventState_DETECTED_EVENT_STATE_ADDED,
+	store.DetectedEventStateUpdated:   whatsappv1.DetectedEventState_DETECTED_EVENT_STATE_UPDATED,
+	store.DetectedEventStateCancelled: whatsappv1.DetectedEventState_DETECTED_EVENT_STATE_CANCELLED,
+	store.DetectedEventStateRejected:  whatsappv1.DetectedEventState_DETECTED_EVENT_STATE_REJECTED,
+	store.DetectedEventStatePending:   whatsappv1.DetectedEventState_DETECTED_EVENT_STATE_PENDING,
+	store.DetectedEventStateExpired:   whatsappv1.DetectedEventState_DETECTED_EVENT_STATE_EXPIRED,
+	store.DetectedEventStateTentative: whatsappv1.DetectedEventState_DETECTED_EVENT_STATE_TENTATIVE,
+}
+
+var chatMonitorings = map[whatsappv1.ChatMonitoring]store.ChatMonitoring{
+	whatsappv1.ChatMonitoring_CHAT_MONITORING_DEFAULT:  store.ChatMonitoringDefault,
+	whatsappv1.ChatMonitoring_CHAT_MONITORING_INCLUDED: store.ChatMonitoringIncluded,
+	whatsappv1.ChatMonitoring_CHAT_MONITORING_EXCLUDED: store.ChatMonitoringExcluded,
+	whatsappv1.ChatMonitoring_CHAT_MONITORING_MUTED:    store.ChatMonitoringMuted,
+}
+
+var chatMonitoringsToProto = map[store.ChatMonitoring]whatsappv1.ChatMonitoring{
+	store.ChatMonitoringDefault:  whatsappv1.ChatMonitoring_CHAT_MONITORING_DEFAULT,
+	store.ChatMonitoringIncluded: whatsappv1.ChatMonitoring_CHAT_MONITORING_INCLUDED,
+	store.ChatMonitoringExcluded: whatsappv1.ChatMonitoring_CHAT_MONITORING_EXCLUDED,
+	store.ChatMonitoringMuted:    whatsappv1.ChatMonitoring_CHAT_MONITORING_MUTED,
+}
+
+var monitoringModes = map[whatsappv1.MonitoringMode]store.WasappMonitoringMode{
+	whatsappv1.MonitoringMode_MONITORING_MODE_ALL:       store.WasappMonitoringModeAll,
+	whatsappv1.MonitoringMode_MONITORING_MODE_ALLOWLIST: store.WasappMonitoringModeAllowlist,
+}
+
+var monitoringModesToProto = map[store.WasappMonitoringMode]whatsappv1.MonitoringMode{
+	store.WasappMonitoringModeAll:       whatsappv1.MonitoringMode_MONITORING_MODE_ALL,
+	store.WasappMonitoringModeAllowlist: whatsappv1.MonitoringMode_MONITORING_MODE_ALLOWLIST,
+}
+
+func chatSettingToProto(chatSetting store.WasappChatSetting, mode store.WasappMonitoringMode) *whatsappv1.Chat {
+	res := &whatsappv1.Chat{
+		ChatId:      chatSetting.ChatID,
+		Name:        chatSetting.ChatName,
+		IsGroup:     wasappclient.IsGroupChat(chatSetting.ChatID),
+		Monitoring:  chatMonitoringsToProto[chatSetting.Monitoring],
+		IsMonitored: wasappmsgconsumer.IsChatMonitored(chatSetting.Monitoring, chatSetting.MutedUntil, mode, time.Now()),
+	}
+	if chatSetting.MutedUntil.Valid {
+		res.MutedUntil = timestamppb.New(chatSetting.MutedUntil.Time)
+	}
+	if chatSetting.LastMessageAt.Valid {
+		res.LastMessageAt = timestamppb.New(chatSetting.LastMessageAt.Time)
+	}
+	return res
+}
+
+var chatImportStatuses = map[store.WasappChatImportStatus]whatsappv1.ChatImportStatus{
+	store.WasappChatImportStatusPending:   whatsappv1.ChatImportStatus_CHAT_IMPORT_STATUS_PENDING,
+	store.WasappChatImportStatusAnalyzing: whatsappv1.ChatImportStatus_CHAT_IMPORT_STATUS_ANALYZING,
+	store.WasappChatImportStatusDone:      whatsappv1.ChatImportStatus_CHAT_IMPORT_STATUS_DONE,
+	store.WasappChatImportStatusFailed:    whatsappv1.ChatImportStatus_CHAT_IMPORT_STATUS_FAILED,
+}
+
+func chatImportToProto(chatImport store.WasappChatImport) *whatsappv1.ChatImport {
+	return &whatsappv1.ChatImport{
+		Id:              chatImport.ID.String(),
+		ChatName:        chatImport.ChatName,
+		Status:          chatImportStatuses[chatImport.Status],
+		MessagesCount:   chatImport.MessagesCount,
+		WindowsCount:    chatImport.WindowsCount,
+		WindowsAnalyzed: chatImport.WindowsAnalyzed,
+		EventsCount:     chatImport.EventsCount,
+		Error:           chatImport.Error.String,
+		CreatedAt:       timestamppb.New(chatImport.CreatedAt),
+	}
+}
+
+// isGroupExport tells if the exported chat is a group chat, which the export does not say. A chat with more than two
+// people talking in it has to be a group, a group where only two of them talk passes for an individual chat.
+func isGroupExport(msgs []wasappchatexport.Message) bool {
+	senders := map[string]bool{}
+	for _, msg := range msgs {
+		senders[msg.SenderName] = true
+		if len(senders) > 2 {
+			return true
+		}
+	}
+	return false
+}
+
+func detectedEventToProto(event store.DetectedEvent) *whatsappv1.DetectedEvent {
+	res := &whatsappv1.DetectedEvent{
+		Id:         event.ID.String(),
+		ChatId:     event.ChatID,
+		ChatUrl:    wasappclient.ChatURL(event.ChatID),
+		State:      detectedEventStates[event.State],
+		Title:      event.Summary.String,
+		EventUid:   event.CaldavUid.String,
+		DetectedAt: timestamppb.New(event.CreatedAt),
+		Location:   event.Location.String,
+	}
+	if event.StartTime.Valid {
+		res.StartTime = timestamppb.New(event.StartTime.Time)
+	}
+	if event.EndTime.Valid {
+		res.EndTime = timestamppb.New(event.EndTime.Time)
+	}
+	return res
+}
+
+func detectedEventToCalendarEvent(event store.DetectedEvent) (wasappcalendar.CalendarEventData, error) {
+	var attendees []wasappcalendar.EventAttendee
+	if err := json.Unmarshal(event.Attendees, &attendees); err != nil {
+		return wasappcalendar.CalendarEventData{}, fmt.Errorf("failed to unmarshal attendees: %w", err)
+	}
+
+	var geo *wasappcalendar.EventGeo
+	if event.Latitude.Valid && event.Longitude.Valid {
+		geo = &wasappcalendar.EventGeo{
+			Latitude:  event.Latitude.Float64,
+			Longitude: event.Longitude.Float64,
+		}
+	}
+
+	return wasappcalendar.CalendarEventData{
+		Action:       wasappcalendar.CalendarEventAction_Add,
+		UID:          event.CaldavUid.String,
+		CustomerID:   event.CustomerID,
+		ChatID:       event.ChatID,
+		Summary:      event.Summary.String,
+		Description:  event.Description.String,
+		StartTime:    event.StartTime.Time,
+		EndTime:      event.EndTime.Time,
+		FlexibleTime: event.FlexibleTime,
+		Location:     event.Location.String,
+		Geo:          geo,
+		Attendees:    attendees,
+	}, nil
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/google/uuid"
 	baikalclient "github.com/jadwalapp/symmetrical-spoon/falak/pkg/baikal/client"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/httpclient"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/keyring"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/util"
 	"github.com/rs/zerolog/log"
@@ -69,6 +71,23 @@ func main() {
 	dbStore := store.New(dbConn)
 	// ======== DATABASE ========
 
+	// ======== KEYRING ========
+	var keyringImpl keyring.Keyring
+	switch config.KeyringProvider {
+	case string(keyring.Provider_File):
+		keyringImpl, err = keyring.NewFileKeyring(config.KeyringMasterKeysFile)
+	default:
+		keyringImpl, err = keyring.NewEnvKeyring(config.KeyringMasterKeys)
+	}
+	if err != nil {
+		log.Fatal().Msgf("cannot create keyring: %v", err)
+	}
+	// ======== KEYRING ========
+
+	// ======== ENCRYPTION SVC ========
+	encryptionSvc := encryptionsvc.NewSvc(*dbStore, keyringImpl, encryptionsvc.LegacyKeys{})
+	// ======== ENCRYPTION SVC ========
+
 	// ======== BAIKAL CLIENT ========
 	cli := httpclient.NewClient(&http.Client{})
 	baikalCli := baikalclient.NewClient(cli, config.BaikalHost, config.BaikalPhpSessionID)
@@ -89,13 +108,18 @@ func main() {
 		}
 
 		randomPassword := uuid.New().String()
+		encryptedPassword, err := encryptionSvc.Encrypt(context.Background(), customer.ID, randomPassword)
+		if err != nil {
+			log.Error().Msgf("❌ failed to encrypt the CalDAV password: %v", err)
+			failedRequestsCustomersId = append(failedRequestsCustomersId, customer.ID.String())
+			continue
+		}
 
 		_, err = dbStore.CreateCalDavAccount(context.Background(), store.CreateCalDavAccountParams{
-			CustomerID:    customer.ID,
-			Email:         customer.Email,
-			Username:      customer.Email,
-			Password:      randomPassword,
-			EncryptionKey: config.CalDAVPasswordEncryptionKey,
+			CustomerID: customer.ID,
+			Email:      customer.Email,
+			Username:   customer.Email,
+			Password:   encryptedPassword,
 		})
 		if err != nil {
 			log.Error().Msgf("❌ failed to run CreateCalDavAccount: %v", err)
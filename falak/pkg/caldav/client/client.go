@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -139,26 +140,15 @@ func (c *caldavClient) AddEvent(ctx context.Context, event EventData) error {
 		return fmt.Errorf("calendar not initialized, call InitCalendar() first")
 	}
 
-	icalEvent := ical.NewEvent()
-	icalEvent.Props.SetText(ical.PropSummary, event.Summary)
-	icalEvent.Props.SetText(ical.PropDescription, event.Description)
-	icalEvent.Props.SetDateTime(ical.PropDateTimeStart, event.StartTime.UTC())
-	icalEvent.Props.SetDateTime(ical.PropDateTimeEnd, event.EndTime.UTC())
-	icalEvent.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
-
 	uid := event.UID
 	if uid == "" {
 		uid = fmt.Sprintf("event-%s@jadwal.app", time.Now().UTC().Format("20060102T150405Z"))
 	}
-	icalEvent.Props.SetText(ical.PropUID, uid)
 
-	cal := ical.NewCalendar()
-	cal.Props.SetText(ical.PropProductID, "-//Jadwal App//Calendar//EN")
-	cal.Props.SetText(ical.PropVersion, "2.0")
-	cal.Children = append(cal.Children, icalEvent.Component)
+	cal := newEventCalendar(uid, event)
 
-	eventPath := c.calendarPath + time.Now().UTC().Format("20060102T150405Z") + ".ics"
-	_, err := c.caldavClient.PutCalendarObject(ctx, eventPath, cal)
+	// the path is derived from the UID so adding the same event again replaces it instead of duplicating it
+	_, err := c.caldavClient.PutCalendarObject(ctx, c.EventPath(uid), cal)
 	if err != nil {
 		return fmt.Errorf("failed to create event: %w", err)
 	}
@@ -166,6 +156,11 @@ func (c *caldavClient) AddEvent(ctx context.Context, event EventData) error {
 	return nil
 }
 
+// EventPath returns the path AddEvent puts the event with the given UID at
+func (c *caldavClient) EventPath(uid string) string {
+	return c.calendarPath + uid + ".ics"
+}
+
 // GetCalendarPath returns the current calendar path
 func (c *caldavClient) GetCalendarPath() string {
 	return c.calendarPath
@@ -289,24 +284,11 @@ func (c *caldavClient) UpdateEvent(ctx context.Context, uid string, updatedEvent
 	}
 
 	if len(events) == 0 {
-		return fmt.Errorf("event with UID %s not found", uid)
+		return fmt.Errorf("%w: UID %s", ErrEventNotFound, uid)
 	}
 
 	// Create a new event with the updated properties but keep the same UID
-	event := ical.NewEvent()
-	event.Props.SetText(ical.PropSummary, updatedEvent.Summary)
-	event.Props.SetText(ical.PropDescription, updatedEvent.Description)
-	event.Props.SetDateTime(ical.PropDateTimeStart, updatedEvent.StartTime.UTC())
-	event.Props.SetDateTime(ical.PropDateTimeEnd, updatedEvent.EndTime.UTC())
-	event.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
-
-	// Make sure we keep the original UID
-	event.Props.SetText(ical.PropUID, uid)
-
-	cal := ical.NewCalendar()
-	cal.Props.SetText(ical.PropProductID, "-//Jadwal App//Calendar//EN")
-	cal.Props.SetText(ical.PropVersion, "2.0")
-	cal.Children = append(cal.Children, event.Component)
+	cal := newEventCalendar(uid, updatedEvent)
 
 	// Get the path of the original event from the found event
 	// We need to update it at the same URL
@@ -320,7 +302,7 @@ func (c *caldavClient) UpdateEvent(ctx context.Context, uid string, updatedEvent
 
 	if eventPath == "" {
 		// If we can't find the exact path, create a new one
-		eventPath = c.calendarPath + time.Now().UTC().Format("20060102T150405Z") + ".ics"
+		eventPath = c.EventPath(uid)
 	}
 
 	// Update or create the event
@@ -331,3 +313,113 @@ func (c *caldavClient) UpdateEvent(ctx context.Context, uid string, updatedEvent
 
 	return nil
 }
+
+// DeleteEvent deletes an existing event identified by UID
+func (c *caldavClient) DeleteEvent(ctx context.Context, uid string) error {
+	if c.calendarPath == "" {
+		return fmt.Errorf("calendar not initialized, call InitCalendar() first")
+	}
+
+	events, err := c.FindEvents(ctx, EventQuery{
+		UIDPattern: uid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find event: %w", err)
+	}
+
+	eventPath := ""
+	for _, e := range events {
+		if e.UID == uid {
+			eventPath = e.Path
+			break
+		}
+	}
+
+	if eventPath == "" {
+		return fmt.Errorf("%w: UID %s", ErrEventNotFound, uid)
+	}
+
+	err = c.caldavClient.RemoveAll(ctx, eventPath)
+	if err != nil {
+		return fmt.Errorf("failed to delete event: %w", err)
+	}
+
+	return nil
+}
+
+// newEventCalendar wraps the event in a VCALENDAR ready to be put on the server
+func newEventCalendar(uid string, event EventData) *ical.Calendar {
+	icalEvent := ical.NewEvent()
+	icalEvent.Props.SetText(ical.PropSummary, event.Summary)
+	icalEvent.Props.SetText(ical.PropDescription, event.Description)
+	if event.AllDay {
+		icalEvent.Props.SetDate(ical.PropDateTimeStart, event.StartTime)
+		icalEvent.Props.SetDate(ical.PropDateTimeEnd, event.EndTime)
+	} else {
+		icalEvent.Props.SetDateTime(ical.PropDateTimeStart, event.StartTime.UTC())
+		icalEvent.Props.SetDateTime(ical.PropDateTimeEnd, event.EndTime.UTC())
+	}
+	icalEvent.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
+	icalEvent.Props.SetText(ical.PropUID, uid)
+	if event.Tentative {
+		icalEvent.SetStatus(ical.EventTentative)
+		icalEvent.Props.SetText(ical.PropTransparency, "TRANSPARENT")
+	} else {
+		icalEvent.SetStatus(ical.EventConfirmed)
+	}
+	if event.Location != "" {
+		icalEvent.Props.SetText(ical.PropLocation, event.Location)
+	}
+	if event.Geo != nil {
+		icalEvent.Props.Set(newGeoProp(*event.Geo))
+		icalEvent.Props.Set(newStructuredLocationProp(event.Location, *event.Geo))
+	}
+	for _, attendee := range event.Attendees {
+		icalEvent.Props.Add(newAttendeeProp(attendee))
+	}
+	if event.URL != "" {
+		if u, err := url.Parse(event.URL); err == nil {
+			icalEvent.Props.SetURI(ical.PropURL, u)
+		}
+	}
+	for name, value := range event.ExtraProperties {
+		icalEvent.Props.SetText(name, value)
+	}
+
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropProductID, "-//Jadwal App//Calendar//EN")
+	cal.Props.SetText(ical.PropVersion, "2.0")
+	cal.Children = append(cal.Children, icalEvent.Component)
+
+	return cal
+}
+
+func newGeoProp(geo Geo) *ical.Prop {
+	prop := ical.NewProp(ical.PropGeo)
+	prop.Value = formatGeo(geo, ";")
+	return prop
+}
+
+// newStructuredLocationProp returns the property Apple Calendar uses to show the location of an event on a map
+func newStructuredLocationProp(title string, geo Geo) *ical.Prop {
+	prop := ical.NewProp("X-APPLE-STRUCTURED-LOCATION")
+	prop.SetValueType(ical.ValueURI)
+	prop.Params.Set("X-TITLE", title)
+	prop.Value = "geo:" + formatGeo(geo, ",")
+	return prop
+}
+
+func formatGeo(geo Geo, sep string) string {
+	return strconv.FormatFloat(geo.Latitude, 'f', -1, 64) + sep + strconv.FormatFloat(geo.Longitude, 'f', -1, 64)
+}
+
+// newAttendeeProp returns an ATTENDEE with a tel: URI, the events have no ORGANIZER so servers don't send invitations
+func newAttendeeProp(attendee Attendee) *ical.Prop {
+	prop := ical.NewProp(ical.PropAttendee)
+	if attendee.Name != "" {
+		prop.Params.Set(ical.ParamCommonName, attendee.Name)
+	}
+	prop.Params.Set(ical.ParamRole, "REQ-PARTICIPANT")
+	prop.Value = "tel:+" + strings.TrimPrefix(attendee.PhoneNumber, "+")
+	return prop
+}
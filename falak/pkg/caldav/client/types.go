@@ -2,11 +2,15 @@ package caldavclient
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/emersion/go-ical"
 )
 
+// ErrEventNotFound is returned when there is no event with the UID in the calendar
+var ErrEventNotFound = errors.New("event not found")
+
 // Client interface defines operations for a CalDAV client
 type Client interface {
 	// Initialize a calendar with given properties, creating it if it doesn't exist
@@ -18,6 +22,9 @@ type Client interface {
 	// GetCalendarPath returns the current calendar path
 	GetCalendarPath() string
 
+	// EventPath returns the path of the event with the given UID in the calendar
+	EventPath(uid string) string
+
 	// FindEvents searches for events in the calendar
 	// Returns a slice of events that match the criteria
 	FindEvents(ctx context.Context, query EventQuery) ([]CalendarEvent, error)
@@ -25,6 +32,10 @@ type Client interface {
 	// UpdateEvent updates an existing event identified by UID
 	// Returns error if event not found
 	UpdateEvent(ctx context.Context, uid string, updatedEvent EventData) error
+
+	// DeleteEvent deletes an existing event identified by UID
+	// Returns error if event not found
+	DeleteEvent(ctx context.Context, uid string) error
 }
 
 // Config stores the configuration for connecting to a CalDAV server
@@ -60,11 +71,45 @@ type EventData struct {
 	// When the event ends
 	EndTime time.Time
 
+	// AllDay writes the start and end as dates, the end being the day after the last day of the event
+	AllDay bool
+
+	// Tentative marks the event as not confirmed yet and not blocking the time it takes
+	Tentative bool
+
+	// Optional free text location of the event
+	Location string
+
+	// Optional coordinates of the location, written as GEO and as a structured location Apple Calendar can show on a map
+	Geo *Geo
+
+	// Optional people taking part in the event
+	Attendees []Attendee
+
+	// Optional link to where the event came from
+	URL string
+
+	// Optional non-standard properties to add to the event, keys must start with "X-"
+	ExtraProperties map[string]string
+
 	// Optional unique identifier (will be auto-generated if empty)
 	// You can use this to store chat_id like: "chat-123@jadwal.app"
 	UID string
 }
 
+// Geo is a point on the map in decimal degrees
+type Geo struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Attendee is a person taking part in an event, they are identified by their phone number since it is what the
+// events we add know about them
+type Attendee struct {
+	Name        string
+	PhoneNumber string // In international format, with or without the leading "+"
+}
+
 // EventQuery represents search criteria for finding events
 type EventQuery struct {
 	// Filter by UID pattern (supports partial match)
@@ -191,7 +191,7 @@ func (app *CalendarApp) getCredentials() error {
 func (app *CalendarApp) mainMenu() {
 	for {
 		clearScreen()
-		titleColor.Println(asciiTitle)
+		titleColor.Print(asciiTitle)
 		headerColor.Printf("🌐 Connected to: %s as %s\n\n", app.baseURL, app.username)
 
 		if app.selectedCal != "" {
@@ -674,7 +674,7 @@ func waitForEnter() {
 }
 
 func main() {
-	titleColor.Println(asciiTitle)
+	titleColor.Print(asciiTitle)
 	headerColor.Println("Starting CalDAV Terminal - The Ultimate Calendar CLI...")
 	infoColor.Println("This tool requires the following packages:")
 	infoColor.Println("  - github.com/fatih/color")
@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: admin/v1/admin.proto
+
+package adminv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/jadwalapp/symmetrical-spoon/falak/pkg/gen/proto/admin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "admin.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceGetLlmUsageProcedure is the fully-qualified name of the AdminService's GetLlmUsage
+	// RPC.
+	AdminServiceGetLlmUsageProcedure = "/admin.v1.AdminService/GetLlmUsage"
+	// AdminServiceGetRetentionStatsProcedure is the fully-qualified name of the AdminService's
+	// GetRetentionStats RPC.
+	AdminServiceGetRetentionStatsProcedure = "/admin.v1.AdminService/GetRetentionStats"
+	// AdminServiceShredCustomerDataProcedure is the fully-qualified name of the AdminService's
+	// ShredCustomerData RPC.
+	AdminServiceShredCustomerDataProcedure = "/admin.v1.AdminService/ShredCustomerData"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	adminServiceServiceDescriptor                 = v1.File_admin_v1_admin_proto.Services().ByName("AdminService")
+	adminServiceGetLlmUsageMethodDescriptor       = adminServiceServiceDescriptor.Methods().ByName("GetLlmUsage")
+	adminServiceGetRetentionStatsMethodDescriptor = adminServiceServiceDescriptor.Methods().ByName("GetRetentionStats")
+	adminServiceShredCustomerDataMethodDescriptor = adminServiceServiceDescriptor.Methods().ByName("ShredCustomerData")
+)
+
+// AdminServiceClient is a client for the admin.v1.AdminService service.
+type AdminServiceClient interface {
+	GetLlmUsage(context.Context, *connect.Request[v1.GetLlmUsageRequest]) (*connect.Response[v1.GetLlmUsageResponse], error)
+	GetRetentionStats(context.Context, *connect.Request[v1.GetRetentionStatsRequest]) (*connect.Response[v1.GetRetentionStatsResponse], error)
+	// ShredCustomerData deletes the customer's data key, which makes their WhatsApp messages and CalDAV password
+	// unreadable everywhere they are kept, and then deletes the data it made unreadable along with the events found in
+	// their chats and the failed analyses of them, which are not encrypted
+	ShredCustomerData(context.Context, *connect.Request[v1.ShredCustomerDataRequest]) (*connect.Response[v1.ShredCustomerDataResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the admin.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &adminServiceClient{
+		getLlmUsage: connect.NewClient[v1.GetLlmUsageRequest, v1.GetLlmUsageResponse](
+			httpClient,
+			baseURL+AdminServiceGetLlmUsageProcedure,
+			connect.WithSchema(adminServiceGetLlmUsageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRetentionStats: connect.NewClient[v1.GetRetentionStatsRequest, v1.GetRetentionStatsResponse](
+			httpClient,
+			baseURL+AdminServiceGetRetentionStatsProcedure,
+			connect.WithSchema(adminServiceGetRetentionStatsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		shredCustomerData: connect.NewClient[v1.ShredCustomerDataRequest, v1.ShredCustomerDataResponse](
+			httpClient,
+			baseURL+AdminServiceShredCustomerDataProcedure,
+			connect.WithSchema(adminServiceShredCustomerDataMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	getLlmUsage       *connect.Client[v1.GetLlmUsageRequest, v1.GetLlmUsageResponse]
+	getRetentionStats *connect.Client[v1.GetRetentionStatsRequest, v1.GetRetentionStatsResponse]
+	shredCustomerData *connect.Client[v1.ShredCustomerDataRequest, v1.ShredCustomerDataResponse]
+}
+
+// GetLlmUsage calls admin.v1.AdminService.GetLlmUsage.
+func (c *adminServiceClient) GetLlmUsage(ctx context.Context, req *connect.Request[v1.GetLlmUsageRequest]) (*connect.Response[v1.GetLlmUsageResponse], error) {
+	return c.getLlmUsage.CallUnary(ctx, req)
+}
+
+// GetRetentionStats calls admin.v1.AdminService.GetRetentionStats.
+func (c *adminServiceClient) GetRetentionStats(ctx context.Context, req *connect.Request[v1.GetRetentionStatsRequest]) (*connect.Response[v1.GetRetentionStatsResponse], error) {
+	return c.getRetentionStats.CallUnary(ctx, req)
+}
+
+// ShredCustomerData calls admin.v1.AdminService.ShredCustomerData.
+func (c *adminServiceClient) ShredCustomerData(ctx context.Context, req *connect.Request[v1.ShredCustomerDataRequest]) (*connect.Response[v1.ShredCustomerDataResponse], error) {
+	return c.shredCustomerData.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the admin.v1.AdminService service.
+type AdminServiceHandler interface {
+	GetLlmUsage(context.Context, *connect.Request[v1.GetLlmUsageRequest]) (*connect.Response[v1.GetLlmUsageResponse], error)
+	GetRetentionStats(context.Context, *connect.Request[v1.GetRetentionStatsRequest]) (*connect.Response[v1.GetRetentionStatsResponse], error)
+	// ShredCustomerData deletes the customer's data key, which makes their WhatsApp messages and CalDAV password
+	// unreadable everywhere they are kept, and then deletes the data it made unreadable along with the events found in
+	// their chats and the failed analyses of them, which are not encrypted
+	ShredCustomerData(context.Context, *connect.Request[v1.ShredCustomerDataRequest]) (*connect.Response[v1.ShredCustomerDataResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceGetLlmUsageHandler := connect.NewUnaryHandler(
+		AdminServiceGetLlmUsageProcedure,
+		svc.GetLlmUsage,
+		connect.WithSchema(adminServiceGetLlmUsageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetRetentionStatsHandler := connect.NewUnaryHandler(
+		AdminServiceGetRetentionStatsProcedure,
+		svc.GetRetentionStats,
+		connect.WithSchema(adminServiceGetRetentionStatsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceShredCustomerDataHandler := connect.NewUnaryHandler(
+		AdminServiceShredCustomerDataProcedure,
+		svc.ShredCustomerData,
+		connect.WithSchema(adminServiceShredCustomerDataMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/admin.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceGetLlmUsageProcedure:
+			adminServiceGetLlmUsageHandler.ServeHTTP(w, r)
+		case AdminServiceGetRetentionStatsProcedure:
+			adminServiceGetRetentionStatsHandler.ServeHTTP(w, r)
+		case AdminServiceShredCustomerDataProcedure:
+			adminServiceShredCustomerDataHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) GetLlmUsage(context.Context, *connect.Request[v1.GetLlmUsageRequest]) (*connect.Response[v1.GetLlmUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.GetLlmUsage is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetRetentionStats(context.Context, *connect.Request[v1.GetRetentionStatsRequest]) (*connect.Response[v1.GetRetentionStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.GetRetentionStats is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ShredCustomerData(context.Context, *connect.Request[v1.ShredCustomerDataRequest]) (*connect.Response[v1.ShredCustomerDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ShredCustomerData is not implemented"))
+}
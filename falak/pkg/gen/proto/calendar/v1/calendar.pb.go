@@ -7,6 +7,7 @@
 package calendarv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,6 +21,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PrayerCalculationMethod int32
+
+const (
+	PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_UNSPECIFIED         PrayerCalculationMethod = 0
+	PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_UMM_AL_QURA         PrayerCalculationMethod = 1
+	PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_MUSLIM_WORLD_LEAGUE PrayerCalculationMethod = 2
+	PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_ISNA                PrayerCalculationMethod = 3
+	PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_EGYPT               PrayerCalculationMethod = 4
+	PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_KARACHI             PrayerCalculationMethod = 5
+	PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_KUWAIT              PrayerCalculationMethod = 6
+	PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_QATAR               PrayerCalculationMethod = 7
+)
+
+// Enum value maps for PrayerCalculationMethod.
+var (
+	PrayerCalculationMethod_name = map[int32]string{
+		0: "PRAYER_CALCULATION_METHOD_UNSPECIFIED",
+		1: "PRAYER_CALCULATION_METHOD_UMM_AL_QURA",
+		2: "PRAYER_CALCULATION_METHOD_MUSLIM_WORLD_LEAGUE",
+		3: "PRAYER_CALCULATION_METHOD_ISNA",
+		4: "PRAYER_CALCULATION_METHOD_EGYPT",
+		5: "PRAYER_CALCULATION_METHOD_KARACHI",
+		6: "PRAYER_CALCULATION_METHOD_KUWAIT",
+		7: "PRAYER_CALCULATION_METHOD_QATAR",
+	}
+	PrayerCalculationMethod_value = map[string]int32{
+		"PRAYER_CALCULATION_METHOD_UNSPECIFIED":         0,
+		"PRAYER_CALCULATION_METHOD_UMM_AL_QURA":         1,
+		"PRAYER_CALCULATION_METHOD_MUSLIM_WORLD_LEAGUE": 2,
+		"PRAYER_CALCULATION_METHOD_ISNA":                3,
+		"PRAYER_CALCULATION_METHOD_EGYPT":               4,
+		"PRAYER_CALCULATION_METHOD_KARACHI":             5,
+		"PRAYER_CALCULATION_METHOD_KUWAIT":              6,
+		"PRAYER_CALCULATION_METHOD_QATAR":               7,
+	}
+)
+
+func (x PrayerCalculationMethod) Enum() *PrayerCalculationMethod {
+	p := new(PrayerCalculationMethod)
+	*p = x
+	return p
+}
+
+func (x PrayerCalculationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrayerCalculationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_v1_calendar_proto_enumTypes[0].Descriptor()
+}
+
+func (PrayerCalculationMethod) Type() protoreflect.EnumType {
+	return &file_calendar_v1_calendar_proto_enumTypes[0]
+}
+
+func (x PrayerCalculationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrayerCalculationMethod.Descriptor instead.
+func (PrayerCalculationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{0}
+}
+
+type AsrJuristic int32
+
+const (
+	AsrJuristic_ASR_JURISTIC_UNSPECIFIED AsrJuristic = 0
+	AsrJuristic_ASR_JURISTIC_SHAFI       AsrJuristic = 1
+	AsrJuristic_ASR_JURISTIC_HANAFI      AsrJuristic = 2
+)
+
+// Enum value maps for AsrJuristic.
+var (
+	AsrJuristic_name = map[int32]string{
+		0: "ASR_JURISTIC_UNSPECIFIED",
+		1: "ASR_JURISTIC_SHAFI",
+		2: "ASR_JURISTIC_HANAFI",
+	}
+	AsrJuristic_value = map[string]int32{
+		"ASR_JURISTIC_UNSPECIFIED": 0,
+		"ASR_JURISTIC_SHAFI":       1,
+		"ASR_JURISTIC_HANAFI":      2,
+	}
+)
+
+func (x AsrJuristic) Enum() *AsrJuristic {
+	p := new(AsrJuristic)
+	*p = x
+	return p
+}
+
+func (x AsrJuristic) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AsrJuristic) Descriptor() protoreflect.EnumDescriptor {
+	return file_calendar_v1_calendar_proto_enumTypes[1].Descriptor()
+}
+
+func (AsrJuristic) Type() protoreflect.EnumType {
+	return &file_calendar_v1_calendar_proto_enumTypes[1]
+}
+
+func (x AsrJuristic) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AsrJuristic.Descriptor instead.
+func (AsrJuristic) EnumDescriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{1}
+}
+
 type GetCalDavAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -190,44 +304,598 @@ func (x *SchedulePrayerTimesResponse) GetIcalUrl() string {
 	return ""
 }
 
+type PrayerSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name, e.g. "Asia/Riyadh"
+	Timezone          string                  `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CalculationMethod PrayerCalculationMethod `protobuf:"varint,4,opt,name=calculation_method,json=calculationMethod,proto3,enum=calendar.v1.PrayerCalculationMethod" json:"calculation_method,omitempty"`
+	AsrJuristic       AsrJuristic             `protobuf:"varint,5,opt,name=asr_juristic,json=asrJuristic,proto3,enum=calendar.v1.AsrJuristic" json:"asr_juristic,omitempty"`
+	// time the Friday khutbah starts at the customer's masjid in the HH:mm format, Dhuhr is used when not set
+	JumuahKhutbahTime *string `protobuf:"bytes,6,opt,name=jumuah_khutbah_time,json=jumuahKhutbahTime,proto3,oneof" json:"jumuah_khutbah_time,omitempty"`
+	// how many minutes before the khutbah the "leave by" alarm goes off, 0 turns it off. It is left as it is when not
+	// set, which is 30 minutes for a customer who never set it
+	JumuahLeaveByMinutes *int32 `protobuf:"varint,7,opt,name=jumuah_leave_by_minutes,json=jumuahLeaveByMinutes,proto3,oneof" json:"jumuah_leave_by_minutes,omitempty"`
+}
+
+func (x *PrayerSettings) Reset() {
+	*x = PrayerSettings{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrayerSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrayerSettings) ProtoMessage() {}
+
+func (x *PrayerSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrayerSettings.ProtoReflect.Descriptor instead.
+func (*PrayerSettings) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PrayerSettings) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *PrayerSettings) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *PrayerSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PrayerSettings) GetCalculationMethod() PrayerCalculationMethod {
+	if x != nil {
+		return x.CalculationMethod
+	}
+	return PrayerCalculationMethod_PRAYER_CALCULATION_METHOD_UNSPECIFIED
+}
+
+func (x *PrayerSettings) GetAsrJuristic() AsrJuristic {
+	if x != nil {
+		return x.AsrJuristic
+	}
+	return AsrJuristic_ASR_JURISTIC_UNSPECIFIED
+}
+
+func (x *PrayerSettings) GetJumuahKhutbahTime() string {
+	if x != nil && x.JumuahKhutbahTime != nil {
+		return *x.JumuahKhutbahTime
+	}
+	return ""
+}
+
+func (x *PrayerSettings) GetJumuahLeaveByMinutes() int32 {
+	if x != nil && x.JumuahLeaveByMinutes != nil {
+		return *x.JumuahLeaveByMinutes
+	}
+	return 0
+}
+
+type UpdatePrayerSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *PrayerSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdatePrayerSettingsRequest) Reset() {
+	*x = UpdatePrayerSettingsRequest{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrayerSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrayerSettingsRequest) ProtoMessage() {}
+
+func (x *UpdatePrayerSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrayerSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrayerSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdatePrayerSettingsRequest) GetSettings() *PrayerSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdatePrayerSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *PrayerSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *UpdatePrayerSettingsResponse) Reset() {
+	*x = UpdatePrayerSettingsResponse{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrayerSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrayerSettingsResponse) ProtoMessage() {}
+
+func (x *UpdatePrayerSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrayerSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePrayerSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdatePrayerSettingsResponse) GetSettings() *PrayerSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type GetPrayerSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPrayerSettingsRequest) Reset() {
+	*x = GetPrayerSettingsRequest{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrayerSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrayerSettingsRequest) ProtoMessage() {}
+
+func (x *GetPrayerSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrayerSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPrayerSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{7}
+}
+
+type GetPrayerSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *PrayerSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *GetPrayerSettingsResponse) Reset() {
+	*x = GetPrayerSettingsResponse{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrayerSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrayerSettingsResponse) ProtoMessage() {}
+
+func (x *GetPrayerSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrayerSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetPrayerSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPrayerSettingsResponse) GetSettings() *PrayerSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// GetOccasionsFeed issues a new webcal url for the Islamic occasions calendar, the previous url stops working.
+type GetOccasionsFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetOccasionsFeedRequest) Reset() {
+	*x = GetOccasionsFeedRequest{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOccasionsFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOccasionsFeedRequest) ProtoMessage() {}
+
+func (x *GetOccasionsFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOccasionsFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetOccasionsFeedRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{9}
+}
+
+type GetOccasionsFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WebcalUrl string `protobuf:"bytes,1,opt,name=webcal_url,json=webcalUrl,proto3" json:"webcal_url,omitempty"`
+}
+
+func (x *GetOccasionsFeedResponse) Reset() {
+	*x = GetOccasionsFeedResponse{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOccasionsFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOccasionsFeedResponse) ProtoMessage() {}
+
+func (x *GetOccasionsFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOccasionsFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetOccasionsFeedResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetOccasionsFeedResponse) GetWebcalUrl() string {
+	if x != nil {
+		return x.WebcalUrl
+	}
+	return ""
+}
+
+// SyncOccasionsCalendar writes the Islamic occasions of the coming year into a calendar in the customer's CalDAV account,
+// and removes the ones that passed or no longer apply.
+type SyncOccasionsCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncOccasionsCalendarRequest) Reset() {
+	*x = SyncOccasionsCalendarRequest{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncOccasionsCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncOccasionsCalendarRequest) ProtoMessage() {}
+
+func (x *SyncOccasionsCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncOccasionsCalendarRequest.ProtoReflect.Descriptor instead.
+func (*SyncOccasionsCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{11}
+}
+
+type SyncOccasionsCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventsCount int32 `protobuf:"varint,1,opt,name=events_count,json=eventsCount,proto3" json:"events_count,omitempty"`
+}
+
+func (x *SyncOccasionsCalendarResponse) Reset() {
+	*x = SyncOccasionsCalendarResponse{}
+	mi := &file_calendar_v1_calendar_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncOccasionsCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncOccasionsCalendarResponse) ProtoMessage() {}
+
+func (x *SyncOccasionsCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calendar_v1_calendar_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncOccasionsCalendarResponse.ProtoReflect.Descriptor instead.
+func (*SyncOccasionsCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_calendar_v1_calendar_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SyncOccasionsCalendarResponse) GetEventsCount() int32 {
+	if x != nil {
+		return x.EventsCount
+	}
+	return 0
+}
+
 var File_calendar_v1_calendar_proto protoreflect.FileDescriptor
 
 var file_calendar_v1_calendar_proto_rawDesc = []byte{
 	0x0a, 0x1a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61,
 	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x43, 0x61, 0x6c, 0x44, 0x61, 0x76, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x44, 0x61,
-	0x76, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x1b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x72, 0x6c,
-	0x32, 0xdc, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x44, 0x61,
-	0x76, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e,
-	0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x44, 0x61, 0x76,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x61, 0x6c, 0x44, 0x61, 0x76, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x63,
-	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c,
+	0x44, 0x61, 0x76, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x52, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x44, 0x61, 0x76, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x38, 0x0a, 0x1b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50,
+	0x72, 0x61, 0x79, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x63, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0xa6, 0x04,
+	0x0a, 0x0e, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x33, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80,
+	0x56, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x56, 0xc0, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14, 0x12, 0x12, 0x19,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x66,
+	0xc0, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x61, 0x79, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20,
+	0x00, 0x52, 0x11, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x61, 0x73, 0x72, 0x5f, 0x6a, 0x75, 0x72, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x72, 0x4a, 0x75, 0x72, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00,
+	0x52, 0x0b, 0x61, 0x73, 0x72, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x5b, 0x0a,
+	0x13, 0x6a, 0x75, 0x6d, 0x75, 0x61, 0x68, 0x5f, 0x6b, 0x68, 0x75, 0x74, 0x62, 0x61, 0x68, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xba, 0x48, 0x23, 0x72,
+	0x21, 0x32, 0x1f, 0x5e, 0x28, 0x5b, 0x30, 0x31, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7c, 0x32,
+	0x5b, 0x30, 0x2d, 0x33, 0x5d, 0x29, 0x3a, 0x5b, 0x30, 0x2d, 0x35, 0x5d, 0x5b, 0x30, 0x2d, 0x39,
+	0x5d, 0x24, 0x48, 0x00, 0x52, 0x11, 0x6a, 0x75, 0x6d, 0x75, 0x61, 0x68, 0x4b, 0x68, 0x75, 0x74,
+	0x62, 0x61, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x17, 0x6a, 0x75,
+	0x6d, 0x75, 0x61, 0x68, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x1a, 0x05, 0x18, 0xf0, 0x01, 0x28, 0x00, 0x48, 0x01, 0x52, 0x14, 0x6a, 0x75, 0x6d, 0x75, 0x61,
+	0x68, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6a, 0x75, 0x6d, 0x75, 0x61, 0x68, 0x5f, 0x6b, 0x68,
+	0x75, 0x74, 0x62, 0x61, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x6a,
+	0x75, 0x6d, 0x75, 0x61, 0x68, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x6d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x57, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x4f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x63,
+	0x61, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x65,
+	0x62, 0x63, 0x61, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x79, 0x6e, 0x63, 0x4f,
+	0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x42, 0x0a, 0x1d, 0x53, 0x79, 0x6e, 0x63, 0x4f,
+	0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0xdd, 0x02, 0x0a, 0x17,
+	0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x52, 0x41, 0x59, 0x45,
+	0x52, 0x5f, 0x43, 0x41, 0x4c, 0x43, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45,
+	0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x52, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4c,
+	0x43, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f,
+	0x55, 0x4d, 0x4d, 0x5f, 0x41, 0x4c, 0x5f, 0x51, 0x55, 0x52, 0x41, 0x10, 0x01, 0x12, 0x31, 0x0a,
+	0x2d, 0x50, 0x52, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4c, 0x43, 0x55, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4d, 0x55, 0x53, 0x4c, 0x49,
+	0x4d, 0x5f, 0x57, 0x4f, 0x52, 0x4c, 0x44, 0x5f, 0x4c, 0x45, 0x41, 0x47, 0x55, 0x45, 0x10, 0x02,
+	0x12, 0x22, 0x0a, 0x1e, 0x50, 0x52, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4c, 0x43, 0x55,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x49, 0x53,
+	0x4e, 0x41, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x52, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x43,
+	0x41, 0x4c, 0x43, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x45, 0x47, 0x59, 0x50, 0x54, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x41,
+	0x59, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4c, 0x43, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4b, 0x41, 0x52, 0x41, 0x43, 0x48, 0x49, 0x10, 0x05,
+	0x12, 0x24, 0x0a, 0x20, 0x50, 0x52, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4c, 0x43, 0x55,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x4b, 0x55,
+	0x57, 0x41, 0x49, 0x54, 0x10, 0x06, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x52, 0x41, 0x59, 0x45, 0x52,
+	0x5f, 0x43, 0x41, 0x4c, 0x43, 0x55, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x51, 0x41, 0x54, 0x41, 0x52, 0x10, 0x07, 0x2a, 0x5c, 0x0a, 0x0b, 0x41,
+	0x73, 0x72, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x53,
+	0x52, 0x5f, 0x4a, 0x55, 0x52, 0x49, 0x53, 0x54, 0x49, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x53, 0x52, 0x5f,
+	0x4a, 0x55, 0x52, 0x49, 0x53, 0x54, 0x49, 0x43, 0x5f, 0x53, 0x48, 0x41, 0x46, 0x49, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x41, 0x53, 0x52, 0x5f, 0x4a, 0x55, 0x52, 0x49, 0x53, 0x54, 0x49, 0x43,
+	0x5f, 0x48, 0x41, 0x4e, 0x41, 0x46, 0x49, 0x10, 0x02, 0x32, 0xfe, 0x04, 0x0a, 0x0f, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5f, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x44, 0x61, 0x76, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x44, 0x61, 0x76, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x44, 0x61, 0x76, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
+	0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
 	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x61, 0x79,
-	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61,
-	0x64, 0x77, 0x61, 0x6c, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x61, 0x6c, 0x2d, 0x73, 0x70, 0x6f, 0x6f, 0x6e, 0x2f, 0x66, 0x61, 0x6c, 0x61, 0x6b, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
-	0x61, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x28, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x4f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x65, 0x65, 0x64, 0x12, 0x24, 0x2e,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f,
+	0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15, 0x53, 0x79,
+	0x6e, 0x63, 0x4f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x29, 0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x4f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x53, 0x5a, 0x51, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x64, 0x77, 0x61, 0x6c, 0x61,
+	0x70, 0x70, 0x2f, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x73,
+	0x70, 0x6f, 0x6f, 0x6e, 0x2f, 0x66, 0x61, 0x6c, 0x61, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -242,23 +910,48 @@ func file_calendar_v1_calendar_proto_rawDescGZIP() []byte {
 	return file_calendar_v1_calendar_proto_rawDescData
 }
 
-var file_calendar_v1_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_calendar_v1_calendar_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_calendar_v1_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_calendar_v1_calendar_proto_goTypes = []any{
-	(*GetCalDavAccountRequest)(nil),     // 0: calendar.v1.GetCalDavAccountRequest
-	(*GetCalDavAccountResponse)(nil),    // 1: calendar.v1.GetCalDavAccountResponse
-	(*SchedulePrayerTimesRequest)(nil),  // 2: calendar.v1.SchedulePrayerTimesRequest
-	(*SchedulePrayerTimesResponse)(nil), // 3: calendar.v1.SchedulePrayerTimesResponse
+	(PrayerCalculationMethod)(0),          // 0: calendar.v1.PrayerCalculationMethod
+	(AsrJuristic)(0),                      // 1: calendar.v1.AsrJuristic
+	(*GetCalDavAccountRequest)(nil),       // 2: calendar.v1.GetCalDavAccountRequest
+	(*GetCalDavAccountResponse)(nil),      // 3: calendar.v1.GetCalDavAccountResponse
+	(*SchedulePrayerTimesRequest)(nil),    // 4: calendar.v1.SchedulePrayerTimesRequest
+	(*SchedulePrayerTimesResponse)(nil),   // 5: calendar.v1.SchedulePrayerTimesResponse
+	(*PrayerSettings)(nil),                // 6: calendar.v1.PrayerSettings
+	(*UpdatePrayerSettingsRequest)(nil),   // 7: calendar.v1.UpdatePrayerSettingsRequest
+	(*UpdatePrayerSettingsResponse)(nil),  // 8: calendar.v1.UpdatePrayerSettingsResponse
+	(*GetPrayerSettingsRequest)(nil),      // 9: calendar.v1.GetPrayerSettingsRequest
+	(*GetPrayerSettingsResponse)(nil),     // 10: calendar.v1.GetPrayerSettingsResponse
+	(*GetOccasionsFeedRequest)(nil),       // 11: calendar.v1.GetOccasionsFeedRequest
+	(*GetOccasionsFeedResponse)(nil),      // 12: calendar.v1.GetOccasionsFeedResponse
+	(*SyncOccasionsCalendarRequest)(nil),  // 13: calendar.v1.SyncOccasionsCalendarRequest
+	(*SyncOccasionsCalendarResponse)(nil), // 14: calendar.v1.SyncOccasionsCalendarResponse
 }
 var file_calendar_v1_calendar_proto_depIdxs = []int32{
-	0, // 0: calendar.v1.CalendarService.GetCalDavAccount:input_type -> calendar.v1.GetCalDavAccountRequest
-	2, // 1: calendar.v1.CalendarService.SchedulePrayerTimes:input_type -> calendar.v1.SchedulePrayerTimesRequest
-	1, // 2: calendar.v1.CalendarService.GetCalDavAccount:output_type -> calendar.v1.GetCalDavAccountResponse
-	3, // 3: calendar.v1.CalendarService.SchedulePrayerTimes:output_type -> calendar.v1.SchedulePrayerTimesResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: calendar.v1.PrayerSettings.calculation_method:type_name -> calendar.v1.PrayerCalculationMethod
+	1,  // 1: calendar.v1.PrayerSettings.asr_juristic:type_name -> calendar.v1.AsrJuristic
+	6,  // 2: calendar.v1.UpdatePrayerSettingsRequest.settings:type_name -> calendar.v1.PrayerSettings
+	6,  // 3: calendar.v1.UpdatePrayerSettingsResponse.settings:type_name -> calendar.v1.PrayerSettings
+	6,  // 4: calendar.v1.GetPrayerSettingsResponse.settings:type_name -> calendar.v1.PrayerSettings
+	2,  // 5: calendar.v1.CalendarService.GetCalDavAccount:input_type -> calendar.v1.GetCalDavAccountRequest
+	4,  // 6: calendar.v1.CalendarService.SchedulePrayerTimes:input_type -> calendar.v1.SchedulePrayerTimesRequest
+	7,  // 7: calendar.v1.CalendarService.UpdatePrayerSettings:input_type -> calendar.v1.UpdatePrayerSettingsRequest
+	9,  // 8: calendar.v1.CalendarService.GetPrayerSettings:input_type -> calendar.v1.GetPrayerSettingsRequest
+	11, // 9: calendar.v1.CalendarService.GetOccasionsFeed:input_type -> calendar.v1.GetOccasionsFeedRequest
+	13, // 10: calendar.v1.CalendarService.SyncOccasionsCalendar:input_type -> calendar.v1.SyncOccasionsCalendarRequest
+	3,  // 11: calendar.v1.CalendarService.GetCalDavAccount:output_type -> calendar.v1.GetCalDavAccountResponse
+	5,  // 12: calendar.v1.CalendarService.SchedulePrayerTimes:output_type -> calendar.v1.SchedulePrayerTimesResponse
+	8,  // 13: calendar.v1.CalendarService.UpdatePrayerSettings:output_type -> calendar.v1.UpdatePrayerSettingsResponse
+	10, // 14: calendar.v1.CalendarService.GetPrayerSettings:output_type -> calendar.v1.GetPrayerSettingsResponse
+	12, // 15: calendar.v1.CalendarService.GetOccasionsFeed:output_type -> calendar.v1.GetOccasionsFeedResponse
+	14, // 16: calendar.v1.CalendarService.SyncOccasionsCalendar:output_type -> calendar.v1.SyncOccasionsCalendarResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_calendar_v1_calendar_proto_init() }
@@ -266,18 +959,20 @@ func file_calendar_v1_calendar_proto_init() {
 	if File_calendar_v1_calendar_proto != nil {
 		return
 	}
+	file_calendar_v1_calendar_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_calendar_v1_calendar_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      2,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_calendar_v1_calendar_proto_goTypes,
 		DependencyIndexes: file_calendar_v1_calendar_proto_depIdxs,
+		EnumInfos:         file_calendar_v1_calendar_proto_enumTypes,
 		MessageInfos:      file_calendar_v1_calendar_proto_msgTypes,
 	}.Build()
 	File_calendar_v1_calendar_proto = out.File
@@ -39,19 +39,39 @@ const (
 	// CalendarServiceSchedulePrayerTimesProcedure is the fully-qualified name of the CalendarService's
 	// SchedulePrayerTimes RPC.
 	CalendarServiceSchedulePrayerTimesProcedure = "/calendar.v1.CalendarService/SchedulePrayerTimes"
+	// CalendarServiceUpdatePrayerSettingsProcedure is the fully-qualified name of the CalendarService's
+	// UpdatePrayerSettings RPC.
+	CalendarServiceUpdatePrayerSettingsProcedure = "/calendar.v1.CalendarService/UpdatePrayerSettings"
+	// CalendarServiceGetPrayerSettingsProcedure is the fully-qualified name of the CalendarService's
+	// GetPrayerSettings RPC.
+	CalendarServiceGetPrayerSettingsProcedure = "/calendar.v1.CalendarService/GetPrayerSettings"
+	// CalendarServiceGetOccasionsFeedProcedure is the fully-qualified name of the CalendarService's
+	// GetOccasionsFeed RPC.
+	CalendarServiceGetOccasionsFeedProcedure = "/calendar.v1.CalendarService/GetOccasionsFeed"
+	// CalendarServiceSyncOccasionsCalendarProcedure is the fully-qualified name of the
+	// CalendarService's SyncOccasionsCalendar RPC.
+	CalendarServiceSyncOccasionsCalendarProcedure = "/calendar.v1.CalendarService/SyncOccasionsCalendar"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	calendarServiceServiceDescriptor                   = v1.File_calendar_v1_calendar_proto.Services().ByName("CalendarService")
-	calendarServiceGetCalDavAccountMethodDescriptor    = calendarServiceServiceDescriptor.Methods().ByName("GetCalDavAccount")
-	calendarServiceSchedulePrayerTimesMethodDescriptor = calendarServiceServiceDescriptor.Methods().ByName("SchedulePrayerTimes")
+	calendarServiceServiceDescriptor                     = v1.File_calendar_v1_calendar_proto.Services().ByName("CalendarService")
+	calendarServiceGetCalDavAccountMethodDescriptor      = calendarServiceServiceDescriptor.Methods().ByName("GetCalDavAccount")
+	calendarServiceSchedulePrayerTimesMethodDescriptor   = calendarServiceServiceDescriptor.Methods().ByName("SchedulePrayerTimes")
+	calendarServiceUpdatePrayerSettingsMethodDescriptor  = calendarServiceServiceDescriptor.Methods().ByName("UpdatePrayerSettings")
+	calendarServiceGetPrayerSettingsMethodDescriptor     = calendarServiceServiceDescriptor.Methods().ByName("GetPrayerSettings")
+	calendarServiceGetOccasionsFeedMethodDescriptor      = calendarServiceServiceDescriptor.Methods().ByName("GetOccasionsFeed")
+	calendarServiceSyncOccasionsCalendarMethodDescriptor = calendarServiceServiceDescriptor.Methods().ByName("SyncOccasionsCalendar")
 )
 
 // CalendarServiceClient is a client for the calendar.v1.CalendarService service.
 type CalendarServiceClient interface {
 	GetCalDavAccount(context.Context, *connect.Request[v1.GetCalDavAccountRequest]) (*connect.Response[v1.GetCalDavAccountResponse], error)
 	SchedulePrayerTimes(context.Context, *connect.Request[v1.SchedulePrayerTimesRequest]) (*connect.Response[v1.SchedulePrayerTimesResponse], error)
+	UpdatePrayerSettings(context.Context, *connect.Request[v1.UpdatePrayerSettingsRequest]) (*connect.Response[v1.UpdatePrayerSettingsResponse], error)
+	GetPrayerSettings(context.Context, *connect.Request[v1.GetPrayerSettingsRequest]) (*connect.Response[v1.GetPrayerSettingsResponse], error)
+	GetOccasionsFeed(context.Context, *connect.Request[v1.GetOccasionsFeedRequest]) (*connect.Response[v1.GetOccasionsFeedResponse], error)
+	SyncOccasionsCalendar(context.Context, *connect.Request[v1.SyncOccasionsCalendarRequest]) (*connect.Response[v1.SyncOccasionsCalendarResponse], error)
 }
 
 // NewCalendarServiceClient constructs a client for the calendar.v1.CalendarService service. By
@@ -76,13 +96,41 @@ func NewCalendarServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(calendarServiceSchedulePrayerTimesMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		updatePrayerSettings: connect.NewClient[v1.UpdatePrayerSettingsRequest, v1.UpdatePrayerSettingsResponse](
+			httpClient,
+			baseURL+CalendarServiceUpdatePrayerSettingsProcedure,
+			connect.WithSchema(calendarServiceUpdatePrayerSettingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getPrayerSettings: connect.NewClient[v1.GetPrayerSettingsRequest, v1.GetPrayerSettingsResponse](
+			httpClient,
+			baseURL+CalendarServiceGetPrayerSettingsProcedure,
+			connect.WithSchema(calendarServiceGetPrayerSettingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getOccasionsFeed: connect.NewClient[v1.GetOccasionsFeedRequest, v1.GetOccasionsFeedResponse](
+			httpClient,
+			baseURL+CalendarServiceGetOccasionsFeedProcedure,
+			connect.WithSchema(calendarServiceGetOccasionsFeedMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		syncOccasionsCalendar: connect.NewClient[v1.SyncOccasionsCalendarRequest, v1.SyncOccasionsCalendarResponse](
+			httpClient,
+			baseURL+CalendarServiceSyncOccasionsCalendarProcedure,
+			connect.WithSchema(calendarServiceSyncOccasionsCalendarMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // calendarServiceClient implements CalendarServiceClient.
 type calendarServiceClient struct {
-	getCalDavAccount    *connect.Client[v1.GetCalDavAccountRequest, v1.GetCalDavAccountResponse]
-	schedulePrayerTimes *connect.Client[v1.SchedulePrayerTimesRequest, v1.SchedulePrayerTimesResponse]
+	getCalDavAccount      *connect.Client[v1.GetCalDavAccountRequest, v1.GetCalDavAccountResponse]
+	schedulePrayerTimes   *connect.Client[v1.SchedulePrayerTimesRequest, v1.SchedulePrayerTimesResponse]
+	updatePrayerSettings  *connect.Client[v1.UpdatePrayerSettingsRequest, v1.UpdatePrayerSettingsResponse]
+	getPrayerSettings     *connect.Client[v1.GetPrayerSettingsRequest, v1.GetPrayerSettingsResponse]
+	getOccasionsFeed      *connect.Client[v1.GetOccasionsFeedRequest, v1.GetOccasionsFeedResponse]
+	syncOccasionsCalendar *connect.Client[v1.SyncOccasionsCalendarRequest, v1.SyncOccasionsCalendarResponse]
 }
 
 // GetCalDavAccount calls calendar.v1.CalendarService.GetCalDavAccount.
@@ -95,10 +143,34 @@ func (c *calendarServiceClient) SchedulePrayerTimes(ctx context.Context, req *co
 	return c.schedulePrayerTimes.CallUnary(ctx, req)
 }
 
+// UpdatePrayerSettings calls calendar.v1.CalendarService.UpdatePrayerSettings.
+func (c *calendarServiceClient) UpdatePrayerSettings(ctx context.Context, req *connect.Request[v1.UpdatePrayerSettingsRequest]) (*connect.Response[v1.UpdatePrayerSettingsResponse], error) {
+	return c.updatePrayerSettings.CallUnary(ctx, req)
+}
+
+// GetPrayerSettings calls calendar.v1.CalendarService.GetPrayerSettings.
+func (c *calendarServiceClient) GetPrayerSettings(ctx context.Context, req *connect.Request[v1.GetPrayerSettingsRequest]) (*connect.Response[v1.GetPrayerSettingsResponse], error) {
+	return c.getPrayerSettings.CallUnary(ctx, req)
+}
+
+// GetOccasionsFeed calls calendar.v1.CalendarService.GetOccasionsFeed.
+func (c *calendarServiceClient) GetOccasionsFeed(ctx context.Context, req *connect.Request[v1.GetOccasionsFeedRequest]) (*connect.Response[v1.GetOccasionsFeedResponse], error) {
+	return c.getOccasionsFeed.CallUnary(ctx, req)
+}
+
+// SyncOccasionsCalendar calls calendar.v1.CalendarService.SyncOccasionsCalendar.
+func (c *calendarServiceClient) SyncOccasionsCalendar(ctx context.Context, req *connect.Request[v1.SyncOccasionsCalendarRequest]) (*connect.Response[v1.SyncOccasionsCalendarResponse], error) {
+	return c.syncOccasionsCalendar.CallUnary(ctx, req)
+}
+
 // CalendarServiceHandler is an implementation of the calendar.v1.CalendarService service.
 type CalendarServiceHandler interface {
 	GetCalDavAccount(context.Context, *connect.Request[v1.GetCalDavAccountRequest]) (*connect.Response[v1.GetCalDavAccountResponse], error)
 	SchedulePrayerTimes(context.Context, *connect.Request[v1.SchedulePrayerTimesRequest]) (*connect.Response[v1.SchedulePrayerTimesResponse], error)
+	UpdatePrayerSettings(context.Context, *connect.Request[v1.UpdatePrayerSettingsRequest]) (*connect.Response[v1.UpdatePrayerSettingsResponse], error)
+	GetPrayerSettings(context.Context, *connect.Request[v1.GetPrayerSettingsRequest]) (*connect.Response[v1.GetPrayerSettingsResponse], error)
+	GetOccasionsFeed(context.Context, *connect.Request[v1.GetOccasionsFeedRequest]) (*connect.Response[v1.GetOccasionsFeedResponse], error)
+	SyncOccasionsCalendar(context.Context, *connect.Request[v1.SyncOccasionsCalendarRequest]) (*connect.Response[v1.SyncOccasionsCalendarResponse], error)
 }
 
 // NewCalendarServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -119,12 +191,44 @@ func NewCalendarServiceHandler(svc CalendarServiceHandler, opts ...connect.Handl
 		connect.WithSchema(calendarServiceSchedulePrayerTimesMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	calendarServiceUpdatePrayerSettingsHandler := connect.NewUnaryHandler(
+		CalendarServiceUpdatePrayerSettingsProcedure,
+		svc.UpdatePrayerSettings,
+		connect.WithSchema(calendarServiceUpdatePrayerSettingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	calendarServiceGetPrayerSettingsHandler := connect.NewUnaryHandler(
+		CalendarServiceGetPrayerSettingsProcedure,
+		svc.GetPrayerSettings,
+		connect.WithSchema(calendarServiceGetPrayerSettingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	calendarServiceGetOccasionsFeedHandler := connect.NewUnaryHandler(
+		CalendarServiceGetOccasionsFeedProcedure,
+		svc.GetOccasionsFeed,
+		connect.WithSchema(calendarServiceGetOccasionsFeedMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	calendarServiceSyncOccasionsCalendarHandler := connect.NewUnaryHandler(
+		CalendarServiceSyncOccasionsCalendarProcedure,
+		svc.SyncOccasionsCalendar,
+		connect.WithSchema(calendarServiceSyncOccasionsCalendarMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/calendar.v1.CalendarService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CalendarServiceGetCalDavAccountProcedure:
 			calendarServiceGetCalDavAccountHandler.ServeHTTP(w, r)
 		case CalendarServiceSchedulePrayerTimesProcedure:
 			calendarServiceSchedulePrayerTimesHandler.ServeHTTP(w, r)
+		case CalendarServiceUpdatePrayerSettingsProcedure:
+			calendarServiceUpdatePrayerSettingsHandler.ServeHTTP(w, r)
+		case CalendarServiceGetPrayerSettingsProcedure:
+			calendarServiceGetPrayerSettingsHandler.ServeHTTP(w, r)
+		case CalendarServiceGetOccasionsFeedProcedure:
+			calendarServiceGetOccasionsFeedHandler.ServeHTTP(w, r)
+		case CalendarServiceSyncOccasionsCalendarProcedure:
+			calendarServiceSyncOccasionsCalendarHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -141,3 +245,19 @@ func (UnimplementedCalendarServiceHandler) GetCalDavAccount(context.Context, *co
 func (UnimplementedCalendarServiceHandler) SchedulePrayerTimes(context.Context, *connect.Request[v1.SchedulePrayerTimesRequest]) (*connect.Response[v1.SchedulePrayerTimesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("calendar.v1.CalendarService.SchedulePrayerTimes is not implemented"))
 }
+
+func (UnimplementedCalendarServiceHandler) UpdatePrayerSettings(context.Context, *connect.Request[v1.UpdatePrayerSettingsRequest]) (*connect.Response[v1.UpdatePrayerSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("calendar.v1.CalendarService.UpdatePrayerSettings is not implemented"))
+}
+
+func (UnimplementedCalendarServiceHandler) GetPrayerSettings(context.Context, *connect.Request[v1.GetPrayerSettingsRequest]) (*connect.Response[v1.GetPrayerSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("calendar.v1.CalendarService.GetPrayerSettings is not implemented"))
+}
+
+func (UnimplementedCalendarServiceHandler) GetOccasionsFeed(context.Context, *connect.Request[v1.GetOccasionsFeedRequest]) (*connect.Response[v1.GetOccasionsFeedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("calendar.v1.CalendarService.GetOccasionsFeed is not implemented"))
+}
+
+func (UnimplementedCalendarServiceHandler) SyncOccasionsCalendar(context.Context, *connect.Request[v1.SyncOccasionsCalendarRequest]) (*connect.Response[v1.SyncOccasionsCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("calendar.v1.CalendarService.SyncOccasionsCalendar is not implemented"))
+}
@@ -7,6 +7,7 @@
 package profilev1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,6 +21,222 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type HijriDateAnnotation int32
+
+const (
+	HijriDateAnnotation_HIJRI_DATE_ANNOTATION_UNSPECIFIED HijriDateAnnotation = 0
+	HijriDateAnnotation_HIJRI_DATE_ANNOTATION_OFF         HijriDateAnnotation = 1
+	HijriDateAnnotation_HIJRI_DATE_ANNOTATION_ARABIC      HijriDateAnnotation = 2
+	HijriDateAnnotation_HIJRI_DATE_ANNOTATION_ENGLISH     HijriDateAnnotation = 3
+)
+
+// Enum value maps for HijriDateAnnotation.
+var (
+	HijriDateAnnotation_name = map[int32]string{
+		0: "HIJRI_DATE_ANNOTATION_UNSPECIFIED",
+		1: "HIJRI_DATE_ANNOTATION_OFF",
+		2: "HIJRI_DATE_ANNOTATION_ARABIC",
+		3: "HIJRI_DATE_ANNOTATION_ENGLISH",
+	}
+	HijriDateAnnotation_value = map[string]int32{
+		"HIJRI_DATE_ANNOTATION_UNSPECIFIED": 0,
+		"HIJRI_DATE_ANNOTATION_OFF":         1,
+		"HIJRI_DATE_ANNOTATION_ARABIC":      2,
+		"HIJRI_DATE_ANNOTATION_ENGLISH":     3,
+	}
+)
+
+func (x HijriDateAnnotation) Enum() *HijriDateAnnotation {
+	p := new(HijriDateAnnotation)
+	*p = x
+	return p
+}
+
+func (x HijriDateAnnotation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HijriDateAnnotation) Descriptor() protoreflect.EnumDescriptor {
+	return file_profile_v1_profile_proto_enumTypes[0].Descriptor()
+}
+
+func (HijriDateAnnotation) Type() protoreflect.EnumType {
+	return &file_profile_v1_profile_proto_enumTypes[0]
+}
+
+func (x HijriDateAnnotation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HijriDateAnnotation.Descriptor instead.
+func (HijriDateAnnotation) EnumDescriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{0}
+}
+
+type PrayerConflictMode int32
+
+const (
+	PrayerConflictMode_PRAYER_CONFLICT_MODE_UNSPECIFIED PrayerConflictMode = 0
+	PrayerConflictMode_PRAYER_CONFLICT_MODE_OFF         PrayerConflictMode = 1
+	// Send a push notification and add a note to the event
+	PrayerConflictMode_PRAYER_CONFLICT_MODE_WARN PrayerConflictMode = 2
+	// Add a separate event blocking the prayer time
+	PrayerConflictMode_PRAYER_CONFLICT_MODE_BUFFER PrayerConflictMode = 3
+	// Move events with no agreed on time to right after the prayer, the others are warned about
+	PrayerConflictMode_PRAYER_CONFLICT_MODE_SHIFT PrayerConflictMode = 4
+)
+
+// Enum value maps for PrayerConflictMode.
+var (
+	PrayerConflictMode_name = map[int32]string{
+		0: "PRAYER_CONFLICT_MODE_UNSPECIFIED",
+		1: "PRAYER_CONFLICT_MODE_OFF",
+		2: "PRAYER_CONFLICT_MODE_WARN",
+		3: "PRAYER_CONFLICT_MODE_BUFFER",
+		4: "PRAYER_CONFLICT_MODE_SHIFT",
+	}
+	PrayerConflictMode_value = map[string]int32{
+		"PRAYER_CONFLICT_MODE_UNSPECIFIED": 0,
+		"PRAYER_CONFLICT_MODE_OFF":         1,
+		"PRAYER_CONFLICT_MODE_WARN":        2,
+		"PRAYER_CONFLICT_MODE_BUFFER":      3,
+		"PRAYER_CONFLICT_MODE_SHIFT":       4,
+	}
+)
+
+func (x PrayerConflictMode) Enum() *PrayerConflictMode {
+	p := new(PrayerConflictMode)
+	*p = x
+	return p
+}
+
+func (x PrayerConflictMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrayerConflictMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_profile_v1_profile_proto_enumTypes[1].Descriptor()
+}
+
+func (PrayerConflictMode) Type() protoreflect.EnumType {
+	return &file_profile_v1_profile_proto_enumTypes[1]
+}
+
+func (x PrayerConflictMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrayerConflictMode.Descriptor instead.
+func (PrayerConflictMode) EnumDescriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{1}
+}
+
+type EventApprovalMode int32
+
+const (
+	EventApprovalMode_EVENT_APPROVAL_MODE_UNSPECIFIED EventApprovalMode = 0
+	// Events agreed on in WhatsApp chats are added to the calendar right away
+	EventApprovalMode_EVENT_APPROVAL_MODE_AUTO EventApprovalMode = 1
+	// Events agreed on in WhatsApp chats wait for the customer to add or dismiss them
+	EventApprovalMode_EVENT_APPROVAL_MODE_ASK EventApprovalMode = 2
+)
+
+// Enum value maps for EventApprovalMode.
+var (
+	EventApprovalMode_name = map[int32]string{
+		0: "EVENT_APPROVAL_MODE_UNSPECIFIED",
+		1: "EVENT_APPROVAL_MODE_AUTO",
+		2: "EVENT_APPROVAL_MODE_ASK",
+	}
+	EventApprovalMode_value = map[string]int32{
+		"EVENT_APPROVAL_MODE_UNSPECIFIED": 0,
+		"EVENT_APPROVAL_MODE_AUTO":        1,
+		"EVENT_APPROVAL_MODE_ASK":         2,
+	}
+)
+
+func (x EventApprovalMode) Enum() *EventApprovalMode {
+	p := new(EventApprovalMode)
+	*p = x
+	return p
+}
+
+func (x EventApprovalMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventApprovalMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_profile_v1_profile_proto_enumTypes[2].Descriptor()
+}
+
+func (EventApprovalMode) Type() protoreflect.EnumType {
+	return &file_profile_v1_profile_proto_enumTypes[2]
+}
+
+func (x EventApprovalMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventApprovalMode.Descriptor instead.
+func (EventApprovalMode) EnumDescriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{2}
+}
+
+type GroupAgreementRule int32
+
+const (
+	GroupAgreementRule_GROUP_AGREEMENT_RULE_UNSPECIFIED GroupAgreementRule = 0
+	// An event suggested in a group chat is agreed on once the customer agrees to it
+	GroupAgreementRule_GROUP_AGREEMENT_RULE_CUSTOMER GroupAgreementRule = 1
+	// An event suggested in a group chat is agreed on once most of the people talking in the chat agree to it
+	GroupAgreementRule_GROUP_AGREEMENT_RULE_MAJORITY GroupAgreementRule = 2
+	// An event suggested in a group chat is agreed on once the person organizing it confirms it
+	GroupAgreementRule_GROUP_AGREEMENT_RULE_ORGANIZER GroupAgreementRule = 3
+)
+
+// Enum value maps for GroupAgreementRule.
+var (
+	GroupAgreementRule_name = map[int32]string{
+		0: "GROUP_AGREEMENT_RULE_UNSPECIFIED",
+		1: "GROUP_AGREEMENT_RULE_CUSTOMER",
+		2: "GROUP_AGREEMENT_RULE_MAJORITY",
+		3: "GROUP_AGREEMENT_RULE_ORGANIZER",
+	}
+	GroupAgreementRule_value = map[string]int32{
+		"GROUP_AGREEMENT_RULE_UNSPECIFIED": 0,
+		"GROUP_AGREEMENT_RULE_CUSTOMER":    1,
+		"GROUP_AGREEMENT_RULE_MAJORITY":    2,
+		"GROUP_AGREEMENT_RULE_ORGANIZER":   3,
+	}
+)
+
+func (x GroupAgreementRule) Enum() *GroupAgreementRule {
+	p := new(GroupAgreementRule)
+	*p = x
+	return p
+}
+
+func (x GroupAgreementRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupAgreementRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_profile_v1_profile_proto_enumTypes[3].Descriptor()
+}
+
+func (GroupAgreementRule) Type() protoreflect.EnumType {
+	return &file_profile_v1_profile_proto_enumTypes[3]
+}
+
+func (x GroupAgreementRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupAgreementRule.Descriptor instead.
+func (GroupAgreementRule) EnumDescriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{3}
+}
+
 type GetProfileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -61,8 +278,9 @@ type GetProfileResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name     string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email    string    `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	LlmUsage *LlmUsage `protobuf:"bytes,3,opt,name=llm_usage,json=llmUsage,proto3" json:"llm_usage,omitempty"`
 }
 
 func (x *GetProfileResponse) Reset() {
@@ -109,6 +327,77 @@ func (x *GetProfileResponse) GetEmail() string {
 	return ""
 }
 
+func (x *GetProfileResponse) GetLlmUsage() *LlmUsage {
+	if x != nil {
+		return x.LlmUsage
+	}
+	return nil
+}
+
+// How many LLM tokens analyzing the customer's WhatsApp messages used
+type LlmUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokensToday int64 `protobuf:"varint,1,opt,name=tokens_today,json=tokensToday,proto3" json:"tokens_today,omitempty"`
+	// Once tokens_today reaches it the messages are analyzed in a cheaper way until the next day, it is 0 when there
+	// is no quota
+	DailyTokenQuota   int64 `protobuf:"varint,2,opt,name=daily_token_quota,json=dailyTokenQuota,proto3" json:"daily_token_quota,omitempty"`
+	TokensLast_30Days int64 `protobuf:"varint,3,opt,name=tokens_last_30_days,json=tokensLast30Days,proto3" json:"tokens_last_30_days,omitempty"`
+}
+
+func (x *LlmUsage) Reset() {
+	*x = LlmUsage{}
+	mi := &file_profile_v1_profile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LlmUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LlmUsage) ProtoMessage() {}
+
+func (x *LlmUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LlmUsage.ProtoReflect.Descriptor instead.
+func (*LlmUsage) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LlmUsage) GetTokensToday() int64 {
+	if x != nil {
+		return x.TokensToday
+	}
+	return 0
+}
+
+func (x *LlmUsage) GetDailyTokenQuota() int64 {
+	if x != nil {
+		return x.DailyTokenQuota
+	}
+	return 0
+}
+
+func (x *LlmUsage) GetTokensLast_30Days() int64 {
+	if x != nil {
+		return x.TokensLast_30Days
+	}
+	return 0
+}
+
 type AddDeviceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -119,7 +408,7 @@ type AddDeviceRequest struct {
 
 func (x *AddDeviceRequest) Reset() {
 	*x = AddDeviceRequest{}
-	mi := &file_profile_v1_profile_proto_msgTypes[2]
+	mi := &file_profile_v1_profile_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -131,7 +420,7 @@ func (x *AddDeviceRequest) String() string {
 func (*AddDeviceRequest) ProtoMessage() {}
 
 func (x *AddDeviceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_v1_profile_proto_msgTypes[2]
+	mi := &file_profile_v1_profile_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -144,7 +433,7 @@ func (x *AddDeviceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDeviceRequest.ProtoReflect.Descriptor instead.
 func (*AddDeviceRequest) Descriptor() ([]byte, []int) {
-	return file_profile_v1_profile_proto_rawDescGZIP(), []int{2}
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AddDeviceRequest) GetDeviceToken() string {
@@ -162,7 +451,7 @@ type AddDeviceResponse struct {
 
 func (x *AddDeviceResponse) Reset() {
 	*x = AddDeviceResponse{}
-	mi := &file_profile_v1_profile_proto_msgTypes[3]
+	mi := &file_profile_v1_profile_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -174,7 +463,7 @@ func (x *AddDeviceResponse) String() string {
 func (*AddDeviceResponse) ProtoMessage() {}
 
 func (x *AddDeviceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_profile_v1_profile_proto_msgTypes[3]
+	mi := &file_profile_v1_profile_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -187,7 +476,291 @@ func (x *AddDeviceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDeviceResponse.ProtoReflect.Descriptor instead.
 func (*AddDeviceResponse) Descriptor() ([]byte, []int) {
-	return file_profile_v1_profile_proto_rawDescGZIP(), []int{3}
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{4}
+}
+
+// The preferences that are set in UpdatePreferencesRequest are changed and the others are left as they are, they are
+// all set in the responses
+type Preferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the Hijri date is written in the description of the events we add, and in which language
+	HijriDateAnnotation *HijriDateAnnotation `protobuf:"varint,1,opt,name=hijri_date_annotation,json=hijriDateAnnotation,proto3,enum=profile.v1.HijriDateAnnotation,oneof" json:"hijri_date_annotation,omitempty"`
+	// What to do when an event we add overlaps a prayer
+	PrayerConflictMode *PrayerConflictMode `protobuf:"varint,2,opt,name=prayer_conflict_mode,json=prayerConflictMode,proto3,enum=profile.v1.PrayerConflictMode,oneof" json:"prayer_conflict_mode,omitempty"`
+	// Whether events found in WhatsApp chats are added right away or after the customer confirms them
+	EventApprovalMode *EventApprovalMode `protobuf:"varint,3,opt,name=event_approval_mode,json=eventApprovalMode,proto3,enum=profile.v1.EventApprovalMode,oneof" json:"event_approval_mode,omitempty"`
+	// Whether events suggested in WhatsApp chats are held in the calendar as tentative until they are agreed on,
+	// holds are only placed when event_approval_mode is EVENT_APPROVAL_MODE_AUTO
+	TentativeHolds *bool `protobuf:"varint,4,opt,name=tentative_holds,json=tentativeHolds,proto3,oneof" json:"tentative_holds,omitempty"`
+	// When an event suggested in a group chat counts as agreed on, events the customer declined are never added
+	GroupAgreementRule *GroupAgreementRule `protobuf:"varint,5,opt,name=group_agreement_rule,json=groupAgreementRule,proto3,enum=profile.v1.GroupAgreementRule,oneof" json:"group_agreement_rule,omitempty"`
+	// How many hours WhatsApp messages, and the chat summaries and event details made of them, are kept for before
+	// they are deleted, the default retention is used when it is not set. 24 keeps nothing for longer than a day.
+	MessageRetentionHours *int32 `protobuf:"varint,6,opt,name=message_retention_hours,json=messageRetentionHours,proto3,oneof" json:"message_retention_hours,omitempty"`
+}
+
+func (x *Preferences) Reset() {
+	*x = Preferences{}
+	mi := &file_profile_v1_profile_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Preferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preferences) ProtoMessage() {}
+
+func (x *Preferences) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Preferences) GetHijriDateAnnotation() HijriDateAnnotation {
+	if x != nil && x.HijriDateAnnotation != nil {
+		return *x.HijriDateAnnotation
+	}
+	return HijriDateAnnotation_HIJRI_DATE_ANNOTATION_UNSPECIFIED
+}
+
+func (x *Preferences) GetPrayerConflictMode() PrayerConflictMode {
+	if x != nil && x.PrayerConflictMode != nil {
+		return *x.PrayerConflictMode
+	}
+	return PrayerConflictMode_PRAYER_CONFLICT_MODE_UNSPECIFIED
+}
+
+func (x *Preferences) GetEventApprovalMode() EventApprovalMode {
+	if x != nil && x.EventApprovalMode != nil {
+		return *x.EventApprovalMode
+	}
+	return EventApprovalMode_EVENT_APPROVAL_MODE_UNSPECIFIED
+}
+
+func (x *Preferences) GetTentativeHolds() bool {
+	if x != nil && x.TentativeHolds != nil {
+		return *x.TentativeHolds
+	}
+	return false
+}
+
+func (x *Preferences) GetGroupAgreementRule() GroupAgreementRule {
+	if x != nil && x.GroupAgreementRule != nil {
+		return *x.GroupAgreementRule
+	}
+	return GroupAgreementRule_GROUP_AGREEMENT_RULE_UNSPECIFIED
+}
+
+func (x *Preferences) GetMessageRetentionHours() int32 {
+	if x != nil && x.MessageRetentionHours != nil {
+		return *x.MessageRetentionHours
+	}
+	return 0
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{6}
+}
+
+type GetPreferencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences *Preferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// How many hours WhatsApp messages are kept for when message_retention_hours is not set
+	DefaultMessageRetentionHours int32 `protobuf:"varint,2,opt,name=default_message_retention_hours,json=defaultMessageRetentionHours,proto3" json:"default_message_retention_hours,omitempty"`
+}
+
+func (x *GetPreferencesResponse) Reset() {
+	*x = GetPreferencesResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesResponse) ProtoMessage() {}
+
+func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPreferencesResponse) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *GetPreferencesResponse) GetDefaultMessageRetentionHours() int32 {
+	if x != nil {
+		return x.DefaultMessageRetentionHours
+	}
+	return 0
+}
+
+type UpdatePreferencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences *Preferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	// Goes back to the default retention, preferences.message_retention_hours can not be set along with it
+	ResetMessageRetentionHours bool `protobuf:"varint,2,opt,name=reset_message_retention_hours,json=resetMessageRetentionHours,proto3" json:"reset_message_retention_hours,omitempty"`
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_profile_v1_profile_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePreferencesRequest) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdatePreferencesRequest) GetResetMessageRetentionHours() bool {
+	if x != nil {
+		return x.ResetMessageRetentionHours
+	}
+	return false
+}
+
+type UpdatePreferencesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preferences *Preferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+}
+
+func (x *UpdatePreferencesResponse) Reset() {
+	*x = UpdatePreferencesResponse{}
+	mi := &file_profile_v1_profile_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesResponse) ProtoMessage() {}
+
+func (x *UpdatePreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_profile_v1_profile_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_profile_v1_profile_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdatePreferencesResponse) GetPreferences() *Preferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
 }
 
 var File_profile_v1_profile_proto protoreflect.FileDescriptor
@@ -195,33 +768,168 @@ var File_profile_v1_profile_proto protoreflect.FileDescriptor
 var file_profile_v1_profile_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
 	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x35, 0x0a, 0x10, 0x41,
-	0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa7, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6a, 0x61, 0x64, 0x77, 0x61, 0x6c, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x73, 0x70, 0x6f, 0x6f, 0x6e, 0x2f, 0x66, 0x61, 0x6c, 0x61,
-	0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x31, 0x0a, 0x09, 0x6c, 0x6c, 0x6d, 0x5f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6c, 0x6d, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x08, 0x6c, 0x6c, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x08,
+	0x4c, 0x6c, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x54, 0x6f, 0x64, 0x61, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2d, 0x0a, 0x13, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x33, 0x30, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74,
+	0x33, 0x30, 0x44, 0x61, 0x79, 0x73, 0x22, 0x35, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x13, 0x0a,
+	0x11, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xa4, 0x05, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x64, 0x0a, 0x15, 0x68, 0x69, 0x6a, 0x72, 0x69, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x69, 0x6a, 0x72, 0x69, 0x44, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x48, 0x00,
+	0x52, 0x13, 0x68, 0x69, 0x6a, 0x72, 0x69, 0x44, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x61, 0x0a, 0x14, 0x70, 0x72, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01,
+	0x20, 0x00, 0x48, 0x01, 0x52, 0x12, 0x70, 0x72, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x5e, 0x0a, 0x13, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10,
+	0x01, 0x20, 0x00, 0x48, 0x02, 0x52, 0x11, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x74,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0e, 0x74, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x61, 0x0a, 0x14, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10,
+	0x01, 0x20, 0x00, 0x48, 0x04, 0x52, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x17,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x1a, 0x05, 0x18, 0xb8, 0x44, 0x28, 0x18, 0x48, 0x05, 0x52, 0x15, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x68, 0x69, 0x6a, 0x72, 0x69, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x17, 0x0a, 0x15, 0x5f, 0x70, 0x72, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68,
+	0x6f, 0x6c, 0x64, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x42, 0x1a, 0x0a,
+	0x18, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
+	0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x1f, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x1c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22,
+	0xa0, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0b,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x41, 0x0a, 0x1d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x22, 0x56, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2a, 0xa0, 0x01, 0x0a, 0x13, 0x48,
+	0x69, 0x6a, 0x72, 0x69, 0x44, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x21, 0x48, 0x49, 0x4a, 0x52, 0x49, 0x5f, 0x44, 0x41, 0x54, 0x45,
+	0x5f, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x48, 0x49, 0x4a,
+	0x52, 0x49, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x49, 0x4a, 0x52,
+	0x49, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x41, 0x52, 0x41, 0x42, 0x49, 0x43, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x49,
+	0x4a, 0x52, 0x49, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x47, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x03, 0x2a, 0xb8, 0x01,
+	0x0a, 0x12, 0x50, 0x72, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x52, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52,
+	0x41, 0x59, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x41, 0x59,
+	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x41, 0x59, 0x45,
+	0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x42, 0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x41, 0x59,
+	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x10, 0x04, 0x2a, 0x73, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a,
+	0x1f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x52,
+	0x4f, 0x56, 0x41, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x01,
+	0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56,
+	0x41, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53, 0x4b, 0x10, 0x02, 0x2a, 0xa4, 0x01,
+	0x0a, 0x12, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x41, 0x47,
+	0x52, 0x45, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x5f, 0x41, 0x47, 0x52, 0x45, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x55,
+	0x4c, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x45, 0x52, 0x10, 0x01, 0x12, 0x21, 0x0a,
+	0x1d, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x41, 0x47, 0x52, 0x45, 0x45, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x4a, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x02,
+	0x12, 0x22, 0x0a, 0x1e, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x41, 0x47, 0x52, 0x45, 0x45, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a,
+	0x45, 0x52, 0x10, 0x03, 0x32, 0xe2, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x64, 0x77, 0x61, 0x6c, 0x61, 0x70,
+	0x70, 0x2f, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x73, 0x70,
+	0x6f, 0x6f, 0x6e, 0x2f, 0x66, 0x61, 0x6c, 0x61, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -236,23 +944,46 @@ func file_profile_v1_profile_proto_rawDescGZIP() []byte {
 	return file_profile_v1_profile_proto_rawDescData
 }
 
-var file_profile_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_profile_v1_profile_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_profile_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_profile_v1_profile_proto_goTypes = []any{
-	(*GetProfileRequest)(nil),  // 0: profile.v1.GetProfileRequest
-	(*GetProfileResponse)(nil), // 1: profile.v1.GetProfileResponse
-	(*AddDeviceRequest)(nil),   // 2: profile.v1.AddDeviceRequest
-	(*AddDeviceResponse)(nil),  // 3: profile.v1.AddDeviceResponse
+	(HijriDateAnnotation)(0),          // 0: profile.v1.HijriDateAnnotation
+	(PrayerConflictMode)(0),           // 1: profile.v1.PrayerConflictMode
+	(EventApprovalMode)(0),            // 2: profile.v1.EventApprovalMode
+	(GroupAgreementRule)(0),           // 3: profile.v1.GroupAgreementRule
+	(*GetProfileRequest)(nil),         // 4: profile.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),        // 5: profile.v1.GetProfileResponse
+	(*LlmUsage)(nil),                  // 6: profile.v1.LlmUsage
+	(*AddDeviceRequest)(nil),          // 7: profile.v1.AddDeviceRequest
+	(*AddDeviceResponse)(nil),         // 8: profile.v1.AddDeviceResponse
+	(*Preferences)(nil),               // 9: profile.v1.Preferences
+	(*GetPreferencesRequest)(nil),     // 10: profile.v1.GetPreferencesRequest
+	(*GetPreferencesResponse)(nil),    // 11: profile.v1.GetPreferencesResponse
+	(*UpdatePreferencesRequest)(nil),  // 12: profile.v1.UpdatePreferencesRequest
+	(*UpdatePreferencesResponse)(nil), // 13: profile.v1.UpdatePreferencesResponse
 }
 var file_profile_v1_profile_proto_depIdxs = []int32{
-	0, // 0: profile.v1.ProfileService.GetProfile:input_type -> profile.v1.GetProfileRequest
-	2, // 1: profile.v1.ProfileService.AddDevice:input_type -> profile.v1.AddDeviceRequest
-	1, // 2: profile.v1.ProfileService.GetProfile:output_type -> profile.v1.GetProfileResponse
-	3, // 3: profile.v1.ProfileService.AddDevice:output_type -> profile.v1.AddDeviceResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6,  // 0: profile.v1.GetProfileResponse.llm_usage:type_name -> profile.v1.LlmUsage
+	0,  // 1: profile.v1.Preferences.hijri_date_annotation:type_name -> profile.v1.HijriDateAnnotation
+	1,  // 2: profile.v1.Preferences.prayer_conflict_mode:type_name -> profile.v1.PrayerConflictMode
+	2,  // 3: profile.v1.Preferences.event_approval_mode:type_name -> profile.v1.EventApprovalMode
+	3,  // 4: profile.v1.Preferences.group_agreement_rule:type_name -> profile.v1.GroupAgreementRule
+	9,  // 5: profile.v1.GetPreferencesResponse.preferences:type_name -> profile.v1.Preferences
+	9,  // 6: profile.v1.UpdatePreferencesRequest.preferences:type_name -> profile.v1.Preferences
+	9,  // 7: profile.v1.UpdatePreferencesResponse.preferences:type_name -> profile.v1.Preferences
+	4,  // 8: profile.v1.ProfileService.GetProfile:input_type -> profile.v1.GetProfileRequest
+	7,  // 9: profile.v1.ProfileService.AddDevice:input_type -> profile.v1.AddDeviceRequest
+	10, // 10: profile.v1.ProfileService.GetPreferences:input_type -> profile.v1.GetPreferencesRequest
+	12, // 11: profile.v1.ProfileService.UpdatePreferences:input_type -> profile.v1.UpdatePreferencesRequest
+	5,  // 12: profile.v1.ProfileService.GetProfile:output_type -> profile.v1.GetProfileResponse
+	8,  // 13: profile.v1.ProfileService.AddDevice:output_type -> profile.v1.AddDeviceResponse
+	11, // 14: profile.v1.ProfileService.GetPreferences:output_type -> profile.v1.GetPreferencesResponse
+	13, // 15: profile.v1.ProfileService.UpdatePreferences:output_type -> profile.v1.UpdatePreferencesResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_profile_v1_profile_proto_init() }
@@ -260,18 +991,20 @@ func file_profile_v1_profile_proto_init() {
 	if File_profile_v1_profile_proto != nil {
 		return
 	}
+	file_profile_v1_profile_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_profile_v1_profile_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_profile_v1_profile_proto_goTypes,
 		DependencyIndexes: file_profile_v1_profile_proto_depIdxs,
+		EnumInfos:         file_profile_v1_profile_proto_enumTypes,
 		MessageInfos:      file_profile_v1_profile_proto_msgTypes,
 	}.Build()
 	File_profile_v1_profile_proto = out.File
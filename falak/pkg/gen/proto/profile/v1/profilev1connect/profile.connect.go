@@ -39,19 +39,29 @@ const (
 	// ProfileServiceAddDeviceProcedure is the fully-qualified name of the ProfileService's AddDevice
 	// RPC.
 	ProfileServiceAddDeviceProcedure = "/profile.v1.ProfileService/AddDevice"
+	// ProfileServiceGetPreferencesProcedure is the fully-qualified name of the ProfileService's
+	// GetPreferences RPC.
+	ProfileServiceGetPreferencesProcedure = "/profile.v1.ProfileService/GetPreferences"
+	// ProfileServiceUpdatePreferencesProcedure is the fully-qualified name of the ProfileService's
+	// UpdatePreferences RPC.
+	ProfileServiceUpdatePreferencesProcedure = "/profile.v1.ProfileService/UpdatePreferences"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	profileServiceServiceDescriptor          = v1.File_profile_v1_profile_proto.Services().ByName("ProfileService")
-	profileServiceGetProfileMethodDescriptor = profileServiceServiceDescriptor.Methods().ByName("GetProfile")
-	profileServiceAddDeviceMethodDescriptor  = profileServiceServiceDescriptor.Methods().ByName("AddDevice")
+	profileServiceServiceDescriptor                 = v1.File_profile_v1_profile_proto.Services().ByName("ProfileService")
+	profileServiceGetProfileMethodDescriptor        = profileServiceServiceDescriptor.Methods().ByName("GetProfile")
+	profileServiceAddDeviceMethodDescriptor         = profileServiceServiceDescriptor.Methods().ByName("AddDevice")
+	profileServiceGetPreferencesMethodDescriptor    = profileServiceServiceDescriptor.Methods().ByName("GetPreferences")
+	profileServiceUpdatePreferencesMethodDescriptor = profileServiceServiceDescriptor.Methods().ByName("UpdatePreferences")
 )
 
 // ProfileServiceClient is a client for the profile.v1.ProfileService service.
 type ProfileServiceClient interface {
 	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
 	AddDevice(context.Context, *connect.Request[v1.AddDeviceRequest]) (*connect.Response[v1.AddDeviceResponse], error)
+	GetPreferences(context.Context, *connect.Request[v1.GetPreferencesRequest]) (*connect.Response[v1.GetPreferencesResponse], error)
+	UpdatePreferences(context.Context, *connect.Request[v1.UpdatePreferencesRequest]) (*connect.Response[v1.UpdatePreferencesResponse], error)
 }
 
 // NewProfileServiceClient constructs a client for the profile.v1.ProfileService service. By
@@ -76,13 +86,27 @@ func NewProfileServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(profileServiceAddDeviceMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getPreferences: connect.NewClient[v1.GetPreferencesRequest, v1.GetPreferencesResponse](
+			httpClient,
+			baseURL+ProfileServiceGetPreferencesProcedure,
+			connect.WithSchema(profileServiceGetPreferencesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updatePreferences: connect.NewClient[v1.UpdatePreferencesRequest, v1.UpdatePreferencesResponse](
+			httpClient,
+			baseURL+ProfileServiceUpdatePreferencesProcedure,
+			connect.WithSchema(profileServiceUpdatePreferencesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // profileServiceClient implements ProfileServiceClient.
 type profileServiceClient struct {
-	getProfile *connect.Client[v1.GetProfileRequest, v1.GetProfileResponse]
-	addDevice  *connect.Client[v1.AddDeviceRequest, v1.AddDeviceResponse]
+	getProfile        *connect.Client[v1.GetProfileRequest, v1.GetProfileResponse]
+	addDevice         *connect.Client[v1.AddDeviceRequest, v1.AddDeviceResponse]
+	getPreferences    *connect.Client[v1.GetPreferencesRequest, v1.GetPreferencesResponse]
+	updatePreferences *connect.Client[v1.UpdatePreferencesRequest, v1.UpdatePreferencesResponse]
 }
 
 // GetProfile calls profile.v1.ProfileService.GetProfile.
@@ -95,10 +119,22 @@ func (c *profileServiceClient) AddDevice(ctx context.Context, req *connect.Reque
 	return c.addDevice.CallUnary(ctx, req)
 }
 
+// GetPreferences calls profile.v1.ProfileService.GetPreferences.
+func (c *profileServiceClient) GetPreferences(ctx context.Context, req *connect.Request[v1.GetPreferencesRequest]) (*connect.Response[v1.GetPreferencesResponse], error) {
+	return c.getPreferences.CallUnary(ctx, req)
+}
+
+// UpdatePreferences calls profile.v1.ProfileService.UpdatePreferences.
+func (c *profileServiceClient) UpdatePreferences(ctx context.Context, req *connect.Request[v1.UpdatePreferencesRequest]) (*connect.Response[v1.UpdatePreferencesResponse], error) {
+	return c.updatePreferences.CallUnary(ctx, req)
+}
+
 // ProfileServiceHandler is an implementation of the profile.v1.ProfileService service.
 type ProfileServiceHandler interface {
 	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
 	AddDevice(context.Context, *connect.Request[v1.AddDeviceRequest]) (*connect.Response[v1.AddDeviceResponse], error)
+	GetPreferences(context.Context, *connect.Request[v1.GetPreferencesRequest]) (*connect.Response[v1.GetPreferencesResponse], error)
+	UpdatePreferences(context.Context, *connect.Request[v1.UpdatePreferencesRequest]) (*connect.Response[v1.UpdatePreferencesResponse], error)
 }
 
 // NewProfileServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -119,12 +155,28 @@ func NewProfileServiceHandler(svc ProfileServiceHandler, opts ...connect.Handler
 		connect.WithSchema(profileServiceAddDeviceMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	profileServiceGetPreferencesHandler := connect.NewUnaryHandler(
+		ProfileServiceGetPreferencesProcedure,
+		svc.GetPreferences,
+		connect.WithSchema(profileServiceGetPreferencesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServiceUpdatePreferencesHandler := connect.NewUnaryHandler(
+		ProfileServiceUpdatePreferencesProcedure,
+		svc.UpdatePreferences,
+		connect.WithSchema(profileServiceUpdatePreferencesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/profile.v1.ProfileService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ProfileServiceGetProfileProcedure:
 			profileServiceGetProfileHandler.ServeHTTP(w, r)
 		case ProfileServiceAddDeviceProcedure:
 			profileServiceAddDeviceHandler.ServeHTTP(w, r)
+		case ProfileServiceGetPreferencesProcedure:
+			profileServiceGetPreferencesHandler.ServeHTTP(w, r)
+		case ProfileServiceUpdatePreferencesProcedure:
+			profileServiceUpdatePreferencesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -141,3 +193,11 @@ func (UnimplementedProfileServiceHandler) GetProfile(context.Context, *connect.R
 func (UnimplementedProfileServiceHandler) AddDevice(context.Context, *connect.Request[v1.AddDeviceRequest]) (*connect.Response[v1.AddDeviceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("profile.v1.ProfileService.AddDevice is not implemented"))
 }
+
+func (UnimplementedProfileServiceHandler) GetPreferences(context.Context, *connect.Request[v1.GetPreferencesRequest]) (*connect.Response[v1.GetPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("profile.v1.ProfileService.GetPreferences is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) UpdatePreferences(context.Context, *connect.Request[v1.UpdatePreferencesRequest]) (*connect.Response[v1.UpdatePreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("profile.v1.ProfileService.UpdatePreferences is not implemented"))
+}
@@ -7,8 +7,10 @@
 package whatsappv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +22,244 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DetectedEventState int32
+
+const (
+	DetectedEventState_DETECTED_EVENT_STATE_UNSPECIFIED DetectedEventState = 0
+	DetectedEventState_DETECTED_EVENT_STATE_PROPOSED    DetectedEventState = 1
+	DetectedEventState_DETECTED_EVENT_STATE_ADDED       DetectedEventState = 2
+	DetectedEventState_DETECTED_EVENT_STATE_UPDATED     DetectedEventState = 3
+	DetectedEventState_DETECTED_EVENT_STATE_CANCELLED   DetectedEventState = 4
+	DetectedEventState_DETECTED_EVENT_STATE_REJECTED    DetectedEventState = 5
+	// waiting for the customer to add or dismiss it
+	DetectedEventState_DETECTED_EVENT_STATE_PENDING DetectedEventState = 6
+	// the customer did not add or dismiss it before it started
+	DetectedEventState_DETECTED_EVENT_STATE_EXPIRED DetectedEventState = 7
+	// held in the calendar as tentative while the chat has not agreed on it yet
+	DetectedEventState_DETECTED_EVENT_STATE_TENTATIVE DetectedEventState = 8
+)
+
+// Enum value maps for DetectedEventState.
+var (
+	DetectedEventState_name = map[int32]string{
+		0: "DETECTED_EVENT_STATE_UNSPECIFIED",
+		1: "DETECTED_EVENT_STATE_PROPOSED",
+		2: "DETECTED_EVENT_STATE_ADDED",
+		3: "DETECTED_EVENT_STATE_UPDATED",
+		4: "DETECTED_EVENT_STATE_CANCELLED",
+		5: "DETECTED_EVENT_STATE_REJECTED",
+		6: "DETECTED_EVENT_STATE_PENDING",
+		7: "DETECTED_EVENT_STATE_EXPIRED",
+		8: "DETECTED_EVENT_STATE_TENTATIVE",
+	}
+	DetectedEventState_value = map[string]int32{
+		"DETECTED_EVENT_STATE_UNSPECIFIED": 0,
+		"DETECTED_EVENT_STATE_PROPOSED":    1,
+		"DETECTED_EVENT_STATE_ADDED":       2,
+		"DETECTED_EVENT_STATE_UPDATED":     3,
+		"DETECTED_EVENT_STATE_CANCELLED":   4,
+		"DETECTED_EVENT_STATE_REJECTED":    5,
+		"DETECTED_EVENT_STATE_PENDING":     6,
+		"DETECTED_EVENT_STATE_EXPIRED":     7,
+		"DETECTED_EVENT_STATE_TENTATIVE":   8,
+	}
+)
+
+func (x DetectedEventState) Enum() *DetectedEventState {
+	p := new(DetectedEventState)
+	*p = x
+	return p
+}
+
+func (x DetectedEventState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DetectedEventState) Descriptor() protoreflect.EnumDescriptor {
+	return file_whatsapp_v1_whatsapp_proto_enumTypes[0].Descriptor()
+}
+
+func (DetectedEventState) Type() protoreflect.EnumType {
+	return &file_whatsapp_v1_whatsapp_proto_enumTypes[0]
+}
+
+func (x DetectedEventState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DetectedEventState.Descriptor instead.
+func (DetectedEventState) EnumDescriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{0}
+}
+
+type ChatMonitoring int32
+
+const (
+	ChatMonitoring_CHAT_MONITORING_UNSPECIFIED ChatMonitoring = 0
+	// The chat follows the default monitoring mode
+	ChatMonitoring_CHAT_MONITORING_DEFAULT ChatMonitoring = 1
+	// The chat is always monitored, it is on the allowlist
+	ChatMonitoring_CHAT_MONITORING_INCLUDED ChatMonitoring = 2
+	// The chat is never monitored and its stored messages are deleted, it is on the blocklist
+	ChatMonitoring_CHAT_MONITORING_EXCLUDED ChatMonitoring = 3
+	// The chat is not monitored until muted_until, or until it is changed when muted_until is not set. The messages
+	// stored before it was muted are kept.
+	ChatMonitoring_CHAT_MONITORING_MUTED ChatMonitoring = 4
+)
+
+// Enum value maps for ChatMonitoring.
+var (
+	ChatMonitoring_name = map[int32]string{
+		0: "CHAT_MONITORING_UNSPECIFIED",
+		1: "CHAT_MONITORING_DEFAULT",
+		2: "CHAT_MONITORING_INCLUDED",
+		3: "CHAT_MONITORING_EXCLUDED",
+		4: "CHAT_MONITORING_MUTED",
+	}
+	ChatMonitoring_value = map[string]int32{
+		"CHAT_MONITORING_UNSPECIFIED": 0,
+		"CHAT_MONITORING_DEFAULT":     1,
+		"CHAT_MONITORING_INCLUDED":    2,
+		"CHAT_MONITORING_EXCLUDED":    3,
+		"CHAT_MONITORING_MUTED":       4,
+	}
+)
+
+func (x ChatMonitoring) Enum() *ChatMonitoring {
+	p := new(ChatMonitoring)
+	*p = x
+	return p
+}
+
+func (x ChatMonitoring) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatMonitoring) Descriptor() protoreflect.EnumDescriptor {
+	return file_whatsapp_v1_whatsapp_proto_enumTypes[1].Descriptor()
+}
+
+func (ChatMonitoring) Type() protoreflect.EnumType {
+	return &file_whatsapp_v1_whatsapp_proto_enumTypes[1]
+}
+
+func (x ChatMonitoring) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatMonitoring.Descriptor instead.
+func (ChatMonitoring) EnumDescriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{1}
+}
+
+type MonitoringMode int32
+
+const (
+	MonitoringMode_MONITORING_MODE_UNSPECIFIED MonitoringMode = 0
+	// Every chat is monitored except the excluded and muted ones
+	MonitoringMode_MONITORING_MODE_ALL MonitoringMode = 1
+	// Only the included chats are monitored
+	MonitoringMode_MONITORING_MODE_ALLOWLIST MonitoringMode = 2
+)
+
+// Enum value maps for MonitoringMode.
+var (
+	MonitoringMode_name = map[int32]string{
+		0: "MONITORING_MODE_UNSPECIFIED",
+		1: "MONITORING_MODE_ALL",
+		2: "MONITORING_MODE_ALLOWLIST",
+	}
+	MonitoringMode_value = map[string]int32{
+		"MONITORING_MODE_UNSPECIFIED": 0,
+		"MONITORING_MODE_ALL":         1,
+		"MONITORING_MODE_ALLOWLIST":   2,
+	}
+)
+
+func (x MonitoringMode) Enum() *MonitoringMode {
+	p := new(MonitoringMode)
+	*p = x
+	return p
+}
+
+func (x MonitoringMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MonitoringMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_whatsapp_v1_whatsapp_proto_enumTypes[2].Descriptor()
+}
+
+func (MonitoringMode) Type() protoreflect.EnumType {
+	return &file_whatsapp_v1_whatsapp_proto_enumTypes[2]
+}
+
+func (x MonitoringMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MonitoringMode.Descriptor instead.
+func (MonitoringMode) EnumDescriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{2}
+}
+
+type ChatImportStatus int32
+
+const (
+	ChatImportStatus_CHAT_IMPORT_STATUS_UNSPECIFIED ChatImportStatus = 0
+	// waiting for its turn to be analyzed
+	ChatImportStatus_CHAT_IMPORT_STATUS_PENDING   ChatImportStatus = 1
+	ChatImportStatus_CHAT_IMPORT_STATUS_ANALYZING ChatImportStatus = 2
+	// the events found in the chat are ready to be confirmed or rejected
+	ChatImportStatus_CHAT_IMPORT_STATUS_DONE   ChatImportStatus = 3
+	ChatImportStatus_CHAT_IMPORT_STATUS_FAILED ChatImportStatus = 4
+)
+
+// Enum value maps for ChatImportStatus.
+var (
+	ChatImportStatus_name = map[int32]string{
+		0: "CHAT_IMPORT_STATUS_UNSPECIFIED",
+		1: "CHAT_IMPORT_STATUS_PENDING",
+		2: "CHAT_IMPORT_STATUS_ANALYZING",
+		3: "CHAT_IMPORT_STATUS_DONE",
+		4: "CHAT_IMPORT_STATUS_FAILED",
+	}
+	ChatImportStatus_value = map[string]int32{
+		"CHAT_IMPORT_STATUS_UNSPECIFIED": 0,
+		"CHAT_IMPORT_STATUS_PENDING":     1,
+		"CHAT_IMPORT_STATUS_ANALYZING":   2,
+		"CHAT_IMPORT_STATUS_DONE":        3,
+		"CHAT_IMPORT_STATUS_FAILED":      4,
+	}
+)
+
+func (x ChatImportStatus) Enum() *ChatImportStatus {
+	p := new(ChatImportStatus)
+	*p = x
+	return p
+}
+
+func (x ChatImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_whatsapp_v1_whatsapp_proto_enumTypes[3].Descriptor()
+}
+
+func (ChatImportStatus) Type() protoreflect.EnumType {
+	return &file_whatsapp_v1_whatsapp_proto_enumTypes[3]
+}
+
+func (x ChatImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatImportStatus.Descriptor instead.
+func (ChatImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{3}
+}
+
 type ConnectWhatsappAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -303,68 +543,1691 @@ func (x *GetWhatsappAccountResponse) GetIsAuthenticated() bool {
 	return false
 }
 
-var File_whatsapp_v1_whatsapp_proto protoreflect.FileDescriptor
+type DetectedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_whatsapp_v1_whatsapp_proto_rawDesc = []byte{
-	0x0a, 0x1a, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x68,
-	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x77, 0x68,
-	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x22, 0x37, 0x0a, 0x1d, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f,
-	0x62, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69,
-	0x6c, 0x65, 0x22, 0x43, 0x0a, 0x1e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61,
-	0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72,
-	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x23, 0x0a, 0x21, 0x44,
-	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70,
-	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd4, 0x01,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70,
-	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x73, 0x5f,
-	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x32, 0xe7, 0x02, 0x0a, 0x0f, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70,
-	0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
-	0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x19, 0x44,
-	0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70,
-	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73,
-	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61,
-	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x68,
-	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x2e,
-	0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57,
-	0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x53,
-	0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x64,
-	0x77, 0x61, 0x6c, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x61, 0x6c, 0x2d, 0x73, 0x70, 0x6f, 0x6f, 0x6e, 0x2f, 0x66, 0x61, 0x6c, 0x61, 0x6b, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x68, 0x61,
-	0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70,
-	0x70, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ChatId string `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// opens the conversation the event was found in, empty when the chat can't be linked to
+	ChatUrl string             `protobuf:"bytes,3,opt,name=chat_url,json=chatUrl,proto3" json:"chat_url,omitempty"`
+	State   DetectedEventState `protobuf:"varint,4,opt,name=state,proto3,enum=whatsapp.v1.DetectedEventState" json:"state,omitempty"`
+	// title, start_time and end_time are not set for events that were rejected before any details were agreed
+	Title     string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// UID of the event in the WhatsApp calendar, empty when it never made it to the calendar
+	EventUid   string                 `protobuf:"bytes,8,opt,name=event_uid,json=eventUid,proto3" json:"event_uid,omitempty"`
+	DetectedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	// where the chat agreed to meet, empty when no place was mentioned
+	Location string `protobuf:"bytes,10,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *DetectedEvent) Reset() {
+	*x = DetectedEvent{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetectedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetectedEvent) ProtoMessage() {}
+
+func (x *DetectedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetectedEvent.ProtoReflect.Descriptor instead.
+func (*DetectedEvent) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DetectedEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetChatUrl() string {
+	if x != nil {
+		return x.ChatUrl
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetState() DetectedEventState {
+	if x != nil {
+		return x.State
+	}
+	return DetectedEventState_DETECTED_EVENT_STATE_UNSPECIFIED
+}
+
+func (x *DetectedEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DetectedEvent) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DetectedEvent) GetEventUid() string {
+	if x != nil {
+		return x.EventUid
+	}
+	return ""
+}
+
+func (x *DetectedEvent) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *DetectedEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+// ListDetectedEvents returns the events found in the customer's chats, the most recent first.
+type ListDetectedEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// defaults to 50 when not set
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListDetectedEventsRequest) Reset() {
+	*x = ListDetectedEventsRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDetectedEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDetectedEventsRequest) ProtoMessage() {}
+
+func (x *ListDetectedEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDetectedEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListDetectedEventsRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListDetectedEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDetectedEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*DetectedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListDetectedEventsResponse) Reset() {
+	*x = ListDetectedEventsResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDetectedEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDetectedEventsResponse) ProtoMessage() {}
+
+func (x *ListDetectedEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDetectedEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListDetectedEventsResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListDetectedEventsResponse) GetEvents() []*DetectedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// ConfirmDetectedEvent adds a pending detected event to the WhatsApp calendar.
+type ConfirmDetectedEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ConfirmDetectedEventRequest) Reset() {
+	*x = ConfirmDetectedEventRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmDetectedEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmDetectedEventRequest) ProtoMessage() {}
+
+func (x *ConfirmDetectedEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmDetectedEventRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmDetectedEventRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConfirmDetectedEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ConfirmDetectedEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *DetectedEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *ConfirmDetectedEventResponse) Reset() {
+	*x = ConfirmDetectedEventResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmDetectedEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmDetectedEventResponse) ProtoMessage() {}
+
+func (x *ConfirmDetectedEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmDetectedEventResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmDetectedEventResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfirmDetectedEventResponse) GetEvent() *DetectedEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// RejectDetectedEvent dismisses a pending detected event.
+type RejectDetectedEventRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RejectDetectedEventRequest) Reset() {
+	*x = RejectDetectedEventRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectDetectedEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectDetectedEventRequest) ProtoMessage() {}
+
+func (x *RejectDetectedEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectDetectedEventRequest.ProtoReflect.Descriptor instead.
+func (*RejectDetectedEventRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RejectDetectedEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RejectDetectedEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event *DetectedEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *RejectDetectedEventResponse) Reset() {
+	*x = RejectDetectedEventResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectDetectedEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectDetectedEventResponse) ProtoMessage() {}
+
+func (x *RejectDetectedEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectDetectedEventResponse.ProtoReflect.Descriptor instead.
+func (*RejectDetectedEventResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RejectDetectedEventResponse) GetEvent() *DetectedEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// Chat is a WhatsApp chat of the customer, only its name and when it was last active are kept for the chats that are
+// not monitored
+type Chat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId string `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	// empty when WhatsApp did not give the chat a name
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsGroup    bool                   `protobuf:"varint,3,opt,name=is_group,json=isGroup,proto3" json:"is_group,omitempty"`
+	Monitoring ChatMonitoring         `protobuf:"varint,4,opt,name=monitoring,proto3,enum=whatsapp.v1.ChatMonitoring" json:"monitoring,omitempty"`
+	MutedUntil *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+	// whether new messages of the chat are analyzed, following the monitoring of the chat and the default mode
+	IsMonitored   bool                   `protobuf:"varint,6,opt,name=is_monitored,json=isMonitored,proto3" json:"is_monitored,omitempty"`
+	LastMessageAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_message_at,json=lastMessageAt,proto3" json:"last_message_at,omitempty"`
+}
+
+func (x *Chat) Reset() {
+	*x = Chat{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chat) ProtoMessage() {}
+
+func (x *Chat) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chat.ProtoReflect.Descriptor instead.
+func (*Chat) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Chat) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *Chat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Chat) GetIsGroup() bool {
+	if x != nil {
+		return x.IsGroup
+	}
+	return false
+}
+
+func (x *Chat) GetMonitoring() ChatMonitoring {
+	if x != nil {
+		return x.Monitoring
+	}
+	return ChatMonitoring_CHAT_MONITORING_UNSPECIFIED
+}
+
+func (x *Chat) GetMutedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+func (x *Chat) GetIsMonitored() bool {
+	if x != nil {
+		return x.IsMonitored
+	}
+	return false
+}
+
+func (x *Chat) GetLastMessageAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastMessageAt
+	}
+	return nil
+}
+
+// ListChats returns the customer's chats, the most recently active first.
+type ListChatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// defaults to 100 when not set
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListChatsRequest) Reset() {
+	*x = ListChatsRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatsRequest) ProtoMessage() {}
+
+func (x *ListChatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatsRequest.ProtoReflect.Descriptor instead.
+func (*ListChatsRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListChatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListChatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chats                 []*Chat        `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"`
+	DefaultMonitoringMode MonitoringMode `protobuf:"varint,2,opt,name=default_monitoring_mode,json=defaultMonitoringMode,proto3,enum=whatsapp.v1.MonitoringMode" json:"default_monitoring_mode,omitempty"`
+}
+
+func (x *ListChatsResponse) Reset() {
+	*x = ListChatsResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChatsResponse) ProtoMessage() {}
+
+func (x *ListChatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChatsResponse.ProtoReflect.Descriptor instead.
+func (*ListChatsResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListChatsResponse) GetChats() []*Chat {
+	if x != nil {
+		return x.Chats
+	}
+	return nil
+}
+
+func (x *ListChatsResponse) GetDefaultMonitoringMode() MonitoringMode {
+	if x != nil {
+		return x.DefaultMonitoringMode
+	}
+	return MonitoringMode_MONITORING_MODE_UNSPECIFIED
+}
+
+// SetChatMonitoring changes whether a chat is monitored, excluding a chat deletes its stored messages right away.
+type SetChatMonitoringRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatId     string         `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Monitoring ChatMonitoring `protobuf:"varint,2,opt,name=monitoring,proto3,enum=whatsapp.v1.ChatMonitoring" json:"monitoring,omitempty"`
+	// only used with CHAT_MONITORING_MUTED, the chat stays muted until it is changed when it is not set
+	MutedUntil *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=muted_until,json=mutedUntil,proto3" json:"muted_until,omitempty"`
+}
+
+func (x *SetChatMonitoringRequest) Reset() {
+	*x = SetChatMonitoringRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatMonitoringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatMonitoringRequest) ProtoMessage() {}
+
+func (x *SetChatMonitoringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatMonitoringRequest.ProtoReflect.Descriptor instead.
+func (*SetChatMonitoringRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetChatMonitoringRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *SetChatMonitoringRequest) GetMonitoring() ChatMonitoring {
+	if x != nil {
+		return x.Monitoring
+	}
+	return ChatMonitoring_CHAT_MONITORING_UNSPECIFIED
+}
+
+func (x *SetChatMonitoringRequest) GetMutedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MutedUntil
+	}
+	return nil
+}
+
+type SetChatMonitoringResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chat *Chat `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+}
+
+func (x *SetChatMonitoringResponse) Reset() {
+	*x = SetChatMonitoringResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatMonitoringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatMonitoringResponse) ProtoMessage() {}
+
+func (x *SetChatMonitoringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatMonitoringResponse.ProtoReflect.Descriptor instead.
+func (*SetChatMonitoringResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetChatMonitoringResponse) GetChat() *Chat {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+// SetDefaultMonitoringMode changes which chats are monitored when they are not set otherwise, switching to
+// MONITORING_MODE_ALLOWLIST deletes the stored messages of every chat that is not included right away.
+type SetDefaultMonitoringModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode MonitoringMode `protobuf:"varint,1,opt,name=mode,proto3,enum=whatsapp.v1.MonitoringMode" json:"mode,omitempty"`
+}
+
+func (x *SetDefaultMonitoringModeRequest) Reset() {
+	*x = SetDefaultMonitoringModeRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultMonitoringModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultMonitoringModeRequest) ProtoMessage() {}
+
+func (x *SetDefaultMonitoringModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultMonitoringModeRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultMonitoringModeRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetDefaultMonitoringModeRequest) GetMode() MonitoringMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MonitoringMode_MONITORING_MODE_UNSPECIFIED
+}
+
+type SetDefaultMonitoringModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode MonitoringMode `protobuf:"varint,1,opt,name=mode,proto3,enum=whatsapp.v1.MonitoringMode" json:"mode,omitempty"`
+}
+
+func (x *SetDefaultMonitoringModeResponse) Reset() {
+	*x = SetDefaultMonitoringModeResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultMonitoringModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultMonitoringModeResponse) ProtoMessage() {}
+
+func (x *SetDefaultMonitoringModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultMonitoringModeResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultMonitoringModeResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetDefaultMonitoringModeResponse) GetMode() MonitoringMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MonitoringMode_MONITORING_MODE_UNSPECIFIED
+}
+
+type ChatImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// empty when it could not be told from the exported file
+	ChatName      string           `protobuf:"bytes,2,opt,name=chat_name,json=chatName,proto3" json:"chat_name,omitempty"`
+	Status        ChatImportStatus `protobuf:"varint,3,opt,name=status,proto3,enum=whatsapp.v1.ChatImportStatus" json:"status,omitempty"`
+	MessagesCount int32            `protobuf:"varint,4,opt,name=messages_count,json=messagesCount,proto3" json:"messages_count,omitempty"`
+	// the chat is analyzed in windows of messages, windows_count is 0 until the analysis starts
+	WindowsCount    int32 `protobuf:"varint,5,opt,name=windows_count,json=windowsCount,proto3" json:"windows_count,omitempty"`
+	WindowsAnalyzed int32 `protobuf:"varint,6,opt,name=windows_analyzed,json=windowsAnalyzed,proto3" json:"windows_analyzed,omitempty"`
+	// how many upcoming events were found, it is set once the import is done
+	EventsCount int32 `protobuf:"varint,7,opt,name=events_count,json=eventsCount,proto3" json:"events_count,omitempty"`
+	// why the import failed, it is only set with CHAT_IMPORT_STATUS_FAILED
+	Error     string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ChatImport) Reset() {
+	*x = ChatImport{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatImport) ProtoMessage() {}
+
+func (x *ChatImport) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatImport.ProtoReflect.Descriptor instead.
+func (*ChatImport) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChatImport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChatImport) GetChatName() string {
+	if x != nil {
+		return x.ChatName
+	}
+	return ""
+}
+
+func (x *ChatImport) GetStatus() ChatImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ChatImportStatus_CHAT_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ChatImport) GetMessagesCount() int32 {
+	if x != nil {
+		return x.MessagesCount
+	}
+	return 0
+}
+
+func (x *ChatImport) GetWindowsCount() int32 {
+	if x != nil {
+		return x.WindowsCount
+	}
+	return 0
+}
+
+func (x *ChatImport) GetWindowsAnalyzed() int32 {
+	if x != nil {
+		return x.WindowsAnalyzed
+	}
+	return 0
+}
+
+func (x *ChatImport) GetEventsCount() int32 {
+	if x != nil {
+		return x.EventsCount
+	}
+	return 0
+}
+
+func (x *ChatImport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ChatImport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ImportChatExport imports a chat exported with WhatsApp's "Export chat", in the Android or the iOS format and in
+// English or Arabic. The chat is analyzed in the background and the upcoming events found in it are kept pending for
+// the customer to add, the customer is notified once they are ready.
+type ImportChatExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the name of the exported file, like "WhatsApp Chat with Sara.zip", the chat name is taken from it
+	FileName string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// the .txt file, or the .zip it comes in, exported without media
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// overrides the chat name taken from file_name
+	ChatName string `protobuf:"bytes,3,opt,name=chat_name,json=chatName,proto3" json:"chat_name,omitempty"`
+	// the name the customer's own messages are under in the export, the name of the connected WhatsApp account is
+	// used when not set
+	SenderName string `protobuf:"bytes,4,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	// the locale of the phone the chat was exported from, like "en_SA" or "ar_SA". Its region tells if the dates in
+	// the export put the day or the month first when the dates themselves do not, the day is taken to come first when
+	// it is not set
+	Locale string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *ImportChatExportRequest) Reset() {
+	*x = ImportChatExportRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportChatExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportChatExportRequest) ProtoMessage() {}
+
+func (x *ImportChatExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportChatExportRequest.ProtoReflect.Descriptor instead.
+func (*ImportChatExportRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportChatExportRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImportChatExportRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportChatExportRequest) GetChatName() string {
+	if x != nil {
+		return x.ChatName
+	}
+	return ""
+}
+
+func (x *ImportChatExportRequest) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *ImportChatExportRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type ImportChatExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatImport *ChatImport `protobuf:"bytes,1,opt,name=chat_import,json=chatImport,proto3" json:"chat_import,omitempty"`
+}
+
+func (x *ImportChatExportResponse) Reset() {
+	*x = ImportChatExportResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportChatExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportChatExportResponse) ProtoMessage() {}
+
+func (x *ImportChatExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportChatExportResponse.ProtoReflect.Descriptor instead.
+func (*ImportChatExportResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportChatExportResponse) GetChatImport() *ChatImport {
+	if x != nil {
+		return x.ChatImport
+	}
+	return nil
+}
+
+// GetChatImport returns the import along with the events found in it so far, the pending ones can be added or
+// dismissed all at once with ConfirmDetectedEvents and RejectDetectedEvents.
+type GetChatImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetChatImportRequest) Reset() {
+	*x = GetChatImportRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatImportRequest) ProtoMessage() {}
+
+func (x *GetChatImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatImportRequest.ProtoReflect.Descriptor instead.
+func (*GetChatImportRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetChatImportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetChatImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatImport *ChatImport `protobuf:"bytes,1,opt,name=chat_import,json=chatImport,proto3" json:"chat_import,omitempty"`
+	// ordered by their start time
+	Events []*DetectedEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GetChatImportResponse) Reset() {
+	*x = GetChatImportResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatImportResponse) ProtoMessage() {}
+
+func (x *GetChatImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatImportResponse.ProtoReflect.Descriptor instead.
+func (*GetChatImportResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetChatImportResponse) GetChatImport() *ChatImport {
+	if x != nil {
+		return x.ChatImport
+	}
+	return nil
+}
+
+func (x *GetChatImportResponse) GetEvents() []*DetectedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// ConfirmDetectedEvents adds the pending detected events to the WhatsApp calendar, the events that are not pending
+// anymore are left as they are and returned in their state.
+type ConfirmDetectedEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *ConfirmDetectedEventsRequest) Reset() {
+	*x = ConfirmDetectedEventsRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmDetectedEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmDetectedEventsRequest) ProtoMessage() {}
+
+func (x *ConfirmDetectedEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmDetectedEventsRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmDetectedEventsRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ConfirmDetectedEventsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ConfirmDetectedEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*DetectedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ConfirmDetectedEventsResponse) Reset() {
+	*x = ConfirmDetectedEventsResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmDetectedEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmDetectedEventsResponse) ProtoMessage() {}
+
+func (x *ConfirmDetectedEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmDetectedEventsResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmDetectedEventsResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ConfirmDetectedEventsResponse) GetEvents() []*DetectedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// RejectDetectedEvents dismisses the pending detected events, the events that are not pending anymore are left as
+// they are and returned in their state.
+type RejectDetectedEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *RejectDetectedEventsRequest) Reset() {
+	*x = RejectDetectedEventsRequest{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectDetectedEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectDetectedEventsRequest) ProtoMessage() {}
+
+func (x *RejectDetectedEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectDetectedEventsRequest.ProtoReflect.Descriptor instead.
+func (*RejectDetectedEventsRequest) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RejectDetectedEventsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type RejectDetectedEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*DetectedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *RejectDetectedEventsResponse) Reset() {
+	*x = RejectDetectedEventsResponse{}
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectDetectedEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectDetectedEventsResponse) ProtoMessage() {}
+
+func (x *RejectDetectedEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_whatsapp_v1_whatsapp_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectDetectedEventsResponse.ProtoReflect.Descriptor instead.
+func (*RejectDetectedEventsResponse) Descriptor() ([]byte, []int) {
+	return file_whatsapp_v1_whatsapp_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RejectDetectedEventsResponse) GetEvents() []*DetectedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_whatsapp_v1_whatsapp_proto protoreflect.FileDescriptor
+
+var file_whatsapp_v1_whatsapp_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x68,
+	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x77, 0x68,
+	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x1d, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x62, 0x69,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65,
+	0x22, 0x43, 0x0a, 0x1e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73,
+	0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x23, 0x0a, 0x21, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd4, 0x01, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x88, 0x03, 0x0a, 0x0d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x55, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05,
+	0x18, 0xc8, 0x01, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x50, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x68, 0x61,
+	0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x37,
+	0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x36, 0x0a, 0x1a, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x4f, 0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0xaf, 0x02, 0x0a, 0x04, 0x43, 0x68, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68,
+	0x61, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x3b, 0x0a, 0x0a, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x41, 0x74, 0x22, 0x34, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xf4,
+	0x03, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x05, 0x63, 0x68, 0x61, 0x74, 0x73, 0x12, 0x53, 0x0a, 0x17, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x68, 0x61,
+	0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x15, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xc2,
+	0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x06, 0x63, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a,
+	0x0a, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x3b, 0x0a, 0x0b, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x5f,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x22, 0x42, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x04, 0x63, 0x68, 0x61, 0x74, 0x22, 0x5e, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20,
+	0x00, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x20, 0x53, 0x65, 0x74, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x68, 0x61, 0x74,
+	0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xdb, 0x02, 0x0a,
+	0x0a, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x68, 0x61, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73,
+	0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x7a, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x17, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03,
+	0x18, 0xff, 0x01, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c,
+	0xba, 0x48, 0x09, 0x7a, 0x07, 0x10, 0x01, 0x18, 0x80, 0x80, 0x80, 0x10, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18,
+	0xff, 0x01, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x0b,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40,
+	0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x54, 0x0a, 0x18, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x68, 0x61, 0x74,
+	0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x30,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x85, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x68,
+	0x61, 0x74, 0x5f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x74, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x45, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10,
+	0x64, 0x18, 0x01, 0x22, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
+	0x53, 0x0a, 0x1d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x44, 0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x13, 0xba, 0x48, 0x10, 0x92, 0x01, 0x0d, 0x08, 0x01, 0x10, 0x64, 0x18, 0x01, 0x22, 0x05,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x1c, 0x52, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x68, 0x61,
+	0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0xce,
+	0x02, 0x0a, 0x12, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x44,
+	0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e,
+	0x0a, 0x1a, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x20,
+	0x0a, 0x1c, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x22, 0x0a, 0x1e, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x45, 0x54, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x45, 0x54,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x07, 0x12, 0x22, 0x0a, 0x1e, 0x44,
+	0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x4e, 0x54, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x08, 0x2a,
+	0xa5, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54,
+	0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x49,
+	0x54, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52,
+	0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x49, 0x4e,
+	0x47, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15,
+	0x43, 0x48, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f,
+	0x4d, 0x55, 0x54, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x69, 0x0a, 0x0e, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x4f, 0x4e,
+	0x49, 0x54, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x4f,
+	0x4e, 0x49, 0x54, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c,
+	0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x4f, 0x4e, 0x49, 0x54, 0x4f, 0x52, 0x49, 0x4e,
+	0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54,
+	0x10, 0x02, 0x2a, 0xb4, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x48, 0x41, 0x54, 0x5f,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
+	0x48, 0x41, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x43,
+	0x48, 0x41, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x5a, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1b, 0x0a,
+	0x17, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x48,
+	0x41, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xe4, 0x0a, 0x0a, 0x0f, 0x57, 0x68,
+	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x71, 0x0a,
+	0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61,
+	0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7a, 0x0a, 0x19, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68,
+	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x2e,
+	0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x77,
+	0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x26, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x68, 0x61,
+	0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x68, 0x61, 0x74,
+	0x73, 0x61, 0x70, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x77, 0x68, 0x61, 0x74,
+	0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x28, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x77,
+	0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x27,
+	0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x74, 0x73, 0x12, 0x1d,
+	0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x11, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x25, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x77, 0x68, 0x61, 0x74,
+	0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x77, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x2e,
+	0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x68,
+	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24,
+	0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x74, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x2e, 0x77,
+	0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x44, 0x65,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x77,
+	0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61,
+	0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x77, 0x68,
+	0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x77, 0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x53, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a,
+	0x61, 0x64, 0x77, 0x61, 0x6c, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x79, 0x6d, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x61, 0x6c, 0x2d, 0x73, 0x70, 0x6f, 0x6f, 0x6e, 0x2f, 0x66, 0x61, 0x6c, 0x61, 0x6b,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77,
+	0x68, 0x61, 0x74, 0x73, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x77, 0x68, 0x61, 0x74, 0x73,
+	0x61, 0x70, 0x70, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -379,27 +2242,100 @@ func file_whatsapp_v1_whatsapp_proto_rawDescGZIP() []byte {
 	return file_whatsapp_v1_whatsapp_proto_rawDescData
 }
 
-var file_whatsapp_v1_whatsapp_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_whatsapp_v1_whatsapp_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_whatsapp_v1_whatsapp_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_whatsapp_v1_whatsapp_proto_goTypes = []any{
-	(*ConnectWhatsappAccountRequest)(nil),     // 0: whatsapp.v1.ConnectWhatsappAccountRequest
-	(*ConnectWhatsappAccountResponse)(nil),    // 1: whatsapp.v1.ConnectWhatsappAccountResponse
-	(*DisconnectWhatsappAccountRequest)(nil),  // 2: whatsapp.v1.DisconnectWhatsappAccountRequest
-	(*DisconnectWhatsappAccountResponse)(nil), // 3: whatsapp.v1.DisconnectWhatsappAccountResponse
-	(*GetWhatsappAccountRequest)(nil),         // 4: whatsapp.v1.GetWhatsappAccountRequest
-	(*GetWhatsappAccountResponse)(nil),        // 5: whatsapp.v1.GetWhatsappAccountResponse
+	(DetectedEventState)(0),                   // 0: whatsapp.v1.DetectedEventState
+	(ChatMonitoring)(0),                       // 1: whatsapp.v1.ChatMonitoring
+	(MonitoringMode)(0),                       // 2: whatsapp.v1.MonitoringMode
+	(ChatImportStatus)(0),                     // 3: whatsapp.v1.ChatImportStatus
+	(*ConnectWhatsappAccountRequest)(nil),     // 4: whatsapp.v1.ConnectWhatsappAccountRequest
+	(*ConnectWhatsappAccountResponse)(nil),    // 5: whatsapp.v1.ConnectWhatsappAccountResponse
+	(*DisconnectWhatsappAccountRequest)(nil),  // 6: whatsapp.v1.DisconnectWhatsappAccountRequest
+	(*DisconnectWhatsappAccountResponse)(nil), // 7: whatsapp.v1.DisconnectWhatsappAccountResponse
+	(*GetWhatsappAccountRequest)(nil),         // 8: whatsapp.v1.GetWhatsappAccountRequest
+	(*GetWhatsappAccountResponse)(nil),        // 9: whatsapp.v1.GetWhatsappAccountResponse
+	(*DetectedEvent)(nil),                     // 10: whatsapp.v1.DetectedEvent
+	(*ListDetectedEventsRequest)(nil),         // 11: whatsapp.v1.ListDetectedEventsRequest
+	(*ListDetectedEventsResponse)(nil),        // 12: whatsapp.v1.ListDetectedEventsResponse
+	(*ConfirmDetectedEventRequest)(nil),       // 13: whatsapp.v1.ConfirmDetectedEventRequest
+	(*ConfirmDetectedEventResponse)(nil),      // 14: whatsapp.v1.ConfirmDetectedEventResponse
+	(*RejectDetectedEventRequest)(nil),        // 15: whatsapp.v1.RejectDetectedEventRequest
+	(*RejectDetectedEventResponse)(nil),       // 16: whatsapp.v1.RejectDetectedEventResponse
+	(*Chat)(nil),                              // 17: whatsapp.v1.Chat
+	(*ListChatsRequest)(nil),                  // 18: whatsapp.v1.ListChatsRequest
+	(*ListChatsResponse)(nil),                 // 19: whatsapp.v1.ListChatsResponse
+	(*SetChatMonitoringRequest)(nil),          // 20: whatsapp.v1.SetChatMonitoringRequest
+	(*SetChatMonitoringResponse)(nil),         // 21: whatsapp.v1.SetChatMonitoringResponse
+	(*SetDefaultMonitoringModeRequest)(nil),   // 22: whatsapp.v1.SetDefaultMonitoringModeRequest
+	(*SetDefaultMonitoringModeResponse)(nil),  // 23: whatsapp.v1.SetDefaultMonitoringModeResponse
+	(*ChatImport)(nil),                        // 24: whatsapp.v1.ChatImport
+	(*ImportChatExportRequest)(nil),           // 25: whatsapp.v1.ImportChatExportRequest
+	(*ImportChatExportResponse)(nil),          // 26: whatsapp.v1.ImportChatExportResponse
+	(*GetChatImportRequest)(nil),              // 27: whatsapp.v1.GetChatImportRequest
+	(*GetChatImportResponse)(nil),             // 28: whatsapp.v1.GetChatImportResponse
+	(*ConfirmDetectedEventsRequest)(nil),      // 29: whatsapp.v1.ConfirmDetectedEventsRequest
+	(*ConfirmDetectedEventsResponse)(nil),     // 30: whatsapp.v1.ConfirmDetectedEventsResponse
+	(*RejectDetectedEventsRequest)(nil),       // 31: whatsapp.v1.RejectDetectedEventsRequest
+	(*RejectDetectedEventsResponse)(nil),      // 32: whatsapp.v1.RejectDetectedEventsResponse
+	(*timestamppb.Timestamp)(nil),             // 33: google.protobuf.Timestamp
 }
 var file_whatsapp_v1_whatsapp_proto_depIdxs = []int32{
-	0, // 0: whatsapp.v1.WhatsappService.ConnectWhatsappAccount:input_type -> whatsapp.v1.ConnectWhatsappAccountRequest
-	2, // 1: whatsapp.v1.WhatsappService.DisconnectWhatsappAccount:input_type -> whatsapp.v1.DisconnectWhatsappAccountRequest
-	4, // 2: whatsapp.v1.WhatsappService.GetWhatsappAccount:input_type -> whatsapp.v1.GetWhatsappAccountRequest
-	1, // 3: whatsapp.v1.WhatsappService.ConnectWhatsappAccount:output_type -> whatsapp.v1.ConnectWhatsappAccountResponse
-	3, // 4: whatsapp.v1.WhatsappService.DisconnectWhatsappAccount:output_type -> whatsapp.v1.DisconnectWhatsappAccountResponse
-	5, // 5: whatsapp.v1.WhatsappService.GetWhatsappAccount:output_type -> whatsapp.v1.GetWhatsappAccountResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: whatsapp.v1.DetectedEvent.state:type_name -> whatsapp.v1.DetectedEventState
+	33, // 1: whatsapp.v1.DetectedEvent.start_time:type_name -> google.protobuf.Timestamp
+	33, // 2: whatsapp.v1.DetectedEvent.end_time:type_name -> google.protobuf.Timestamp
+	33, // 3: whatsapp.v1.DetectedEvent.detected_at:type_name -> google.protobuf.Timestamp
+	10, // 4: whatsapp.v1.ListDetectedEventsResponse.events:type_name -> whatsapp.v1.DetectedEvent
+	10, // 5: whatsapp.v1.ConfirmDetectedEventResponse.event:type_name -> whatsapp.v1.DetectedEvent
+	10, // 6: whatsapp.v1.RejectDetectedEventResponse.event:type_name -> whatsapp.v1.DetectedEvent
+	1,  // 7: whatsapp.v1.Chat.monitoring:type_name -> whatsapp.v1.ChatMonitoring
+	33, // 8: whatsapp.v1.Chat.muted_until:type_name -> google.protobuf.Timestamp
+	33, // 9: whatsapp.v1.Chat.last_message_at:type_name -> google.protobuf.Timestamp
+	17, // 10: whatsapp.v1.ListChatsResponse.chats:type_name -> whatsapp.v1.Chat
+	2,  // 11: whatsapp.v1.ListChatsResponse.default_monitoring_mode:type_name -> whatsapp.v1.MonitoringMode
+	1,  // 12: whatsapp.v1.SetChatMonitoringRequest.monitoring:type_name -> whatsapp.v1.ChatMonitoring
+	33, // 13: whatsapp.v1.SetChatMonitoringRequest.muted_until:type_name -> google.protobuf.Timestamp
+	17, // 14: whatsapp.v1.SetChatMonitoringResponse.chat:type_name -> whatsapp.v1.Chat
+	2,  // 15: whatsapp.v1.SetDefaultMonitoringModeRequest.mode:type_name -> whatsapp.v1.MonitoringMode
+	2,  // 16: whatsapp.v1.SetDefaultMonitoringModeResponse.mode:type_name -> whatsapp.v1.MonitoringMode
+	3,  // 17: whatsapp.v1.ChatImport.status:type_name -> whatsapp.v1.ChatImportStatus
+	33, // 18: whatsapp.v1.ChatImport.created_at:type_name -> google.protobuf.Timestamp
+	24, // 19: whatsapp.v1.ImportChatExportResponse.chat_import:type_name -> whatsapp.v1.ChatImport
+	24, // 20: whatsapp.v1.GetChatImportResponse.chat_import:type_name -> whatsapp.v1.ChatImport
+	10, // 21: whatsapp.v1.GetChatImportResponse.events:type_name -> whatsapp.v1.DetectedEvent
+	10, // 22: whatsapp.v1.ConfirmDetectedEventsResponse.events:type_name -> whatsapp.v1.DetectedEvent
+	10, // 23: whatsapp.v1.RejectDetectedEventsResponse.events:type_name -> whatsapp.v1.DetectedEvent
+	4,  // 24: whatsapp.v1.WhatsappService.ConnectWhatsappAccount:input_type -> whatsapp.v1.ConnectWhatsappAccountRequest
+	6,  // 25: whatsapp.v1.WhatsappService.DisconnectWhatsappAccount:input_type -> whatsapp.v1.DisconnectWhatsappAccountRequest
+	8,  // 26: whatsapp.v1.WhatsappService.GetWhatsappAccount:input_type -> whatsapp.v1.GetWhatsappAccountRequest
+	11, // 27: whatsapp.v1.WhatsappService.ListDetectedEvents:input_type -> whatsapp.v1.ListDetectedEventsRequest
+	13, // 28: whatsapp.v1.WhatsappService.ConfirmDetectedEvent:input_type -> whatsapp.v1.ConfirmDetectedEventRequest
+	15, // 29: whatsapp.v1.WhatsappService.RejectDetectedEvent:input_type -> whatsapp.v1.RejectDetectedEventRequest
+	18, // 30: whatsapp.v1.WhatsappService.ListChats:input_type -> whatsapp.v1.ListChatsRequest
+	20, // 31: whatsapp.v1.WhatsappService.SetChatMonitoring:input_type -> whatsapp.v1.SetChatMonitoringRequest
+	22, // 32: whatsapp.v1.WhatsappService.SetDefaultMonitoringMode:input_type -> whatsapp.v1.SetDefaultMonitoringModeRequest
+	25, // 33: whatsapp.v1.WhatsappService.ImportChatExport:input_type -> whatsapp.v1.ImportChatExportRequest
+	27, // 34: whatsapp.v1.WhatsappService.GetChatImport:input_type -> whatsapp.v1.GetChatImportRequest
+	29, // 35: whatsapp.v1.WhatsappService.ConfirmDetectedEvents:input_type -> whatsapp.v1.ConfirmDetectedEventsRequest
+	31, // 36: whatsapp.v1.WhatsappService.RejectDetectedEvents:input_type -> whatsapp.v1.RejectDetectedEventsRequest
+	5,  // 37: whatsapp.v1.WhatsappService.ConnectWhatsappAccount:output_type -> whatsapp.v1.ConnectWhatsappAccountResponse
+	7,  // 38: whatsapp.v1.WhatsappService.DisconnectWhatsappAccount:output_type -> whatsapp.v1.DisconnectWhatsappAccountResponse
+	9,  // 39: whatsapp.v1.WhatsappService.GetWhatsappAccount:output_type -> whatsapp.v1.GetWhatsappAccountResponse
+	12, // 40: whatsapp.v1.WhatsappService.ListDetectedEvents:output_type -> whatsapp.v1.ListDetectedEventsResponse
+	14, // 41: whatsapp.v1.WhatsappService.ConfirmDetectedEvent:output_type -> whatsapp.v1.ConfirmDetectedEventResponse
+	16, // 42: whatsapp.v1.WhatsappService.RejectDetectedEvent:output_type -> whatsapp.v1.RejectDetectedEventResponse
+	19, // 43: whatsapp.v1.WhatsappService.ListChats:output_type -> whatsapp.v1.ListChatsResponse
+	21, // 44: whatsapp.v1.WhatsappService.SetChatMonitoring:output_type -> whatsapp.v1.SetChatMonitoringResponse
+	23, // 45: whatsapp.v1.WhatsappService.SetDefaultMonitoringMode:output_type -> whatsapp.v1.SetDefaultMonitoringModeResponse
+	26, // 46: whatsapp.v1.WhatsappService.ImportChatExport:output_type -> whatsapp.v1.ImportChatExportResponse
+	28, // 47: whatsapp.v1.WhatsappService.GetChatImport:output_type -> whatsapp.v1.GetChatImportResponse
+	30, // 48: whatsapp.v1.WhatsappService.ConfirmDetectedEvents:output_type -> whatsapp.v1.ConfirmDetectedEventsResponse
+	32, // 49: whatsapp.v1.WhatsappService.RejectDetectedEvents:output_type -> whatsapp.v1.RejectDetectedEventsResponse
+	37, // [37:50] is the sub-list for method output_type
+	24, // [24:37] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_whatsapp_v1_whatsapp_proto_init() }
@@ -412,13 +2348,14 @@ func file_whatsapp_v1_whatsapp_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_whatsapp_v1_whatsapp_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      4,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_whatsapp_v1_whatsapp_proto_goTypes,
 		DependencyIndexes: file_whatsapp_v1_whatsapp_proto_depIdxs,
+		EnumInfos:         file_whatsapp_v1_whatsapp_proto_enumTypes,
 		MessageInfos:      file_whatsapp_v1_whatsapp_proto_msgTypes,
 	}.Build()
 	File_whatsapp_v1_whatsapp_proto = out.File
@@ -42,6 +42,36 @@ const (
 	// WhatsappServiceGetWhatsappAccountProcedure is the fully-qualified name of the WhatsappService's
 	// GetWhatsappAccount RPC.
 	WhatsappServiceGetWhatsappAccountProcedure = "/whatsapp.v1.WhatsappService/GetWhatsappAccount"
+	// WhatsappServiceListDetectedEventsProcedure is the fully-qualified name of the WhatsappService's
+	// ListDetectedEvents RPC.
+	WhatsappServiceListDetectedEventsProcedure = "/whatsapp.v1.WhatsappService/ListDetectedEvents"
+	// WhatsappServiceConfirmDetectedEventProcedure is the fully-qualified name of the WhatsappService's
+	// ConfirmDetectedEvent RPC.
+	WhatsappServiceConfirmDetectedEventProcedure = "/whatsapp.v1.WhatsappService/ConfirmDetectedEvent"
+	// WhatsappServiceRejectDetectedEventProcedure is the fully-qualified name of the WhatsappService's
+	// RejectDetectedEvent RPC.
+	WhatsappServiceRejectDetectedEventProcedure = "/whatsapp.v1.WhatsappService/RejectDetectedEvent"
+	// WhatsappServiceListChatsProcedure is the fully-qualified name of the WhatsappService's ListChats
+	// RPC.
+	WhatsappServiceListChatsProcedure = "/whatsapp.v1.WhatsappService/ListChats"
+	// WhatsappServiceSetChatMonitoringProcedure is the fully-qualified name of the WhatsappService's
+	// SetChatMonitoring RPC.
+	WhatsappServiceSetChatMonitoringProcedure = "/whatsapp.v1.WhatsappService/SetChatMonitoring"
+	// WhatsappServiceSetDefaultMonitoringModeProcedure is the fully-qualified name of the
+	// WhatsappService's SetDefaultMonitoringMode RPC.
+	WhatsappServiceSetDefaultMonitoringModeProcedure = "/whatsapp.v1.WhatsappService/SetDefaultMonitoringMode"
+	// WhatsappServiceImportChatExportProcedure is the fully-qualified name of the WhatsappService's
+	// ImportChatExport RPC.
+	WhatsappServiceImportChatExportProcedure = "/whatsapp.v1.WhatsappService/ImportChatExport"
+	// WhatsappServiceGetChatImportProcedure is the fully-qualified name of the WhatsappService's
+	// GetChatImport RPC.
+	WhatsappServiceGetChatImportProcedure = "/whatsapp.v1.WhatsappService/GetChatImport"
+	// WhatsappServiceConfirmDetectedEventsProcedure is the fully-qualified name of the
+	// WhatsappService's ConfirmDetectedEvents RPC.
+	WhatsappServiceConfirmDetectedEventsProcedure = "/whatsapp.v1.WhatsappService/ConfirmDetectedEvents"
+	// WhatsappServiceRejectDetectedEventsProcedure is the fully-qualified name of the WhatsappService's
+	// RejectDetectedEvents RPC.
+	WhatsappServiceRejectDetectedEventsProcedure = "/whatsapp.v1.WhatsappService/RejectDetectedEvents"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -50,6 +80,16 @@ var (
 	whatsappServiceConnectWhatsappAccountMethodDescriptor    = whatsappServiceServiceDescriptor.Methods().ByName("ConnectWhatsappAccount")
 	whatsappServiceDisconnectWhatsappAccountMethodDescriptor = whatsappServiceServiceDescriptor.Methods().ByName("DisconnectWhatsappAccount")
 	whatsappServiceGetWhatsappAccountMethodDescriptor        = whatsappServiceServiceDescriptor.Methods().ByName("GetWhatsappAccount")
+	whatsappServiceListDetectedEventsMethodDescriptor        = whatsappServiceServiceDescriptor.Methods().ByName("ListDetectedEvents")
+	whatsappServiceConfirmDetectedEventMethodDescriptor      = whatsappServiceServiceDescriptor.Methods().ByName("ConfirmDetectedEvent")
+	whatsappServiceRejectDetectedEventMethodDescriptor       = whatsappServiceServiceDescriptor.Methods().ByName("RejectDetectedEvent")
+	whatsappServiceListChatsMethodDescriptor                 = whatsappServiceServiceDescriptor.Methods().ByName("ListChats")
+	whatsappServiceSetChatMonitoringMethodDescriptor         = whatsappServiceServiceDescriptor.Methods().ByName("SetChatMonitoring")
+	whatsappServiceSetDefaultMonitoringModeMethodDescriptor  = whatsappServiceServiceDescriptor.Methods().ByName("SetDefaultMonitoringMode")
+	whatsappServiceImportChatExportMethodDescriptor          = whatsappServiceServiceDescriptor.Methods().ByName("ImportChatExport")
+	whatsappServiceGetChatImportMethodDescriptor             = whatsappServiceServiceDescriptor.Methods().ByName("GetChatImport")
+	whatsappServiceConfirmDetectedEventsMethodDescriptor     = whatsappServiceServiceDescriptor.Methods().ByName("ConfirmDetectedEvents")
+	whatsappServiceRejectDetectedEventsMethodDescriptor      = whatsappServiceServiceDescriptor.Methods().ByName("RejectDetectedEvents")
 )
 
 // WhatsappServiceClient is a client for the whatsapp.v1.WhatsappService service.
@@ -61,6 +101,30 @@ type WhatsappServiceClient interface {
 	// possible errors:
 	//   - not found
 	GetWhatsappAccount(context.Context, *connect.Request[v1.GetWhatsappAccountRequest]) (*connect.Response[v1.GetWhatsappAccountResponse], error)
+	ListDetectedEvents(context.Context, *connect.Request[v1.ListDetectedEventsRequest]) (*connect.Response[v1.ListDetectedEventsResponse], error)
+	// possible errors:
+	//   - not found
+	//   - failed precondition: the event is not pending anymore, it was already handled or it expired
+	ConfirmDetectedEvent(context.Context, *connect.Request[v1.ConfirmDetectedEventRequest]) (*connect.Response[v1.ConfirmDetectedEventResponse], error)
+	// possible errors:
+	//   - not found
+	//   - failed precondition: the event is not pending anymore, it was already handled or it expired
+	RejectDetectedEvent(context.Context, *connect.Request[v1.RejectDetectedEventRequest]) (*connect.Response[v1.RejectDetectedEventResponse], error)
+	ListChats(context.Context, *connect.Request[v1.ListChatsRequest]) (*connect.Response[v1.ListChatsResponse], error)
+	SetChatMonitoring(context.Context, *connect.Request[v1.SetChatMonitoringRequest]) (*connect.Response[v1.SetChatMonitoringResponse], error)
+	SetDefaultMonitoringMode(context.Context, *connect.Request[v1.SetDefaultMonitoringModeRequest]) (*connect.Response[v1.SetDefaultMonitoringModeResponse], error)
+	// possible errors:
+	//   - invalid argument: the file is not a WhatsApp chat export, or it is too large
+	ImportChatExport(context.Context, *connect.Request[v1.ImportChatExportRequest]) (*connect.Response[v1.ImportChatExportResponse], error)
+	// possible errors:
+	//   - not found
+	GetChatImport(context.Context, *connect.Request[v1.GetChatImportRequest]) (*connect.Response[v1.GetChatImportResponse], error)
+	// possible errors:
+	//   - not found: one of the events was not found, none of them is confirmed then
+	ConfirmDetectedEvents(context.Context, *connect.Request[v1.ConfirmDetectedEventsRequest]) (*connect.Response[v1.ConfirmDetectedEventsResponse], error)
+	// possible errors:
+	//   - not found: one of the events was not found, none of them is rejected then
+	RejectDetectedEvents(context.Context, *connect.Request[v1.RejectDetectedEventsRequest]) (*connect.Response[v1.RejectDetectedEventsResponse], error)
 }
 
 // NewWhatsappServiceClient constructs a client for the whatsapp.v1.WhatsappService service. By
@@ -91,6 +155,66 @@ func NewWhatsappServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(whatsappServiceGetWhatsappAccountMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listDetectedEvents: connect.NewClient[v1.ListDetectedEventsRequest, v1.ListDetectedEventsResponse](
+			httpClient,
+			baseURL+WhatsappServiceListDetectedEventsProcedure,
+			connect.WithSchema(whatsappServiceListDetectedEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		confirmDetectedEvent: connect.NewClient[v1.ConfirmDetectedEventRequest, v1.ConfirmDetectedEventResponse](
+			httpClient,
+			baseURL+WhatsappServiceConfirmDetectedEventProcedure,
+			connect.WithSchema(whatsappServiceConfirmDetectedEventMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rejectDetectedEvent: connect.NewClient[v1.RejectDetectedEventRequest, v1.RejectDetectedEventResponse](
+			httpClient,
+			baseURL+WhatsappServiceRejectDetectedEventProcedure,
+			connect.WithSchema(whatsappServiceRejectDetectedEventMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listChats: connect.NewClient[v1.ListChatsRequest, v1.ListChatsResponse](
+			httpClient,
+			baseURL+WhatsappServiceListChatsProcedure,
+			connect.WithSchema(whatsappServiceListChatsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setChatMonitoring: connect.NewClient[v1.SetChatMonitoringRequest, v1.SetChatMonitoringResponse](
+			httpClient,
+			baseURL+WhatsappServiceSetChatMonitoringProcedure,
+			connect.WithSchema(whatsappServiceSetChatMonitoringMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setDefaultMonitoringMode: connect.NewClient[v1.SetDefaultMonitoringModeRequest, v1.SetDefaultMonitoringModeResponse](
+			httpClient,
+			baseURL+WhatsappServiceSetDefaultMonitoringModeProcedure,
+			connect.WithSchema(whatsappServiceSetDefaultMonitoringModeMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importChatExport: connect.NewClient[v1.ImportChatExportRequest, v1.ImportChatExportResponse](
+			httpClient,
+			baseURL+WhatsappServiceImportChatExportProcedure,
+			connect.WithSchema(whatsappServiceImportChatExportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getChatImport: connect.NewClient[v1.GetChatImportRequest, v1.GetChatImportResponse](
+			httpClient,
+			baseURL+WhatsappServiceGetChatImportProcedure,
+			connect.WithSchema(whatsappServiceGetChatImportMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		confirmDetectedEvents: connect.NewClient[v1.ConfirmDetectedEventsRequest, v1.ConfirmDetectedEventsResponse](
+			httpClient,
+			baseURL+WhatsappServiceConfirmDetectedEventsProcedure,
+			connect.WithSchema(whatsappServiceConfirmDetectedEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rejectDetectedEvents: connect.NewClient[v1.RejectDetectedEventsRequest, v1.RejectDetectedEventsResponse](
+			httpClient,
+			baseURL+WhatsappServiceRejectDetectedEventsProcedure,
+			connect.WithSchema(whatsappServiceRejectDetectedEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -99,6 +223,16 @@ type whatsappServiceClient struct {
 	connectWhatsappAccount    *connect.Client[v1.ConnectWhatsappAccountRequest, v1.ConnectWhatsappAccountResponse]
 	disconnectWhatsappAccount *connect.Client[v1.DisconnectWhatsappAccountRequest, v1.DisconnectWhatsappAccountResponse]
 	getWhatsappAccount        *connect.Client[v1.GetWhatsappAccountRequest, v1.GetWhatsappAccountResponse]
+	listDetectedEvents        *connect.Client[v1.ListDetectedEventsRequest, v1.ListDetectedEventsResponse]
+	confirmDetectedEvent      *connect.Client[v1.ConfirmDetectedEventRequest, v1.ConfirmDetectedEventResponse]
+	rejectDetectedEvent       *connect.Client[v1.RejectDetectedEventRequest, v1.RejectDetectedEventResponse]
+	listChats                 *connect.Client[v1.ListChatsRequest, v1.ListChatsResponse]
+	setChatMonitoring         *connect.Client[v1.SetChatMonitoringRequest, v1.SetChatMonitoringResponse]
+	setDefaultMonitoringMode  *connect.Client[v1.SetDefaultMonitoringModeRequest, v1.SetDefaultMonitoringModeResponse]
+	importChatExport          *connect.Client[v1.ImportChatExportRequest, v1.ImportChatExportResponse]
+	getChatImport             *connect.Client[v1.GetChatImportRequest, v1.GetChatImportResponse]
+	confirmDetectedEvents     *connect.Client[v1.ConfirmDetectedEventsRequest, v1.ConfirmDetectedEventsResponse]
+	rejectDetectedEvents      *connect.Client[v1.RejectDetectedEventsRequest, v1.RejectDetectedEventsResponse]
 }
 
 // ConnectWhatsappAccount calls whatsapp.v1.WhatsappService.ConnectWhatsappAccount.
@@ -116,6 +250,56 @@ func (c *whatsappServiceClient) GetWhatsappAccount(ctx context.Context, req *con
 	return c.getWhatsappAccount.CallUnary(ctx, req)
 }
 
+// ListDetectedEvents calls whatsapp.v1.WhatsappService.ListDetectedEvents.
+func (c *whatsappServiceClient) ListDetectedEvents(ctx context.Context, req *connect.Request[v1.ListDetectedEventsRequest]) (*connect.Response[v1.ListDetectedEventsResponse], error) {
+	return c.listDetectedEvents.CallUnary(ctx, req)
+}
+
+// ConfirmDetectedEvent calls whatsapp.v1.WhatsappService.ConfirmDetectedEvent.
+func (c *whatsappServiceClient) ConfirmDetectedEvent(ctx context.Context, req *connect.Request[v1.ConfirmDetectedEventRequest]) (*connect.Response[v1.ConfirmDetectedEventResponse], error) {
+	return c.confirmDetectedEvent.CallUnary(ctx, req)
+}
+
+// RejectDetectedEvent calls whatsapp.v1.WhatsappService.RejectDetectedEvent.
+func (c *whatsappServiceClient) RejectDetectedEvent(ctx context.Context, req *connect.Request[v1.RejectDetectedEventRequest]) (*connect.Response[v1.RejectDetectedEventResponse], error) {
+	return c.rejectDetectedEvent.CallUnary(ctx, req)
+}
+
+// ListChats calls whatsapp.v1.WhatsappService.ListChats.
+func (c *whatsappServiceClient) ListChats(ctx context.Context, req *connect.Request[v1.ListChatsRequest]) (*connect.Response[v1.ListChatsResponse], error) {
+	return c.listChats.CallUnary(ctx, req)
+}
+
+// SetChatMonitoring calls whatsapp.v1.WhatsappService.SetChatMonitoring.
+func (c *whatsappServiceClient) SetChatMonitoring(ctx context.Context, req *connect.Request[v1.SetChatMonitoringRequest]) (*connect.Response[v1.SetChatMonitoringResponse], error) {
+	return c.setChatMonitoring.CallUnary(ctx, req)
+}
+
+// SetDefaultMonitoringMode calls whatsapp.v1.WhatsappService.SetDefaultMonitoringMode.
+func (c *whatsappServiceClient) SetDefaultMonitoringMode(ctx context.Context, req *connect.Request[v1.SetDefaultMonitoringModeRequest]) (*connect.Response[v1.SetDefaultMonitoringModeResponse], error) {
+	return c.setDefaultMonitoringMode.CallUnary(ctx, req)
+}
+
+// ImportChatExport calls whatsapp.v1.WhatsappService.ImportChatExport.
+func (c *whatsappServiceClient) ImportChatExport(ctx context.Context, req *connect.Request[v1.ImportChatExportRequest]) (*connect.Response[v1.ImportChatExportResponse], error) {
+	return c.importChatExport.CallUnary(ctx, req)
+}
+
+// GetChatImport calls whatsapp.v1.WhatsappService.GetChatImport.
+func (c *whatsappServiceClient) GetChatImport(ctx context.Context, req *connect.Request[v1.GetChatImportRequest]) (*connect.Response[v1.GetChatImportResponse], error) {
+	return c.getChatImport.CallUnary(ctx, req)
+}
+
+// ConfirmDetectedEvents calls whatsapp.v1.WhatsappService.ConfirmDetectedEvents.
+func (c *whatsappServiceClient) ConfirmDetectedEvents(ctx context.Context, req *connect.Request[v1.ConfirmDetectedEventsRequest]) (*connect.Response[v1.ConfirmDetectedEventsResponse], error) {
+	return c.confirmDetectedEvents.CallUnary(ctx, req)
+}
+
+// RejectDetectedEvents calls whatsapp.v1.WhatsappService.RejectDetectedEvents.
+func (c *whatsappServiceClient) RejectDetectedEvents(ctx context.Context, req *connect.Request[v1.RejectDetectedEventsRequest]) (*connect.Response[v1.RejectDetectedEventsResponse], error) {
+	return c.rejectDetectedEvents.CallUnary(ctx, req)
+}
+
 // WhatsappServiceHandler is an implementation of the whatsapp.v1.WhatsappService service.
 type WhatsappServiceHandler interface {
 	ConnectWhatsappAccount(context.Context, *connect.Request[v1.ConnectWhatsappAccountRequest]) (*connect.Response[v1.ConnectWhatsappAccountResponse], error)
@@ -125,6 +309,30 @@ type WhatsappServiceHandler interface {
 	// possible errors:
 	//   - not found
 	GetWhatsappAccount(context.Context, *connect.Request[v1.GetWhatsappAccountRequest]) (*connect.Response[v1.GetWhatsappAccountResponse], error)
+	ListDetectedEvents(context.Context, *connect.Request[v1.ListDetectedEventsRequest]) (*connect.Response[v1.ListDetectedEventsResponse], error)
+	// possible errors:
+	//   - not found
+	//   - failed precondition: the event is not pending anymore, it was already handled or it expired
+	ConfirmDetectedEvent(context.Context, *connect.Request[v1.ConfirmDetectedEventRequest]) (*connect.Response[v1.ConfirmDetectedEventResponse], error)
+	// possible errors:
+	//   - not found
+	//   - failed precondition: the event is not pending anymore, it was already handled or it expired
+	RejectDetectedEvent(context.Context, *connect.Request[v1.RejectDetectedEventRequest]) (*connect.Response[v1.RejectDetectedEventResponse], error)
+	ListChats(context.Context, *connect.Request[v1.ListChatsRequest]) (*connect.Response[v1.ListChatsResponse], error)
+	SetChatMonitoring(context.Context, *connect.Request[v1.SetChatMonitoringRequest]) (*connect.Response[v1.SetChatMonitoringResponse], error)
+	SetDefaultMonitoringMode(context.Context, *connect.Request[v1.SetDefaultMonitoringModeRequest]) (*connect.Response[v1.SetDefaultMonitoringModeResponse], error)
+	// possible errors:
+	//   - invalid argument: the file is not a WhatsApp chat export, or it is too large
+	ImportChatExport(context.Context, *connect.Request[v1.ImportChatExportRequest]) (*connect.Response[v1.ImportChatExportResponse], error)
+	// possible errors:
+	//   - not found
+	GetChatImport(context.Context, *connect.Request[v1.GetChatImportRequest]) (*connect.Response[v1.GetChatImportResponse], error)
+	// possible errors:
+	//   - not found: one of the events was not found, none of them is confirmed then
+	ConfirmDetectedEvents(context.Context, *connect.Request[v1.ConfirmDetectedEventsRequest]) (*connect.Response[v1.ConfirmDetectedEventsResponse], error)
+	// possible errors:
+	//   - not found: one of the events was not found, none of them is rejected then
+	RejectDetectedEvents(context.Context, *connect.Request[v1.RejectDetectedEventsRequest]) (*connect.Response[v1.RejectDetectedEventsResponse], error)
 }
 
 // NewWhatsappServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -151,6 +359,66 @@ func NewWhatsappServiceHandler(svc WhatsappServiceHandler, opts ...connect.Handl
 		connect.WithSchema(whatsappServiceGetWhatsappAccountMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	whatsappServiceListDetectedEventsHandler := connect.NewUnaryHandler(
+		WhatsappServiceListDetectedEventsProcedure,
+		svc.ListDetectedEvents,
+		connect.WithSchema(whatsappServiceListDetectedEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	whatsappServiceConfirmDetectedEventHandler := connect.NewUnaryHandler(
+		WhatsappServiceConfirmDetectedEventProcedure,
+		svc.ConfirmDetectedEvent,
+		connect.WithSchema(whatsappServiceConfirmDetectedEventMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	whatsappServiceRejectDetectedEventHandler := connect.NewUnaryHandler(
+		WhatsappServiceRejectDetectedEventProcedure,
+		svc.RejectDetectedEvent,
+		connect.WithSchema(whatsappServiceRejectDetectedEventMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	whatsappServiceListChatsHandler := connect.NewUnaryHandler(
+		WhatsappServiceListChatsProcedure,
+		svc.ListChats,
+		connect.WithSchema(whatsappServiceListChatsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	whatsappServiceSetChatMonitoringHandler := connect.NewUnaryHandler(
+		WhatsappServiceSetChatMonitoringProcedure,
+		svc.SetChatMonitoring,
+		connect.WithSchema(whatsappServiceSetChatMonitoringMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	whatsappServiceSetDefaultMonitoringModeHandler := connect.NewUnaryHandler(
+		WhatsappServiceSetDefaultMonitoringModeProcedure,
+		svc.SetDefaultMonitoringMode,
+		connect.WithSchema(whatsappServiceSetDefaultMonitoringModeMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	whatsappServiceImportChatExportHandler := connect.NewUnaryHandler(
+		WhatsappServiceImportChatExportProcedure,
+		svc.ImportChatExport,
+		connect.WithSchema(whatsappServiceImportChatExportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	whatsappServiceGetChatImportHandler := connect.NewUnaryHandler(
+		WhatsappServiceGetChatImportProcedure,
+		svc.GetChatImport,
+		connect.WithSchema(whatsappServiceGetChatImportMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	whatsappServiceConfirmDetectedEventsHandler := connect.NewUnaryHandler(
+		WhatsappServiceConfirmDetectedEventsProcedure,
+		svc.ConfirmDetectedEvents,
+		connect.WithSchema(whatsappServiceConfirmDetectedEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	whatsappServiceRejectDetectedEventsHandler := connect.NewUnaryHandler(
+		WhatsappServiceRejectDetectedEventsProcedure,
+		svc.RejectDetectedEvents,
+		connect.WithSchema(whatsappServiceRejectDetectedEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/whatsapp.v1.WhatsappService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WhatsappServiceConnectWhatsappAccountProcedure:
@@ -159,6 +427,26 @@ func NewWhatsappServiceHandler(svc WhatsappServiceHandler, opts ...connect.Handl
 			whatsappServiceDisconnectWhatsappAccountHandler.ServeHTTP(w, r)
 		case WhatsappServiceGetWhatsappAccountProcedure:
 			whatsappServiceGetWhatsappAccountHandler.ServeHTTP(w, r)
+		case WhatsappServiceListDetectedEventsProcedure:
+			whatsappServiceListDetectedEventsHandler.ServeHTTP(w, r)
+		case WhatsappServiceConfirmDetectedEventProcedure:
+			whatsappServiceConfirmDetectedEventHandler.ServeHTTP(w, r)
+		case WhatsappServiceRejectDetectedEventProcedure:
+			whatsappServiceRejectDetectedEventHandler.ServeHTTP(w, r)
+		case WhatsappServiceListChatsProcedure:
+			whatsappServiceListChatsHandler.ServeHTTP(w, r)
+		case WhatsappServiceSetChatMonitoringProcedure:
+			whatsappServiceSetChatMonitoringHandler.ServeHTTP(w, r)
+		case WhatsappServiceSetDefaultMonitoringModeProcedure:
+			whatsappServiceSetDefaultMonitoringModeHandler.ServeHTTP(w, r)
+		case WhatsappServiceImportChatExportProcedure:
+			whatsappServiceImportChatExportHandler.ServeHTTP(w, r)
+		case WhatsappServiceGetChatImportProcedure:
+			whatsappServiceGetChatImportHandler.ServeHTTP(w, r)
+		case WhatsappServiceConfirmDetectedEventsProcedure:
+			whatsappServiceConfirmDetectedEventsHandler.ServeHTTP(w, r)
+		case WhatsappServiceRejectDetectedEventsProcedure:
+			whatsappServiceRejectDetectedEventsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -179,3 +467,43 @@ func (UnimplementedWhatsappServiceHandler) DisconnectWhatsappAccount(context.Con
 func (UnimplementedWhatsappServiceHandler) GetWhatsappAccount(context.Context, *connect.Request[v1.GetWhatsappAccountRequest]) (*connect.Response[v1.GetWhatsappAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.GetWhatsappAccount is not implemented"))
 }
+
+func (UnimplementedWhatsappServiceHandler) ListDetectedEvents(context.Context, *connect.Request[v1.ListDetectedEventsRequest]) (*connect.Response[v1.ListDetectedEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.ListDetectedEvents is not implemented"))
+}
+
+func (UnimplementedWhatsappServiceHandler) ConfirmDetectedEvent(context.Context, *connect.Request[v1.ConfirmDetectedEventRequest]) (*connect.Response[v1.ConfirmDetectedEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.ConfirmDetectedEvent is not implemented"))
+}
+
+func (UnimplementedWhatsappServiceHandler) RejectDetectedEvent(context.Context, *connect.Request[v1.RejectDetectedEventRequest]) (*connect.Response[v1.RejectDetectedEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.RejectDetectedEvent is not implemented"))
+}
+
+func (UnimplementedWhatsappServiceHandler) ListChats(context.Context, *connect.Request[v1.ListChatsRequest]) (*connect.Response[v1.ListChatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.ListChats is not implemented"))
+}
+
+func (UnimplementedWhatsappServiceHandler) SetChatMonitoring(context.Context, *connect.Request[v1.SetChatMonitoringRequest]) (*connect.Response[v1.SetChatMonitoringResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.SetChatMonitoring is not implemented"))
+}
+
+func (UnimplementedWhatsappServiceHandler) SetDefaultMonitoringMode(context.Context, *connect.Request[v1.SetDefaultMonitoringModeRequest]) (*connect.Response[v1.SetDefaultMonitoringModeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.SetDefaultMonitoringMode is not implemented"))
+}
+
+func (UnimplementedWhatsappServiceHandler) ImportChatExport(context.Context, *connect.Request[v1.ImportChatExportRequest]) (*connect.Response[v1.ImportChatExportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.ImportChatExport is not implemented"))
+}
+
+func (UnimplementedWhatsappServiceHandler) GetChatImport(context.Context, *connect.Request[v1.GetChatImportRequest]) (*connect.Response[v1.GetChatImportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.GetChatImport is not implemented"))
+}
+
+func (UnimplementedWhatsappServiceHandler) ConfirmDetectedEvents(context.Context, *connect.Request[v1.ConfirmDetectedEventsRequest]) (*connect.Response[v1.ConfirmDetectedEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.ConfirmDetectedEvents is not implemented"))
+}
+
+func (UnimplementedWhatsappServiceHandler) RejectDetectedEvents(context.Context, *connect.Request[v1.RejectDetectedEventsRequest]) (*connect.Response[v1.RejectDetectedEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("whatsapp.v1.WhatsappService.RejectDetectedEvents is not implemented"))
+}
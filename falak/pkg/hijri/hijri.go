@@ -0,0 +1,178 @@
+package hijri
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	firstTableYear = 1356
+
+	// mcjdnOffset converts a Julian Day Number to the modified form used in the table.
+	mcjdnOffset = 2400000
+	// unixEpochJDN is the Julian Day Number of 1970-01-01.
+	unixEpochJDN = 2440588
+)
+
+var (
+	ErrOutOfRange  = errors.New("hijri: date is outside the Umm al-Qura table")
+	ErrInvalidDate = errors.New("hijri: invalid date")
+)
+
+// Month is a month of the Hijri year, Muharram being the first one.
+type Month int
+
+const (
+	Muharram Month = iota + 1
+	Safar
+	RabiAlAwwal
+	RabiAlThani
+	JumadaAlUla
+	JumadaAlAkhirah
+	Rajab
+	Shaban
+	Ramadan
+	Shawwal
+	DhuAlQadah
+	DhuAlHijjah
+)
+
+var monthNames = [...]struct {
+	en string
+	ar string
+}{
+	Muharram:        {"Muharram", "محرم"},
+	Safar:           {"Safar", "صفر"},
+	RabiAlAwwal:     {"Rabi al-Awwal", "ربيع الأول"},
+	RabiAlThani:     {"Rabi al-Thani", "ربيع الآخر"},
+	JumadaAlUla:     {"Jumada al-Ula", "جمادى الأولى"},
+	JumadaAlAkhirah: {"Jumada al-Akhirah", "جمادى الآخرة"},
+	Rajab:           {"Rajab", "رجب"},
+	Shaban:          {"Shaban", "شعبان"},
+	Ramadan:         {"Ramadan", "رمضان"},
+	Shawwal:         {"Shawwal", "شوال"},
+	DhuAlQadah:      {"Dhu al-Qadah", "ذو القعدة"},
+	DhuAlHijjah:     {"Dhu al-Hijjah", "ذو الحجة"},
+}
+
+func (m Month) Valid() bool {
+	return m >= Muharram && m <= DhuAlHijjah
+}
+
+// String returns the English transliteration of the month name.
+func (m Month) String() string {
+	if !m.Valid() {
+		return "Month(" + strconv.Itoa(int(m)) + ")"
+	}
+	return monthNames[m].en
+}
+
+// ArabicName returns the month name in Arabic.
+func (m Month) ArabicName() string {
+	if !m.Valid() {
+		return m.String()
+	}
+	return monthNames[m].ar
+}
+
+// Date is a day in the Umm al-Qura calendar.
+type Date struct {
+	Year  int
+	Month Month
+	Day   int
+}
+
+// FromGregorian converts the calendar day of t, as seen in t's location, to its Umm al-Qura date.
+func FromGregorian(t time.Time) (Date, error) {
+	mcjdn := gregorianToJDN(t.Year(), t.Month(), t.Day()) - mcjdnOffset
+
+	last := len(ummAlQuraMonthStarts) - 1
+	if mcjdn < ummAlQuraMonthStarts[0] || mcjdn >= ummAlQuraMonthStarts[last] {
+		return Date{}, ErrOutOfRange
+	}
+
+	// binary search for the last month that starts on or before mcjdn
+	lo, hi := 0, last
+	for hi-lo > 1 {
+		mid := (lo + hi) / 2
+		if ummAlQuraMonthStarts[mid] <= mcjdn {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return Date{
+		Year:  firstTableYear + lo/12,
+		Month: Month(lo%12 + 1),
+		Day:   mcjdn - ummAlQuraMonthStarts[lo] + 1,
+	}, nil
+}
+
+// ToGregorian returns midnight of the Gregorian day matching d in loc.
+func (d Date) ToGregorian(loc *time.Location) (time.Time, error) {
+	if err := d.validate(); err != nil {
+		return time.Time{}, err
+	}
+
+	idx := monthIndex(d.Year, d.Month)
+	jdn := ummAlQuraMonthStarts[idx] + d.Day - 1 + mcjdnOffset
+
+	utc := time.Unix(int64(jdn-unixEpochJDN)*24*60*60, 0).UTC()
+	return time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, loc), nil
+}
+
+// DaysInMonth returns the number of days (29 or 30) of the given Hijri month.
+func DaysInMonth(year int, month Month) (int, error) {
+	if !month.Valid() {
+		return 0, ErrInvalidDate
+	}
+
+	idx := monthIndex(year, month)
+	if idx < 0 || idx >= len(ummAlQuraMonthStarts)-1 {
+		return 0, ErrOutOfRange
+	}
+
+	return ummAlQuraMonthStarts[idx+1] - ummAlQuraMonthStarts[idx], nil
+}
+
+// String formats the date in English, e.g. "15 Ramadan 1447".
+func (d Date) String() string {
+	return fmt.Sprintf("%d %s %d", d.Day, d.Month, d.Year)
+}
+
+// ISOString formats the date as YYYY-MM-DD, e.g. "1447-09-15".
+func (d Date) ISOString() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, int(d.Month), d.Day)
+}
+
+// ArabicString formats the date in Arabic with Arabic-Indic digits, e.g. "١٥ رمضان ١٤٤٧هـ".
+func (d Date) ArabicString() string {
+	return fmt.Sprintf("%s %s %sهـ", ToArabicDigits(strconv.Itoa(d.Day)), d.Month.ArabicName(), ToArabicDigits(strconv.Itoa(d.Year)))
+}
+
+// ToArabicDigits replaces the western digits in s with Arabic-Indic ones.
+func ToArabicDigits(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			r = '٠' + (r - '0')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+func monthIndex(year int, month Month) int {
+	return (year-firstTableYear)*12 + int(month) - 1
+}
+
+func gregorianToJDN(year int, month time.Month, day int) int {
+	a := (14 - int(month)) / 12
+	y := year + 4800 - a
+	m := int(month) + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
@@ -0,0 +1,125 @@
+package hijri
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// dateRe matches a day followed by a month name and an optional year, e.g. "15 Shawwal 1447" or "15 رمضان".
+var dateRe = regexp.MustCompile(`^(\d{1,2})\s+(.+?)(?:\s+(\d{3,4}))?$`)
+
+// eraSuffixRe matches the era written after the year, e.g. "1447هـ" or "1447 AH".
+var eraSuffixRe = regexp.MustCompile(`(\d)\s*(?:هـ|ه|AH|H)$`)
+
+// monthAliases maps the normalized spellings of the month names to their months,
+// see normalizeMonthName for how a name is normalized.
+var monthAliases = map[string]Month{
+	"muharram": Muharram, "muharam": Muharram, "محرم": Muharram,
+	"safar": Safar, "صفر": Safar,
+	"rabialawwal": RabiAlAwwal, "rabiulawwal": RabiAlAwwal, "rabialawal": RabiAlAwwal, "rabii": RabiAlAwwal, "rabi1": RabiAlAwwal, "ربيعالاول": RabiAlAwwal,
+	"rabialthani": RabiAlThani, "rabiulthani": RabiAlThani, "rabialakhir": RabiAlThani, "rabiulakhir": RabiAlThani, "rabiii": RabiAlThani, "rabi2": RabiAlThani, "ربيعالاخر": RabiAlThani, "ربيعالثاني": RabiAlThani,
+	"jumadaalula": JumadaAlUla, "jumadaalawwal": JumadaAlUla, "jumadai": JumadaAlUla, "jumada1": JumadaAlUla, "جماديالاولي": JumadaAlUla, "جماديالاول": JumadaAlUla,
+	"jumadaalakhirah": JumadaAlAkhirah, "jumadaalakhira": JumadaAlAkhirah, "jumadaalthani": JumadaAlAkhirah, "jumadaii": JumadaAlAkhirah, "jumada2": JumadaAlAkhirah, "جماديالاخره": JumadaAlAkhirah, "جماديالاخر": JumadaAlAkhirah, "جماديالثانيه": JumadaAlAkhirah,
+	"rajab": Rajab, "رجب": Rajab,
+	"shaban": Shaban, "shaaban": Shaban, "شعبان": Shaban,
+	"ramadan": Ramadan, "ramadhan": Ramadan, "ramzan": Ramadan, "رمضان": Ramadan,
+	"shawwal": Shawwal, "shawal": Shawwal, "شوال": Shawwal,
+	"dhualqadah": DhuAlQadah, "dhulqadah": DhuAlQadah, "dhulqidah": DhuAlQadah, "dhualqidah": DhuAlQadah, "thulqadah": DhuAlQadah, "ذوالقعده": DhuAlQadah, "ذيالقعده": DhuAlQadah,
+	"dhualhijjah": DhuAlHijjah, "dhulhijjah": DhuAlHijjah, "dhulhijja": DhuAlHijjah, "thulhijjah": DhuAlHijjah, "thulhijja": DhuAlHijjah, "ذوالحجه": DhuAlHijjah, "ذيالحجه": DhuAlHijjah,
+}
+
+// Parse reads a Hijri date written the way people write it in chats, in Arabic or English, such as
+// "١٥ رمضان", "15 Shawwal 1447" or "٩ ذي الحجة ١٤٤٧هـ". When the year is missing the first occurrence
+// of the day on or after ref is picked.
+func Parse(s string, ref time.Time) (Date, error) {
+	s = strings.TrimSpace(toWesternDigits(s))
+	s = eraSuffixRe.ReplaceAllString(s, "$1")
+
+	m := dateRe.FindStringSubmatch(s)
+	if m == nil {
+		return Date{}, ErrInvalidDate
+	}
+
+	day, err := strconv.Atoi(m[1])
+	if err != nil {
+		return Date{}, ErrInvalidDate
+	}
+
+	month, ok := monthAliases[normalizeMonthName(m[2])]
+	if !ok {
+		return Date{}, ErrInvalidDate
+	}
+
+	if m[3] != "" {
+		year, err := strconv.Atoi(m[3])
+		if err != nil {
+			return Date{}, ErrInvalidDate
+		}
+		d := Date{Year: year, Month: month, Day: day}
+		if err := d.validate(); err != nil {
+			return Date{}, err
+		}
+		return d, nil
+	}
+
+	today, err := FromGregorian(ref)
+	if err != nil {
+		return Date{}, err
+	}
+	d := Date{Year: today.Year, Month: month, Day: day}
+	if d.Month < today.Month || (d.Month == today.Month && d.Day < today.Day) {
+		d.Year++
+	}
+	if err := d.validate(); err != nil {
+		return Date{}, err
+	}
+	return d, nil
+}
+
+func (d Date) validate() error {
+	length, err := DaysInMonth(d.Year, d.Month)
+	if err != nil {
+		return err
+	}
+	if d.Day < 1 || d.Day > length {
+		return ErrInvalidDate
+	}
+	return nil
+}
+
+// normalizeMonthName lowercases the name and drops everything that is not a letter, Arabic letters that are
+// commonly written interchangeably (أ إ آ ا, ة ه, ى ي) are folded into one form.
+func normalizeMonthName(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch r {
+		case 'أ', 'إ', 'آ':
+			r = 'ا'
+		case 'ة':
+			r = 'ه'
+		case 'ى':
+			r = 'ي'
+		case 'ـ':
+			continue
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// toWesternDigits replaces the Arabic-Indic digits in s with western ones.
+func toWesternDigits(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r >= '٠' && r <= '٩' {
+			r = '0' + (r - '٠')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -4,12 +4,19 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/emersion/go-ical"
 	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/apimetadata"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/mobileconfig"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/occasions"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayercal"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/util"
 	"github.com/rs/zerolog/log"
@@ -17,10 +24,11 @@ import (
 )
 
 type service struct {
-	store                       store.Queries
-	calDAVPasswordEncryptionKey string
-	caldavHost                  string
-	isProd                      bool
+	store         store.Queries
+	encryptionSvc encryptionsvc.Svc
+	caldavHost    string
+	isProd        bool
+	prayerSvc     prayersvc.Svc
 }
 
 func (s *service) HandleMobileConfigCaldav(w http.ResponseWriter, r *http.Request) {
@@ -55,16 +63,20 @@ func (s *service) HandleMobileConfigCaldav(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	customer, err := s.store.GetCalDavAccountByCustomerId(ctx, store.GetCalDavAccountByCustomerIdParams{
-		CustomerID:    magicToken.CustomerID,
-		EncryptionKey: s.calDAVPasswordEncryptionKey,
-	})
+	customer, err := s.store.GetCalDavAccountByCustomerId(ctx, magicToken.CustomerID)
 	if err != nil {
 		log.Ctx(ctx).Err(err).Msg("failed getting CalDAV account")
 		http.Error(w, "Internal server error", http.StatusInternalServerError)
 		return
 	}
 
+	password, err := s.encryptionSvc.Decrypt(ctx, customer.CustomerID, customer.Password)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed decrypting CalDAV password")
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
 	w.Header().Add("Content-Type", "application/x-apple-aspen-config")
 	filename := fmt.Sprintf("%s-%s.mobileconfig", customer.CustomerID, time.Now())
 	w.Header().Set("Content-Disposition", "attachment; filename=\""+filename+"\"")
@@ -91,7 +103,7 @@ func (s *service) HandleMobileConfigCaldav(w http.ResponseWriter, r *http.Reques
 		CalDAVUseSSL:             s.isProd,
 		CalDAVPrincipalURL:       "/dav.php",
 		CalDAVUsername:           customer.Username,
-		CalDAVPassword:           customer.DecryptedPassword,
+		CalDAVPassword:           password,
 	}
 
 	mobileConfig := mobileconfig.MobileConfig{
@@ -184,6 +196,166 @@ func (s *service) HandleMobileConfigWebcal(w http.ResponseWriter, r *http.Reques
 	w.Write(plistBytes.Bytes())
 }
 
+func (s *service) HandleWebcalOccasions(w http.ResponseWriter, r *http.Request) {
+	ctx := context.Background()
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+	hashedToken := util.HashStringToBase64SHA256(q.Get("s"))
+
+	feed, err := s.store.GetCalendarFeedByTokenHash(ctx, store.GetCalendarFeedByTokenHashParams{
+		TokenHash: hashedToken,
+		FeedType:  store.CalendarFeedTypeOccasions,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.Ctx(ctx).Err(err).Msg("no calendar feed exists in the database that matches the hash of the token provided by user")
+			http.Error(w, "Invalid token", http.StatusNotFound)
+			return
+		}
+
+		log.Ctx(ctx).Err(err).Msg("failed running GetCalendarFeedByTokenHash")
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	lang := apimetadata.Lang(feed.Lang)
+	genReq := &occasions.GenerateRequest{
+		// a month back so calendar apps don't drop the events that just passed
+		From: time.Now().AddDate(0, -1, 0),
+		To:   time.Now().AddDate(1, 0, 0),
+		Lang: lang,
+	}
+	prayerSettings, err := s.prayerSvc.GetCustomerSettings(ctx, feed.CustomerID)
+	switch {
+	case err == nil:
+		genReq.Timezone = prayerSettings.Location.Timezone
+		genReq.PrayerLocation = &prayerSettings.Location
+		genReq.PrayerConfig = prayerSettings.Config
+	case errors.Is(err, prayersvc.ErrNoSettings):
+	default:
+		log.Ctx(ctx).Err(err).Msg("failed running GetCustomerSettings")
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	events, err := occasions.Generate(genReq)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running occasions.Generate")
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropProductID, util.ProdID)
+	cal.Props.SetText(ical.PropVersion, "2.0")
+	cal.Props.SetText("X-WR-CALNAME", occasions.CalendarName(lang))
+	cal.Props.SetText("X-APPLE-CALENDAR-COLOR", occasions.CalendarColor)
+	refreshInterval := ical.NewProp("REFRESH-INTERVAL")
+	refreshInterval.SetValueType(ical.ValueDuration)
+	refreshInterval.Value = "P1D"
+	cal.Props.Set(refreshInterval)
+
+	now := time.Now().UTC()
+	for _, e := range events {
+		event := ical.NewEvent()
+		event.Props.SetText(ical.PropUID, e.UID)
+		event.Props.SetText(ical.PropSummary, e.Title)
+		event.Props.SetText(ical.PropDescription, e.Description)
+		event.Props.SetDateTime(ical.PropDateTimeStamp, now)
+		if e.AllDay {
+			event.Props.SetDate(ical.PropDateTimeStart, e.Start)
+			event.Props.SetDate(ical.PropDateTimeEnd, e.End)
+		} else {
+			event.Props.SetDateTime(ical.PropDateTimeStart, e.Start.UTC())
+			event.Props.SetDateTime(ical.PropDateTimeEnd, e.End.UTC())
+		}
+		cal.Children = append(cal.Children, event.Component)
+	}
+
+	icsBytes := new(bytes.Buffer)
+	err = ical.NewEncoder(icsBytes).Encode(cal)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed encoding ical")
+		http.Error(w, "Failed to generate calendar", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", "inline; filename=\"islamic-occasions.ics\"")
+	w.Write(icsBytes.Bytes())
+}
+
+func (s *service) HandleWebcalPrayer(w http.ResponseWriter, r *http.Request) {
+	ctx := context.Background()
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+	hashedToken := util.HashStringToBase64SHA256(q.Get("s"))
+
+	feed, err := s.store.GetCalendarFeedByTokenHash(ctx, store.GetCalendarFeedByTokenHashParams{
+		TokenHash: hashedToken,
+		FeedType:  store.CalendarFeedTypePrayer,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.Ctx(ctx).Err(err).Msg("no calendar feed exists in the database that matches the hash of the token provided by user")
+			http.Error(w, "Invalid token", http.StatusNotFound)
+			return
+		}
+
+		log.Ctx(ctx).Err(err).Msg("failed running GetCalendarFeedByTokenHash")
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	prayerSettings, err := s.prayerSvc.GetCustomerSettings(ctx, feed.CustomerID)
+	if err != nil {
+		if !errors.Is(err, prayersvc.ErrNoSettings) {
+			log.Ctx(ctx).Err(err).Msg("failed running GetCustomerSettings")
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+		prayerSettings = prayersvc.DefaultSettings()
+	}
+
+	cal, err := prayercal.Generate(&prayercal.GenerateRequest{
+		// a day back so the prayers of today stay visible in every timezone
+		From:     time.Now().AddDate(0, 0, -1),
+		To:       time.Now().AddDate(0, 0, 60),
+		Lang:     apimetadata.Lang(feed.Lang),
+		Location: prayerSettings.Location,
+		Config:   prayerSettings.Config,
+		Jumuah: prayercal.JumuahConfig{
+			KhutbahTime: prayerSettings.Jumuah.KhutbahTime,
+			LeaveBy:     prayerSettings.Jumuah.LeaveBy,
+		},
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running prayercal.Generate")
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	icsBytes := new(bytes.Buffer)
+	err = ical.NewEncoder(icsBytes).Encode(cal)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed encoding ical")
+		http.Error(w, "Failed to generate calendar", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", "inline; filename=\"prayer-times.ics\"")
+	w.Write(icsBytes.Bytes())
+}
+
 func (s *service) HandleRoot(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("jadwal-fingerprint", "sg2a")
 	w.Write([]byte(`                                                   .                                                
@@ -241,11 +413,12 @@ func (s *service) HandleRoot(w http.ResponseWriter, r *http.Request) {
           .      .                                   .           .                                . `))
 }
 
-func NewRouter(store store.Queries, calDAVPasswordEncryptionKey string, caldavHost string, isProd bool) Svc {
+func NewRouter(store store.Queries, encryptionSvc encryptionsvc.Svc, caldavHost string, isProd bool, prayerSvc prayersvc.Svc) Svc {
 	return &service{
-		store:                       store,
-		calDAVPasswordEncryptionKey: calDAVPasswordEncryptionKey,
-		caldavHost:                  caldavHost,
-		isProd:                      isProd,
+		store:         store,
+		encryptionSvc: encryptionSvc,
+		caldavHost:    caldavHost,
+		isProd:        isProd,
+		prayerSvc:     prayerSvc,
 	}
 }
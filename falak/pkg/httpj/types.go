@@ -6,4 +6,6 @@ type Svc interface {
 	HandleRoot(w http.ResponseWriter, r *http.Request)
 	HandleMobileConfigCaldav(w http.ResponseWriter, r *http.Request)
 	HandleMobileConfigWebcal(w http.ResponseWriter, r *http.Request)
+	HandleWebcalOccasions(w http.ResponseWriter, r *http.Request)
+	HandleWebcalPrayer(w http.ResponseWriter, r *http.Request)
 }
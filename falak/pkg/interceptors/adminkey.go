@@ -0,0 +1,58 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+var (
+	errInvalidAdminKey = connect.NewError(connect.CodeUnauthenticated, errors.New("invalid admin key"))
+)
+
+// EnsureAdminKeyInterceptor lets through the requests that have adminKey as their bearer token, every request is
+// rejected when adminKey is empty so the admin services are closed unless a key is configured.
+func EnsureAdminKeyInterceptor(adminKey string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if err := checkAdminKey(req.Header().Get("Authorization"), adminKey); err != nil {
+				return nil, err
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
+
+// EnsureAdminKeyHandler guards a plain HTTP handler, like the metrics, the way EnsureAdminKeyInterceptor guards the
+// admin services
+func EnsureAdminKeyHandler(adminKey string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := checkAdminKey(r.Header.Get("Authorization"), adminKey); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func checkAdminKey(authorization, adminKey string) error {
+	if adminKey == "" {
+		return errInvalidAdminKey
+	}
+
+	if authorization == "" {
+		return errMissingAuthorizationHeader
+	}
+
+	key := strings.TrimPrefix(authorization, "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(key), []byte(adminKey)) != 1 {
+		return errInvalidAdminKey
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package keyring
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// masterKeySize is the size of the AES-256 master keys
+const masterKeySize = 32
+
+// localKeyring holds the master keys in memory, the first key is the current one and the rest are older keys that
+// are kept to unwrap the data keys wrapped before the current one was added.
+type localKeyring struct {
+	currentID string
+	keys      map[string]cipher.AEAD
+}
+
+func (k *localKeyring) Wrap(ctx context.Context, dataKey []byte) ([]byte, string, error) {
+	aead := k.keys[k.currentID]
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(k.currentID)), k.currentID, nil
+}
+
+func (k *localKeyring) Unwrap(ctx context.Context, masterKeyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[masterKeyID]
+	if !ok {
+		return nil, ErrUnknownMasterKey
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped data key is too short")
+	}
+
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealed, []byte(masterKeyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	return dataKey, nil
+}
+
+func (k *localKeyring) CurrentMasterKeyID() string {
+	return k.currentID
+}
+
+// NewEnvKeyring returns a keyring with the master keys in the value of an environment variable, which has the base64
+// encoded 32 byte keys separated by commas with the current key first.
+func NewEnvKeyring(masterKeys string) (Keyring, error) {
+	return newLocalKeyring(strings.Split(masterKeys, ","))
+}
+
+// NewFileKeyring returns a keyring with the master keys in a file, which has a base64 encoded 32 byte key on each line
+// with the current key first. Empty lines and lines starting with "#" are skipped.
+func NewFileKeyring(path string) (Keyring, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read master keys file: %w", err)
+	}
+
+	var encodedKeys []string
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		encodedKeys = append(encodedKeys, line)
+	}
+	return newLocalKeyring(encodedKeys)
+}
+
+func newLocalKeyring(encodedKeys []string) (Keyring, error) {
+	k := &localKeyring{
+		keys: make(map[string]cipher.AEAD),
+	}
+	for idx, encodedKey := range encodedKeys {
+		encodedKey = strings.TrimSpace(encodedKey)
+		if encodedKey == "" {
+			continue
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("master key %d is not valid base64: %w", idx+1, err)
+		}
+		if len(key) != masterKeySize {
+			return nil, fmt.Errorf("master key %d is %d bytes, it has to be %d bytes", idx+1, len(key), masterKeySize)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cipher for master key %d: %w", idx+1, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create GCM for master key %d: %w", idx+1, err)
+		}
+
+		id := masterKeyID(key)
+		if k.currentID == "" {
+			k.currentID = id
+		}
+		k.keys[id] = aead
+	}
+
+	if k.currentID == "" {
+		return nil, ErrNoMasterKeys
+	}
+	return k, nil
+}
+
+// masterKeyID identifies a master key by a fingerprint of it, so the ID stays the same wherever the key is kept
+func masterKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
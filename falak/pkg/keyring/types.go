@@ -0,0 +1,30 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+)
+
+type Provider string
+
+const (
+	Provider_Env  Provider = "env"
+	Provider_File Provider = "file"
+)
+
+var (
+	ErrNoMasterKeys     = errors.New("no master keys in the keyring")
+	ErrUnknownMasterKey = errors.New("the master key is not in the keyring")
+)
+
+// Keyring holds the master keys that wrap the customers' data keys. The master keys never leave the keyring, so a
+// keyring backed by a KMS only has to wrap and unwrap the data keys it is given.
+type Keyring interface {
+	// Wrap encrypts the data key with the current master key, the returned ID is the one to unwrap it with
+	Wrap(ctx context.Context, dataKey []byte) (wrapped []byte, masterKeyID string, err error)
+	// Unwrap decrypts a data key wrapped with the master key with the given ID
+	Unwrap(ctx context.Context, masterKeyID string, wrapped []byte) ([]byte, error)
+	// CurrentMasterKeyID is the ID of the master key new data keys are wrapped with, data keys wrapped with another one
+	// are wrapped again with it
+	CurrentMasterKeyID() string
+}
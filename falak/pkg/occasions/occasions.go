@@ -0,0 +1,154 @@
+package occasions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/apimetadata"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/hijri"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayer"
+)
+
+const (
+	suhoorBeforeFajr = 45 * time.Minute
+	iftarDuration    = 30 * time.Minute
+)
+
+// lastDay marks an occasion that ends on the last day of its month, whatever its length is.
+const lastDay = -1
+
+type occasion struct {
+	key   string
+	month hijri.Month
+	// first and last are days of the month, both inclusive.
+	first int
+	last  int
+	title title
+}
+
+var occasions = []occasion{
+	{key: "new-year", month: hijri.Muharram, first: 1, last: 1, title: title{"Islamic New Year", "رأس السنة الهجرية"}},
+	{key: "tasua", month: hijri.Muharram, first: 9, last: 9, title: title{"Day of Tasu'a", "يوم تاسوعاء"}},
+	{key: "ashura", month: hijri.Muharram, first: 10, last: 10, title: title{"Day of Ashura", "يوم عاشوراء"}},
+	{key: "ramadan-start", month: hijri.Ramadan, first: 1, last: 1, title: title{"Ramadan begins", "بداية شهر رمضان"}},
+	{key: "ramadan-last-ten", month: hijri.Ramadan, first: 21, last: lastDay, title: title{"Last ten nights of Ramadan", "العشر الأواخر من رمضان"}},
+	{key: "ramadan-end", month: hijri.Ramadan, first: lastDay, last: lastDay, title: title{"Last day of Ramadan", "آخر يوم من رمضان"}},
+	{key: "eid-al-fitr", month: hijri.Shawwal, first: 1, last: 1, title: title{"Eid al-Fitr", "عيد الفطر"}},
+	{key: "dhul-hijjah-ten", month: hijri.DhuAlHijjah, first: 1, last: 10, title: title{"First ten days of Dhu al-Hijjah", "العشر الأوائل من ذي الحجة"}},
+	{key: "arafah", month: hijri.DhuAlHijjah, first: 9, last: 9, title: title{"Day of Arafah", "يوم عرفة"}},
+	{key: "eid-al-adha", month: hijri.DhuAlHijjah, first: 10, last: 10, title: title{"Eid al-Adha", "عيد الأضحى"}},
+	{key: "tashreeq", month: hijri.DhuAlHijjah, first: 11, last: 13, title: title{"Days of Tashreeq", "أيام التشريق"}},
+}
+
+var (
+	whiteDaysTitle = title{"White days (Ayyam al-Beed)", "الأيام البيض"}
+	suhoorTitle    = title{"Suhoor", "السحور"}
+	iftarTitle     = title{"Iftar", "الإفطار"}
+)
+
+// Generate returns the Islamic occasions between r.From and r.To following the Umm al-Qura calendar,
+// sorted by their start.
+func Generate(r *GenerateRequest) ([]Event, error) {
+	tz := r.Timezone
+	if tz == nil {
+		tz = time.UTC
+	}
+
+	from := r.From.In(tz)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, tz)
+
+	var events []Event
+	for ; day.Before(r.To); day = day.AddDate(0, 0, 1) {
+		h, err := hijri.FromGregorian(day)
+		if err != nil {
+			return nil, err
+		}
+		monthLength, err := hijri.DaysInMonth(h.Year, h.Month)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, o := range occasionsOf(h.Month) {
+			first, last := o.first, o.last
+			if first == lastDay {
+				first = monthLength
+			}
+			if last == lastDay {
+				last = monthLength
+			}
+			if h.Day != first {
+				continue
+			}
+
+			events = append(events, allDayEvent(day, last-first+1, o.key, o.title.in(r.Lang), h, r.Lang))
+		}
+
+		if h.Month == hijri.Ramadan && r.PrayerLocation != nil {
+			times, err := prayer.Compute(day, *r.PrayerLocation, r.PrayerConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			events = append(events,
+				timedEvent("suhoor", suhoorTitle.in(r.Lang), times.Fajr.Add(-suhoorBeforeFajr), times.Fajr, h, r.Lang),
+				timedEvent("iftar", iftarTitle.in(r.Lang), times.Maghrib, times.Maghrib.Add(iftarDuration), h, r.Lang),
+			)
+		}
+	}
+
+	return events, nil
+}
+
+// occasionsOf returns the occasions of the given month, the white days included.
+func occasionsOf(month hijri.Month) []occasion {
+	var res []occasion
+	for _, o := range occasions {
+		if o.month == month {
+			res = append(res, o)
+		}
+	}
+
+	switch month {
+	case hijri.Ramadan:
+		// the whole month is fasted anyway
+	case hijri.DhuAlHijjah:
+		// the 13th is one of the days of Tashreeq, fasting it is not allowed
+		res = append(res, occasion{key: "white-days", month: month, first: 14, last: 15, title: whiteDaysTitle})
+	default:
+		res = append(res, occasion{key: "white-days", month: month, first: 13, last: 15, title: whiteDaysTitle})
+	}
+
+	return res
+}
+
+func allDayEvent(day time.Time, days int, key, eventTitle string, h hijri.Date, lang apimetadata.Lang) Event {
+	return Event{
+		UID:         uid(key, day),
+		Title:       eventTitle,
+		Description: formatHijri(h, lang),
+		Start:       day,
+		End:         day.AddDate(0, 0, days),
+		AllDay:      true,
+	}
+}
+
+func timedEvent(key, eventTitle string, start, end time.Time, h hijri.Date, lang apimetadata.Lang) Event {
+	return Event{
+		UID:         uid(key, start),
+		Title:       eventTitle,
+		Description: formatHijri(h, lang),
+		Start:       start,
+		End:         end,
+	}
+}
+
+func uid(key string, day time.Time) string {
+	return fmt.Sprintf("%s-%s@jadwal.app", key, day.Format("2006-01-02"))
+}
+
+func formatHijri(h hijri.Date, lang apimetadata.Lang) string {
+	if lang == apimetadata.Lang_Arabic {
+		return h.ArabicString()
+	}
+	return h.String()
+}
@@ -0,0 +1,56 @@
+package occasions
+
+import (
+	"time"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/apimetadata"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayer"
+)
+
+const (
+	CalendarPathSuffix = "islamic-occasions/"
+	CalendarColor      = "#8E44AD"
+)
+
+var calendarName = title{"🌙 Islamic Occasions", "🌙 المناسبات الإسلامية"}
+
+// CalendarName returns the display name of the occasions calendar in the given language.
+func CalendarName(lang apimetadata.Lang) string {
+	return calendarName.in(lang)
+}
+
+// Event is a single occasion ready to be written as a VEVENT.
+// All day events start at midnight of their first day and end at midnight after their last day.
+type Event struct {
+	UID         string
+	Title       string
+	Description string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+}
+
+type GenerateRequest struct {
+	// From and To bound the generated days, From is inclusive and To is exclusive.
+	From time.Time
+	To   time.Time
+	Lang apimetadata.Lang
+	// Timezone decides which Gregorian day an occasion falls on, UTC is used when nil.
+	Timezone *time.Location
+
+	// PrayerLocation and PrayerConfig enable the daily Suhoor and Iftar events of Ramadan, they are skipped when nil.
+	PrayerLocation *prayer.Location
+	PrayerConfig   prayer.Config
+}
+
+type title struct {
+	en string
+	ar string
+}
+
+func (t title) in(lang apimetadata.Lang) string {
+	if lang == apimetadata.Lang_Arabic {
+		return t.ar
+	}
+	return t.en
+}
@@ -0,0 +1,159 @@
+package prayer
+
+import (
+	"math"
+	"time"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/hijri"
+)
+
+// riseSetAngle is the sun altitude used for sunrise and sunset, it accounts for refraction and the sun's radius.
+const riseSetAngle = 0.833
+
+// Compute returns the prayer times for the calendar day of date at the given location.
+// The calculation follows the well known astronomical formulas used by most prayer time
+// calculators (see praytimes.org), times are rounded to the nearest minute.
+func Compute(date time.Time, loc Location, cfg Config) (*Times, error) {
+	params, ok := methods[cfg.Method]
+	if !ok {
+		return nil, ErrUnknownMethod
+	}
+	if !IsValidAsrJuristic(cfg.AsrJuristic) {
+		return nil, ErrUnknownAsrJuristic
+	}
+	if loc.Timezone == nil {
+		return nil, ErrMissingTimezone
+	}
+
+	date = date.In(loc.Timezone)
+	year, month, day := date.Date()
+
+	c := calculator{
+		lat: loc.Latitude,
+		jd:  julianDate(year, month, day) - loc.Longitude/(15*24),
+	}
+
+	asrFactor := 1.0
+	if cfg.AsrJuristic == AsrJuristic_Hanafi {
+		asrFactor = 2
+	}
+
+	// initial guesses in hours, refined by recomputing with the previous result
+	fajr, sunrise, dhuhr, asr, sunset, isha := 5.0, 6.0, 12.0, 13.0, 18.0, 18.0
+	for i := 0; i < 2; i++ {
+		fajr = c.sunAngleTime(params.fajrAngle, fajr, true)
+		sunrise = c.sunAngleTime(riseSetAngle, sunrise, true)
+		dhuhr = c.midDay(dhuhr)
+		asr = c.asrTime(asrFactor, asr)
+		sunset = c.sunAngleTime(riseSetAngle, sunset, false)
+		isha = c.sunAngleTime(params.ishaAngle, isha, false)
+	}
+
+	nightHours := 24 - (sunset - sunrise)
+	if math.IsNaN(fajr) {
+		fajr = sunrise - params.fajrAngle/60*nightHours
+	}
+
+	ishaMinutes := params.ishaMinutes
+	if params.ramadanIshaMinutes > 0 {
+		if h, err := hijri.FromGregorian(date); err == nil && h.Month == hijri.Ramadan {
+			ishaMinutes = params.ramadanIshaMinutes
+		}
+	}
+	if ishaMinutes > 0 {
+		isha = sunset + ishaMinutes/60
+	} else if math.IsNaN(isha) {
+		isha = sunset + params.ishaAngle/60*nightHours
+	}
+
+	base := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	toTime := func(hours float64) time.Time {
+		utcHours := hours - loc.Longitude/15
+		return base.Add(time.Duration(utcHours * float64(time.Hour))).Round(time.Minute).In(loc.Timezone)
+	}
+
+	return &Times{
+		Fajr:    toTime(fajr),
+		Sunrise: toTime(sunrise),
+		Dhuhr:   toTime(dhuhr),
+		Asr:     toTime(asr),
+		Maghrib: toTime(sunset),
+		Isha:    toTime(isha),
+	}, nil
+}
+
+type calculator struct {
+	lat float64
+	jd  float64
+}
+
+// sunPosition returns the declination of the sun and the equation of time for the given julian date.
+func (c calculator) sunPosition(jd float64) (float64, float64) {
+	d := jd - 2451545.0
+	g := fixAngle(357.529 + 0.98560028*d)
+	q := fixAngle(280.459 + 0.98564736*d)
+	l := fixAngle(q + 1.915*dsin(g) + 0.020*dsin(2*g))
+	e := 23.439 - 0.00000036*d
+
+	ra := darctan2(dcos(e)*dsin(l), dcos(l)) / 15
+	eqt := q/15 - fixHour(ra)
+	decl := darcsin(dsin(e) * dsin(l))
+
+	return decl, eqt
+}
+
+func (c calculator) midDay(hours float64) float64 {
+	_, eqt := c.sunPosition(c.jd + hours/24)
+	return fixHour(12 - eqt)
+}
+
+// sunAngleTime returns the time at which the sun reaches the given angle below the horizon,
+// before noon when ccw is true and after noon otherwise.
+func (c calculator) sunAngleTime(angle, hours float64, ccw bool) float64 {
+	decl, _ := c.sunPosition(c.jd + hours/24)
+	noon := c.midDay(hours)
+	t := darccos((-dsin(angle)-dsin(decl)*dsin(c.lat))/(dcos(decl)*dcos(c.lat))) / 15
+	if ccw {
+		return noon - t
+	}
+	return noon + t
+}
+
+func (c calculator) asrTime(factor, hours float64) float64 {
+	decl, _ := c.sunPosition(c.jd + hours/24)
+	angle := -darccot(factor + dtan(math.Abs(c.lat-decl)))
+	return c.sunAngleTime(angle, hours, false)
+}
+
+func julianDate(year int, month time.Month, day int) float64 {
+	y, m := float64(year), float64(month)
+	if m <= 2 {
+		y--
+		m += 12
+	}
+	a := math.Floor(y / 100)
+	b := 2 - a + math.Floor(a/4)
+	return math.Floor(365.25*(y+4716)) + math.Floor(30.6001*(m+1)) + float64(day) + b - 1524.5
+}
+
+func dtr(d float64) float64 { return d * math.Pi / 180 }
+func rtd(r float64) float64 { return r * 180 / math.Pi }
+
+func dsin(d float64) float64        { return math.Sin(dtr(d)) }
+func dcos(d float64) float64        { return math.Cos(dtr(d)) }
+func dtan(d float64) float64        { return math.Tan(dtr(d)) }
+func darcsin(x float64) float64     { return rtd(math.Asin(x)) }
+func darccos(x float64) float64     { return rtd(math.Acos(x)) }
+func darccot(x float64) float64     { return rtd(math.Atan(1 / x)) }
+func darctan2(y, x float64) float64 { return rtd(math.Atan2(y, x)) }
+
+func fixAngle(a float64) float64 { return fix(a, 360) }
+func fixHour(a float64) float64  { return fix(a, 24) }
+
+func fix(a, b float64) float64 {
+	a = a - b*math.Floor(a/b)
+	if a < 0 {
+		return a + b
+	}
+	return a
+}
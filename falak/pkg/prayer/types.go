@@ -0,0 +1,132 @@
+package prayer
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrUnknownMethod      = errors.New("prayer: unknown calculation method")
+	ErrUnknownAsrJuristic = errors.New("prayer: unknown asr juristic method")
+	ErrMissingTimezone    = errors.New("prayer: missing timezone")
+)
+
+type Method string
+
+const (
+	Method_UmmAlQura         Method = "umm_al_qura"
+	Method_MuslimWorldLeague Method = "mwl"
+	Method_ISNA              Method = "isna"
+	Method_Egypt             Method = "egypt"
+	Method_Karachi           Method = "karachi"
+	Method_Kuwait            Method = "kuwait"
+	Method_Qatar             Method = "qatar"
+)
+
+type AsrJuristic string
+
+const (
+	AsrJuristic_Shafi  AsrJuristic = "shafi"
+	AsrJuristic_Hanafi AsrJuristic = "hanafi"
+)
+
+type Prayer string
+
+const (
+	Prayer_Fajr    Prayer = "fajr"
+	Prayer_Sunrise Prayer = "sunrise"
+	Prayer_Dhuhr   Prayer = "dhuhr"
+	Prayer_Asr     Prayer = "asr"
+	Prayer_Maghrib Prayer = "maghrib"
+	Prayer_Isha    Prayer = "isha"
+)
+
+var prayerNames = map[Prayer]string{
+	Prayer_Fajr:    "Fajr",
+	Prayer_Sunrise: "Sunrise",
+	Prayer_Dhuhr:   "Dhuhr",
+	Prayer_Asr:     "Asr",
+	Prayer_Maghrib: "Maghrib",
+	Prayer_Isha:    "Isha",
+}
+
+// Name returns the English name of the prayer, e.g. "Maghrib".
+func (p Prayer) Name() string {
+	if name, ok := prayerNames[p]; ok {
+		return name
+	}
+	return string(p)
+}
+
+// Prayers lists the five daily prayers in the order they happen, sunrise is not included.
+var Prayers = []Prayer{Prayer_Fajr, Prayer_Dhuhr, Prayer_Asr, Prayer_Maghrib, Prayer_Isha}
+
+// Location is where the prayer times are computed for.
+type Location struct {
+	Latitude  float64
+	Longitude float64
+	Timezone  *time.Location
+}
+
+type Config struct {
+	Method      Method
+	AsrJuristic AsrJuristic
+}
+
+// Times holds the prayer times of a single day, all in the location's timezone.
+type Times struct {
+	Fajr    time.Time
+	Sunrise time.Time
+	Dhuhr   time.Time
+	Asr     time.Time
+	Maghrib time.Time
+	Isha    time.Time
+}
+
+// Get returns the time of the given prayer, or the zero time if p is not known.
+func (t *Times) Get(p Prayer) time.Time {
+	switch p {
+	case Prayer_Fajr:
+		return t.Fajr
+	case Prayer_Sunrise:
+		return t.Sunrise
+	case Prayer_Dhuhr:
+		return t.Dhuhr
+	case Prayer_Asr:
+		return t.Asr
+	case Prayer_Maghrib:
+		return t.Maghrib
+	case Prayer_Isha:
+		return t.Isha
+	}
+	return time.Time{}
+}
+
+type methodParams struct {
+	fajrAngle float64
+	ishaAngle float64
+	// ishaMinutes is used instead of ishaAngle when set, it is counted from maghrib.
+	ishaMinutes        float64
+	ramadanIshaMinutes float64
+}
+
+var methods = map[Method]methodParams{
+	Method_UmmAlQura:         {fajrAngle: 18.5, ishaMinutes: 90, ramadanIshaMinutes: 120},
+	Method_MuslimWorldLeague: {fajrAngle: 18, ishaAngle: 17},
+	Method_ISNA:              {fajrAngle: 15, ishaAngle: 15},
+	Method_Egypt:             {fajrAngle: 19.5, ishaAngle: 17.5},
+	Method_Karachi:           {fajrAngle: 18, ishaAngle: 18},
+	Method_Kuwait:            {fajrAngle: 18, ishaAngle: 17.5},
+	Method_Qatar:             {fajrAngle: 18, ishaMinutes: 90},
+}
+
+// IsValidMethod reports whether m is a supported calculation method.
+func IsValidMethod(m Method) bool {
+	_, ok := methods[m]
+	return ok
+}
+
+// IsValidAsrJuristic reports whether a is a supported asr juristic method.
+func IsValidAsrJuristic(a AsrJuristic) bool {
+	return a == AsrJuristic_Shafi || a == AsrJuristic_Hanafi
+}
@@ -0,0 +1,60 @@
+package prayer
+
+import "time"
+
+// windowDurations is roughly how long a prayer takes from the adhan until the congregation is done,
+// it includes the wait for the iqama.
+var windowDurations = map[Prayer]time.Duration{
+	Prayer_Fajr:    35 * time.Minute,
+	Prayer_Dhuhr:   30 * time.Minute,
+	Prayer_Asr:     30 * time.Minute,
+	Prayer_Maghrib: 20 * time.Minute,
+	Prayer_Isha:    30 * time.Minute,
+}
+
+// Window is the time a prayer occupies.
+type Window struct {
+	Prayer Prayer
+	Start  time.Time
+	End    time.Time
+}
+
+// Windows returns the windows of the five prayers of the day t belongs to.
+func (t *Times) Windows() []Window {
+	res := make([]Window, 0, len(Prayers))
+	for _, p := range Prayers {
+		start := t.Get(p)
+		res = append(res, Window{
+			Prayer: p,
+			Start:  start,
+			End:    start.Add(windowDurations[p]),
+		})
+	}
+	return res
+}
+
+// OverlappingWindows returns the prayer windows that overlap the [start, end) range, in order.
+func OverlappingWindows(start, end time.Time, loc Location, cfg Config) ([]Window, error) {
+	if loc.Timezone == nil {
+		return nil, ErrMissingTimezone
+	}
+
+	first := start.In(loc.Timezone)
+	day := time.Date(first.Year(), first.Month(), first.Day(), 12, 0, 0, 0, loc.Timezone)
+
+	var res []Window
+	for ; day.Add(-12 * time.Hour).Before(end); day = day.AddDate(0, 0, 1) {
+		times, err := Compute(day, loc, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range times.Windows() {
+			if w.Start.Before(end) && w.End.After(start) {
+				res = append(res, w)
+			}
+		}
+	}
+
+	return res, nil
+}
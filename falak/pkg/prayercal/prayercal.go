@@ -0,0 +1,186 @@
+package prayercal
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayer"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/util"
+)
+
+const (
+	jumuahDuration = 45 * time.Minute
+
+	// jumuahRecurrenceRule repeats the event every Friday
+	jumuahRecurrenceRule = "FREQ=WEEKLY;BYDAY=FR"
+
+	// timezoneCoverage is how long after the end of the feed the timezone is described for, so the occurrences of the
+	// recurring Jumu'ah after it are still placed right when the feed is not refreshed
+	timezoneCoverage = 365 * 24 * time.Hour
+)
+
+var ErrInvalidKhutbahTime = errors.New("prayercal: khutbah time must be in the HH:mm format")
+
+// Generate returns a calendar with the prayers of every day between r.From and r.To.
+//
+// On Fridays Dhuhr is replaced by Jumu'ah. When the customer set their masjid's khutbah time Jumu'ah is a single
+// weekly recurring event, its start is written in the customer's timezone so calendar apps keep it at the same
+// wall clock time across DST changes. Otherwise every Friday gets its own event at that day's Dhuhr. The times are all
+// written in the customer's timezone, which the calendar describes in a VTIMEZONE.
+func Generate(r *GenerateRequest) (*ical.Calendar, error) {
+	tz := r.Location.Timezone
+	if tz == nil {
+		return nil, prayer.ErrMissingTimezone
+	}
+
+	var khutbahHour, khutbahMinute int
+	fixedKhutbah := r.Jumuah.KhutbahTime != ""
+	if fixedKhutbah {
+		t, err := time.Parse("15:04", r.Jumuah.KhutbahTime)
+		if err != nil {
+			return nil, ErrInvalidKhutbahTime
+		}
+		khutbahHour, khutbahMinute = t.Hour(), t.Minute()
+	}
+
+	cal := ical.NewCalendar()
+	cal.Props.SetText(ical.PropProductID, util.ProdID)
+	cal.Props.SetText(ical.PropVersion, "2.0")
+	cal.Props.SetText("X-WR-CALNAME", CalendarName(r.Lang))
+	cal.Props.SetText("X-WR-TIMEZONE", tz.String())
+	refreshInterval := ical.NewProp("REFRESH-INTERVAL")
+	refreshInterval.SetValueType(ical.ValueDuration)
+	refreshInterval.Value = "P1D"
+	cal.Props.Set(refreshInterval)
+	cal.Children = append(cal.Children, newTimezone(tz, r.From, r.To.Add(timezoneCoverage)))
+
+	now := time.Now().UTC()
+	from := r.From.In(tz)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 12, 0, 0, 0, tz)
+	addedRecurringJumuah := false
+
+	for ; day.Add(-12 * time.Hour).Before(r.To); day = day.AddDate(0, 0, 1) {
+		times, err := prayer.Compute(day, r.Location, r.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		// the weekday is taken from the date in the customer's timezone, never from UTC
+		isFriday := day.Weekday() == time.Friday
+
+		for _, w := range times.Windows() {
+			if isFriday && w.Prayer == prayer.Prayer_Dhuhr {
+				continue
+			}
+
+			event := newEvent(fmt.Sprintf("prayer-%s-%s@jadwal.app", w.Prayer, day.Format("2006-01-02")), prayerTitles[w.Prayer].in(r.Lang), w.Start, w.End, now)
+			event.Props.SetText(ical.PropTransparency, "TRANSPARENT")
+			cal.Children = append(cal.Children, event.Component)
+		}
+
+		if !isFriday {
+			continue
+		}
+
+		if fixedKhutbah {
+			if addedRecurringJumuah {
+				continue
+			}
+			start := time.Date(day.Year(), day.Month(), day.Day(), khutbahHour, khutbahMinute, 0, 0, tz)
+			event := newJumuahEvent("jumuah@jadwal.app", start, r, now)
+			rrule := ical.NewProp(ical.PropRecurrenceRule)
+			rrule.Value = jumuahRecurrenceRule
+			event.Props.Set(rrule)
+			cal.Children = append(cal.Children, event.Component)
+			addedRecurringJumuah = true
+			continue
+		}
+
+		event := newJumuahEvent(fmt.Sprintf("jumuah-%s@jadwal.app", day.Format("2006-01-02")), times.Dhuhr, r, now)
+		cal.Children = append(cal.Children, event.Component)
+	}
+
+	return cal, nil
+}
+
+func newEvent(uid, summary string, start, end, now time.Time) *ical.Event {
+	event := ical.NewEvent()
+	event.Props.SetText(ical.PropUID, uid)
+	event.Props.SetText(ical.PropSummary, summary)
+	event.Props.SetDateTime(ical.PropDateTimeStamp, now)
+	event.Props.SetDateTime(ical.PropDateTimeStart, start)
+	event.Props.SetDateTime(ical.PropDateTimeEnd, end)
+	return event
+}
+
+func newJumuahEvent(uid string, start time.Time, r *GenerateRequest, now time.Time) *ical.Event {
+	event := newEvent(uid, jumuahTitle.in(r.Lang), start, start.Add(jumuahDuration), now)
+
+	if r.Jumuah.LeaveBy > 0 {
+		alarm := ical.NewComponent(ical.CompAlarm)
+		alarm.Props.SetText(ical.PropAction, "DISPLAY")
+		alarm.Props.SetText(ical.PropDescription, leaveByTitle.in(r.Lang))
+		trigger := ical.NewProp(ical.PropTrigger)
+		trigger.Value = fmt.Sprintf("-PT%dM", int(r.Jumuah.LeaveBy.Minutes()))
+		alarm.Props.Set(trigger)
+		event.Children = append(event.Children, alarm)
+	}
+
+	return event
+}
+
+// newTimezone describes tz between from and to as a VTIMEZONE, which RFC 5545 asks for for every TZID the events use.
+// Go does not expose the rules of a timezone, so every change of its offset in that range gets its own observance.
+func newTimezone(tz *time.Location, from, to time.Time) *ical.Component {
+	vtimezone := ical.NewComponent(ical.CompTimezone)
+	vtimezone.Props.SetText(ical.PropTimezoneID, tz.String())
+
+	t := from.In(tz)
+	for {
+		start, end := t.ZoneBounds()
+		vtimezone.Children = append(vtimezone.Children, newObservance(t, start))
+		if end.IsZero() || !end.Before(to) {
+			return vtimezone
+		}
+		t = end.In(tz)
+	}
+}
+
+// newObservance describes the offset in effect at t, which came into effect at start. A zero start means the offset
+// has always been in effect.
+func newObservance(t, start time.Time) *ical.Component {
+	name, offset := t.Zone()
+	offsetFrom := offset
+	dtstart := "19700101T000000"
+	if !start.IsZero() {
+		_, offsetFrom = start.Add(-time.Second).Zone()
+		// the start is written in the local time before the change
+		dtstart = start.In(time.FixedZone("", offsetFrom)).Format("20060102T150405")
+	}
+
+	compName := ical.CompTimezoneStandard
+	if t.IsDST() {
+		compName = ical.CompTimezoneDaylight
+	}
+	observance := ical.NewComponent(compName)
+	observance.Props.Set(&ical.Prop{Name: ical.PropDateTimeStart, Params: ical.Params{}, Value: dtstart})
+	observance.Props.Set(&ical.Prop{Name: ical.PropTimezoneOffsetFrom, Params: ical.Params{}, Value: formatUTCOffset(offsetFrom)})
+	observance.Props.Set(&ical.Prop{Name: ical.PropTimezoneOffsetTo, Params: ical.Params{}, Value: formatUTCOffset(offset)})
+	observance.Props.SetText(ical.PropTimezoneName, name)
+	return observance
+}
+
+// formatUTCOffset formats an offset in seconds east of UTC as +HHMM, or +HHMMSS when it is not in whole minutes
+func formatUTCOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	if offset%60 != 0 {
+		return fmt.Sprintf("%c%02d%02d%02d", sign, offset/3600, offset/60%60, offset%60)
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset/60%60)
+}
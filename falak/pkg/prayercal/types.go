@@ -0,0 +1,57 @@
+package prayercal
+
+import (
+	"time"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/apimetadata"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayer"
+)
+
+type GenerateRequest struct {
+	// From and To bound the generated days, From is inclusive and To is exclusive.
+	From time.Time
+	To   time.Time
+	Lang apimetadata.Lang
+
+	Location prayer.Location
+	Config   prayer.Config
+	Jumuah   JumuahConfig
+}
+
+type JumuahConfig struct {
+	// KhutbahTime is the start of the khutbah at the customer's masjid in the HH:mm format, Dhuhr is used when empty.
+	KhutbahTime string
+	// LeaveBy is how long before the khutbah the "leave by" alarm goes off, no alarm is added when it is zero.
+	LeaveBy time.Duration
+}
+
+type title struct {
+	en string
+	ar string
+}
+
+func (t title) in(lang apimetadata.Lang) string {
+	if lang == apimetadata.Lang_Arabic {
+		return t.ar
+	}
+	return t.en
+}
+
+var (
+	calendarName = title{"🕌 Prayer Times", "🕌 أوقات الصلاة"}
+	jumuahTitle  = title{"🕌 Jumu'ah", "🕌 صلاة الجمعة"}
+	leaveByTitle = title{"Time to leave for Jumu'ah", "حان وقت الذهاب لصلاة الجمعة"}
+
+	prayerTitles = map[prayer.Prayer]title{
+		prayer.Prayer_Fajr:    {"🕌 Fajr", "🕌 الفجر"},
+		prayer.Prayer_Dhuhr:   {"🕌 Dhuhr", "🕌 الظهر"},
+		prayer.Prayer_Asr:     {"🕌 Asr", "🕌 العصر"},
+		prayer.Prayer_Maghrib: {"🕌 Maghrib", "🕌 المغرب"},
+		prayer.Prayer_Isha:    {"🕌 Isha", "🕌 العشاء"},
+	}
+)
+
+// CalendarName returns the display name of the prayer times calendar in the given language.
+func CalendarName(lang apimetadata.Lang) string {
+	return calendarName.in(lang)
+}
@@ -4,15 +4,30 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	caldavclient "github.com/jadwalapp/symmetrical-spoon/falak/pkg/caldav/client"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/hijri"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
 )
 
+const (
+	// hijriDateProperty holds the Umm al-Qura date of the start of the event, formatted as YYYY-MM-DD
+	hijriDateProperty = "X-JADWAL-HIJRI-DATE"
+	// sourceProperty holds where the event came from, like the WhatsApp chat it was agreed on in
+	sourceProperty = "X-JADWAL-SOURCE"
+)
+
+// clientKey identifies a calendar of a customer, a customer can have more than one calendar initialized at once
+type clientKey struct {
+	customerID uuid.UUID
+	pathSuffix string
+}
+
 type svc struct {
 	mu            sync.RWMutex
-	caldavClients map[uuid.UUID]caldavclient.Client
+	caldavClients map[clientKey]caldavclient.Client
 	store         store.Queries
 	calDavBaseUrl string
 }
@@ -37,17 +52,17 @@ func (s *svc) InitCalendar(ctx context.Context, r *InitCalendarRequest) error {
 		return err
 	}
 
-	s.caldavClients[r.CustomerID] = calClient
+	s.caldavClients[clientKey{customerID: r.CustomerID, pathSuffix: r.PathSuffix}] = calClient
 	return nil
 }
 
 func (s *svc) AddEvent(ctx context.Context, r *AddEventRequest) error {
 	s.mu.RLock()
-	calendar, exists := s.caldavClients[r.CustomerID]
+	calendar, exists := s.caldavClients[clientKey{customerID: r.CustomerID, pathSuffix: r.PathSuffix}]
 	s.mu.RUnlock()
 
 	if !exists {
-		return fmt.Errorf("calendar not initialized for customer %s", r.CustomerID)
+		return fmt.Errorf("calendar %s not initialized for customer %s", r.PathSuffix, r.CustomerID)
 	}
 
 	eventData := caldavclient.EventData{
@@ -56,11 +71,125 @@ func (s *svc) AddEvent(ctx context.Context, r *AddEventRequest) error {
 		StartTime:   r.StartTime,
 		EndTime:     r.EndTime,
 		UID:         r.UID,
+		AllDay:      r.AllDay,
+		Tentative:   r.Tentative,
+		Location:    r.Location,
+		Geo:         mapGeo(r.Geo),
+		Attendees:   mapAttendees(r.Attendees),
+		URL:         r.URL,
 	}
 
+	eventData.ExtraProperties = eventProperties(r.StartTime, r.Source, r.HijriDate)
+
 	return calendar.AddEvent(ctx, eventData)
 }
 
+func (s *svc) UpdateEvent(ctx context.Context, r *UpdateEventRequest) error {
+	s.mu.RLock()
+	calendar, exists := s.caldavClients[clientKey{customerID: r.CustomerID, pathSuffix: r.PathSuffix}]
+	s.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("calendar %s not initialized for customer %s", r.PathSuffix, r.CustomerID)
+	}
+
+	return calendar.UpdateEvent(ctx, r.UID, caldavclient.EventData{
+		Summary:         r.Summary,
+		Description:     r.Description,
+		StartTime:       r.StartTime,
+		EndTime:         r.EndTime,
+		UID:             r.UID,
+		AllDay:          r.AllDay,
+		Tentative:       r.Tentative,
+		Location:        r.Location,
+		Geo:             mapGeo(r.Geo),
+		Attendees:       mapAttendees(r.Attendees),
+		URL:             r.URL,
+		ExtraProperties: eventProperties(r.StartTime, r.Source, r.HijriDate),
+	})
+}
+
+func (s *svc) DeleteEvent(ctx context.Context, r *DeleteEventRequest) error {
+	s.mu.RLock()
+	calendar, exists := s.caldavClients[clientKey{customerID: r.CustomerID, pathSuffix: r.PathSuffix}]
+	s.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("calendar %s not initialized for customer %s", r.PathSuffix, r.CustomerID)
+	}
+
+	return calendar.DeleteEvent(ctx, r.UID)
+}
+
+func (s *svc) ListEventUIDs(ctx context.Context, r *ListEventUIDsRequest) ([]string, error) {
+	s.mu.RLock()
+	calendar, exists := s.caldavClients[clientKey{customerID: r.CustomerID, pathSuffix: r.PathSuffix}]
+	s.mu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("calendar %s not initialized for customer %s", r.PathSuffix, r.CustomerID)
+	}
+
+	events, err := calendar.FindEvents(ctx, caldavclient.EventQuery{})
+	if err != nil {
+		return nil, err
+	}
+
+	uids := make([]string, len(events))
+	for idx, event := range events {
+		uids[idx] = event.UID
+	}
+	return uids, nil
+}
+
+func (s *svc) GetEventPath(ctx context.Context, r *GetEventPathRequest) (string, error) {
+	s.mu.RLock()
+	calendar, exists := s.caldavClients[clientKey{customerID: r.CustomerID, pathSuffix: r.PathSuffix}]
+	s.mu.RUnlock()
+
+	if !exists {
+		return "", fmt.Errorf("calendar %s not initialized for customer %s", r.PathSuffix, r.CustomerID)
+	}
+
+	return calendar.EventPath(r.UID), nil
+}
+
+// eventProperties returns the extra properties that carry the Hijri date of an event starting at start when it is
+// asked for, and the source of the event when it has one
+func eventProperties(start time.Time, source string, withHijriDate bool) map[string]string {
+	props := map[string]string{}
+	if withHijriDate {
+		if hijriDate, err := hijri.FromGregorian(start); err == nil {
+			props[hijriDateProperty] = hijriDate.ISOString()
+		}
+	}
+	if source != "" {
+		props[sourceProperty] = source
+	}
+	return props
+}
+
+func mapGeo(geo *Geo) *caldavclient.Geo {
+	if geo == nil {
+		return nil
+	}
+	return &caldavclient.Geo{
+		Latitude:  geo.Latitude,
+		Longitude: geo.Longitude,
+	}
+}
+
+func mapAttendees(attendees []Attendee) []caldavclient.Attendee {
+	res := make([]caldavclient.Attendee, len(attendees))
+	for idx, attendee := range attendees {
+		res[idx] = caldavclient.Attendee{
+			Name:        attendee.Name,
+			PhoneNumber: attendee.PhoneNumber,
+		}
+	}
+	return res
+}
+
 func (s *svc) createCalendarClient(baseUrl, username, password string) (caldavclient.Client, error) {
 	config := caldavclient.Config{
 		BaseURL:  fmt.Sprintf("%s/dav.php", baseUrl),
@@ -73,7 +202,7 @@ func (s *svc) createCalendarClient(baseUrl, username, password string) (caldavcl
 
 func NewSvc(calDavBaseUrl string, store store.Queries) Svc {
 	return &svc{
-		caldavClients: make(map[uuid.UUID]caldavclient.Client),
+		caldavClients: make(map[clientKey]caldavclient.Client),
 		store:         store,
 		calDavBaseUrl: calDavBaseUrl,
 	}
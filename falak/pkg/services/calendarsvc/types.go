@@ -5,16 +5,80 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	caldavclient "github.com/jadwalapp/symmetrical-spoon/falak/pkg/caldav/client"
 )
 
+// ErrEventNotFound is returned when there is no event with the UID in the calendar
+var ErrEventNotFound = caldavclient.ErrEventNotFound
+
+// Geo is a point on the map in decimal degrees
+type Geo struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Attendee is a person taking part in an event
+type Attendee struct {
+	Name        string
+	PhoneNumber string // In international format
+}
+
 // AddEventRequest contains data needed to add an event to a calendar
 type AddEventRequest struct {
 	CustomerID  uuid.UUID
+	PathSuffix  string // Path suffix of the calendar, it must have been initialized with InitCalendar
 	Summary     string
 	Description string
 	StartTime   time.Time
 	EndTime     time.Time
 	UID         string // Optional unique identifier
+	AllDay      bool
+	Tentative   bool // Held in the calendar without blocking its time until it is confirmed
+	Location    string
+	Geo         *Geo // Optional coordinates of the location
+	Attendees   []Attendee
+	URL         string // Optional link to where the event came from
+	Source      string // Optional description of where the event came from, written as X-JADWAL-SOURCE
+	HijriDate   bool   // Writes the Hijri date of the start as X-JADWAL-HIJRI-DATE
+}
+
+// UpdateEventRequest contains data needed to update an event that was added with AddEvent
+type UpdateEventRequest struct {
+	CustomerID  uuid.UUID
+	PathSuffix  string // Path suffix of the calendar, it must have been initialized with InitCalendar
+	UID         string
+	Summary     string
+	Description string
+	StartTime   time.Time
+	EndTime     time.Time
+	AllDay      bool
+	Tentative   bool // Held in the calendar without blocking its time until it is confirmed
+	Location    string
+	Geo         *Geo // Optional coordinates of the location
+	Attendees   []Attendee
+	URL         string // Optional link to where the event came from
+	Source      string // Optional description of where the event came from, written as X-JADWAL-SOURCE
+	HijriDate   bool   // Writes the Hijri date of the start as X-JADWAL-HIJRI-DATE
+}
+
+// DeleteEventRequest contains data needed to delete an event from a calendar
+type DeleteEventRequest struct {
+	CustomerID uuid.UUID
+	PathSuffix string // Path suffix of the calendar, it must have been initialized with InitCalendar
+	UID        string
+}
+
+// ListEventUIDsRequest contains data needed to list the events in a calendar
+type ListEventUIDsRequest struct {
+	CustomerID uuid.UUID
+	PathSuffix string // Path suffix of the calendar, it must have been initialized with InitCalendar
+}
+
+// GetEventPathRequest contains data needed to find where an event is stored on the CalDAV server
+type GetEventPathRequest struct {
+	CustomerID uuid.UUID
+	PathSuffix string // Path suffix of the calendar, it must have been initialized with InitCalendar
+	UID        string
 }
 
 // InitCalendarRequest contains data needed to initialize a calendar
@@ -32,6 +96,18 @@ type Svc interface {
 	// AddEvent adds an event to a customer's calendar
 	AddEvent(ctx context.Context, r *AddEventRequest) error
 
+	// UpdateEvent replaces an event in a customer's calendar, keeping its UID
+	UpdateEvent(ctx context.Context, r *UpdateEventRequest) error
+
+	// DeleteEvent deletes an event from a customer's calendar
+	DeleteEvent(ctx context.Context, r *DeleteEventRequest) error
+
+	// ListEventUIDs returns the UIDs of the events in a customer's calendar
+	ListEventUIDs(ctx context.Context, r *ListEventUIDsRequest) ([]string, error)
+
+	// GetEventPath returns the CalDAV path of an event in a customer's calendar
+	GetEventPath(ctx context.Context, r *GetEventPathRequest) (string, error)
+
 	// InitCalendar initializes a calendar for a customer
 	InitCalendar(ctx context.Context, r *InitCalendarRequest) error
 }
@@ -0,0 +1,271 @@
+package encryptionsvc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// legacyBatchSize is how many legacy values are read at once
+	legacyBatchSize = 500
+	// legacyCiphertextPrefix starts the hex format of the bytea pgp_sym_encrypt returned
+	legacyCiphertextPrefix = `\x`
+)
+
+// LegacyKeys are the global keys the data was encrypted with by pgp_sym_encrypt before it was encrypted with the
+// customers' data keys, the data of a key that is empty is not re-encrypted
+type LegacyKeys struct {
+	CalDAVPassword   string
+	WhatsappMessages string
+}
+
+// ReencryptLegacyData encrypts the values that are still encrypted with the legacy keys with the customers' data
+// keys, the legacy values are told apart by the "\x" the hex format of the bytea pgp_sym_encrypt returned starts
+// with. It has nothing to do once every value was re-encrypted, after which the legacy keys can be removed, until then
+// Decrypt falls back to the legacy keys for the values it did not get to yet. The values that cannot be decrypted with the legacy key
+// are logged and left as they are, so one of them does not keep the others from being re-encrypted.
+func ReencryptLegacyData(ctx context.Context, dbStore store.Queries, encryptionSvc Svc, legacyKeys LegacyKeys) error {
+	if legacyKeys.CalDAVPassword != "" {
+		count, skipped, err := reencryptLegacyCalDavPasswords(ctx, dbStore, encryptionSvc, legacyKeys.CalDAVPassword)
+		if err != nil {
+			return err
+		}
+		log.Ctx(ctx).Info().
+			Int("reencrypted_count", count).
+			Int("skipped_count", skipped).
+			Msg("re-encrypted legacy caldav passwords")
+	}
+
+	if legacyKeys.WhatsappMessages != "" {
+		count, skipped, err := reencryptLegacyWasappMessages(ctx, dbStore, encryptionSvc, legacyKeys.WhatsappMessages)
+		if err != nil {
+			return err
+		}
+		log.Ctx(ctx).Info().
+			Int("reencrypted_count", count).
+			Int("skipped_count", skipped).
+			Msg("re-encrypted legacy wasapp messages")
+
+		count, skipped, err = reencryptLegacyChatSummaries(ctx, dbStore, encryptionSvc, legacyKeys.WhatsappMessages)
+		if err != nil {
+			return err
+		}
+		log.Ctx(ctx).Info().
+			Int("reencrypted_count", count).
+			Int("skipped_count", skipped).
+			Msg("re-encrypted legacy chat summaries")
+
+		count, skipped, err = reencryptLegacyChatImportExports(ctx, dbStore, encryptionSvc, legacyKeys.WhatsappMessages)
+		if err != nil {
+			return err
+		}
+		log.Ctx(ctx).Info().
+			Int("reencrypted_count", count).
+			Int("skipped_count", skipped).
+			Msg("re-encrypted legacy chat import exports")
+	}
+
+	return nil
+}
+
+// decryptLegacyValue decrypts a value encrypted with a legacy key, ok is false when it cannot be decrypted with the
+// key, in which case it is logged and should be skipped
+func decryptLegacyValue(ctx context.Context, dbStore store.Queries, legacyKey, value string, id uuid.UUID) (decrypted string, ok bool) {
+	decrypted, err := dbStore.DecryptLegacyValue(ctx, store.DecryptLegacyValueParams{
+		Value:         value,
+		EncryptionKey: legacyKey,
+	})
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("id", id.String()).Msg("failed running DecryptLegacyValue, skipping the value")
+		return "", false
+	}
+	return decrypted, true
+}
+
+func reencryptLegacyCalDavPasswords(ctx context.Context, dbStore store.Queries, encryptionSvc Svc, legacyKey string) (int, int, error) {
+	count, skipped := 0, 0
+	afterID := uuid.Nil
+	for {
+		accounts, err := dbStore.ListLegacyCalDavPasswords(ctx, store.ListLegacyCalDavPasswordsParams{
+			AfterID:   afterID,
+			BatchSize: legacyBatchSize,
+		})
+		if err != nil {
+			return count, skipped, fmt.Errorf("failed running ListLegacyCalDavPasswords: %w", err)
+		}
+
+		for _, account := range accounts {
+			afterID = account.ID
+
+			decryptedPassword, ok := decryptLegacyValue(ctx, dbStore, legacyKey, account.Password, account.ID)
+			if !ok {
+				skipped++
+				continue
+			}
+
+			password, err := encryptionSvc.Encrypt(ctx, account.CustomerID, decryptedPassword)
+			if err != nil {
+				return count, skipped, err
+			}
+
+			err = dbStore.SetCalDavAccountPassword(ctx, store.SetCalDavAccountPasswordParams{
+				ID:       account.ID,
+				Password: password,
+			})
+			if err != nil {
+				return count, skipped, fmt.Errorf("failed running SetCalDavAccountPassword: %w", err)
+			}
+			count++
+		}
+
+		if len(accounts) < legacyBatchSize {
+			return count, skipped, nil
+		}
+	}
+}
+
+func reencryptLegacyWasappMessages(ctx context.Context, dbStore store.Queries, encryptionSvc Svc, legacyKey string) (int, int, error) {
+	count, skipped := 0, 0
+	afterID := uuid.Nil
+	for {
+		msgs, err := dbStore.ListLegacyWasappMessages(ctx, store.ListLegacyWasappMessagesParams{
+			AfterID:   afterID,
+			BatchSize: legacyBatchSize,
+		})
+		if err != nil {
+			return count, skipped, fmt.Errorf("failed running ListLegacyWasappMessages: %w", err)
+		}
+
+		for _, msg := range msgs {
+			afterID = msg.ID
+
+			decryptedBody, ok := decryptLegacyValue(ctx, dbStore, legacyKey, msg.Body, msg.ID)
+			if !ok {
+				skipped++
+				continue
+			}
+			// the quoted body is null when the message does not quote one
+			decryptedQuotedBody := sql.NullString{}
+			if msg.QuotedBody.Valid {
+				decryptedQuotedBody.String, ok = decryptLegacyValue(ctx, dbStore, legacyKey, msg.QuotedBody.String, msg.ID)
+				if !ok {
+					skipped++
+					continue
+				}
+				decryptedQuotedBody.Valid = true
+			}
+
+			body, err := encryptionSvc.Encrypt(ctx, msg.CustomerID, decryptedBody)
+			if err != nil {
+				return count, skipped, err
+			}
+			quotedBody, err := encryptionSvc.EncryptNull(ctx, msg.CustomerID, decryptedQuotedBody)
+			if err != nil {
+				return count, skipped, err
+			}
+
+			err = dbStore.SetWasappMessageBodies(ctx, store.SetWasappMessageBodiesParams{
+				ID:         msg.ID,
+				Body:       body,
+				QuotedBody: quotedBody,
+			})
+			if err != nil {
+				return count, skipped, fmt.Errorf("failed running SetWasappMessageBodies: %w", err)
+			}
+			count++
+		}
+
+		if len(msgs) < legacyBatchSize {
+			return count, skipped, nil
+		}
+	}
+}
+
+func reencryptLegacyChatSummaries(ctx context.Context, dbStore store.Queries, encryptionSvc Svc, legacyKey string) (int, int, error) {
+	count, skipped := 0, 0
+	afterID := uuid.Nil
+	for {
+		chats, err := dbStore.ListLegacyChatSummaries(ctx, store.ListLegacyChatSummariesParams{
+			AfterID:   afterID,
+			BatchSize: legacyBatchSize,
+		})
+		if err != nil {
+			return count, skipped, fmt.Errorf("failed running ListLegacyChatSummaries: %w", err)
+		}
+
+		for _, chat := range chats {
+			afterID = chat.ID
+
+			decryptedSummary, ok := decryptLegacyValue(ctx, dbStore, legacyKey, chat.Summary, chat.ID)
+			if !ok {
+				skipped++
+				continue
+			}
+
+			summary, err := encryptionSvc.Encrypt(ctx, chat.CustomerID, decryptedSummary)
+			if err != nil {
+				return count, skipped, err
+			}
+
+			err = dbStore.SetChatSummary(ctx, store.SetChatSummaryParams{
+				ID:      chat.ID,
+				Summary: sql.NullString{String: summary, Valid: true},
+			})
+			if err != nil {
+				return count, skipped, fmt.Errorf("failed running SetChatSummary: %w", err)
+			}
+			count++
+		}
+
+		if len(chats) < legacyBatchSize {
+			return count, skipped, nil
+		}
+	}
+}
+
+func reencryptLegacyChatImportExports(ctx context.Context, dbStore store.Queries, encryptionSvc Svc, legacyKey string) (int, int, error) {
+	count, skipped := 0, 0
+	afterID := uuid.Nil
+	for {
+		chatImports, err := dbStore.ListLegacyWasappChatImportExports(ctx, store.ListLegacyWasappChatImportExportsParams{
+			AfterID:   afterID,
+			BatchSize: legacyBatchSize,
+		})
+		if err != nil {
+			return count, skipped, fmt.Errorf("failed running ListLegacyWasappChatImportExports: %w", err)
+		}
+
+		for _, chatImport := range chatImports {
+			afterID = chatImport.ID
+
+			decryptedExport, ok := decryptLegacyValue(ctx, dbStore, legacyKey, chatImport.Export, chatImport.ID)
+			if !ok {
+				skipped++
+				continue
+			}
+
+			export, err := encryptionSvc.Encrypt(ctx, chatImport.CustomerID, decryptedExport)
+			if err != nil {
+				return count, skipped, err
+			}
+
+			err = dbStore.SetWasappChatImportExport(ctx, store.SetWasappChatImportExportParams{
+				ID:     chatImport.ID,
+				Export: sql.NullString{String: export, Valid: true},
+			})
+			if err != nil {
+				return count, skipped, fmt.Errorf("failed running SetWasappChatImportExport: %w", err)
+			}
+			count++
+		}
+
+		if len(chatImports) < legacyBatchSize {
+			return count, skipped, nil
+		}
+	}
+}
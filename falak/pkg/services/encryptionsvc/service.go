@@ -0,0 +1,289 @@
+package encryptionsvc
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/keyring"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	dataKeySize = 32
+	// ciphertextPrefix marks the values encrypted with a data key, it has the version of the format which is followed
+	// by the base64 of the data key's ID, the nonce and the sealed value
+	ciphertextPrefix = "v1:"
+	// dataKeyCacheTTL is how long an unwrapped data key is kept in memory before it is unwrapped again. A cached key
+	// is only used while it is still the customer's key in the database, so a key another instance shredded is
+	// dropped on its next use instead of encrypting data that can never be read.
+	dataKeyCacheTTL = 10 * time.Minute
+)
+
+type dataKey struct {
+	id       uuid.UUID
+	aead     cipher.AEAD
+	loadedAt time.Time
+}
+
+type svc struct {
+	store      store.Queries
+	keyring    keyring.Keyring
+	legacyKeys LegacyKeys
+
+	mu       sync.Mutex
+	dataKeys map[uuid.UUID]*dataKey
+}
+
+func (s *svc) Encrypt(ctx context.Context, customerID uuid.UUID, plaintext string) (string, error) {
+	key, err := s.dataKey(ctx, customerID, true)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := make([]byte, 0, len(key.id)+len(nonce)+len(plaintext)+key.aead.Overhead())
+	sealed = append(sealed, key.id[:]...)
+	sealed = append(sealed, nonce...)
+	sealed = key.aead.Seal(sealed, nonce, []byte(plaintext), customerID[:])
+	return ciphertextPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (s *svc) Decrypt(ctx context.Context, customerID uuid.UUID, ciphertext string) (string, error) {
+	encoded, ok := strings.CutPrefix(ciphertext, ciphertextPrefix)
+	if !ok {
+		if strings.HasPrefix(ciphertext, legacyCiphertextPrefix) {
+			return s.decryptLegacy(ctx, ciphertext)
+		}
+		return "", ErrInvalidCiphertext
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+	if len(sealed) < len(uuid.UUID{}) {
+		return "", ErrInvalidCiphertext
+	}
+	keyID, err := uuid.FromBytes(sealed[:len(uuid.UUID{})])
+	if err != nil {
+		return "", ErrInvalidCiphertext
+	}
+	sealed = sealed[len(keyID):]
+
+	key, err := s.dataKey(ctx, customerID, false)
+	if err != nil {
+		return "", err
+	}
+	if key.id != keyID {
+		// the cached key may have been shredded and replaced by another instance
+		s.forgetDataKey(customerID)
+		key, err = s.dataKey(ctx, customerID, false)
+		if err != nil {
+			return "", err
+		}
+		if key.id != keyID {
+			return "", ErrDataKeyShredded
+		}
+	}
+
+	if len(sealed) < key.aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+	nonce, sealed := sealed[:key.aead.NonceSize()], sealed[key.aead.NonceSize():]
+	plaintext, err := key.aead.Open(nil, nonce, sealed, customerID[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+func (s *svc) EncryptNull(ctx context.Context, customerID uuid.UUID, plaintext sql.NullString) (sql.NullString, error) {
+	if !plaintext.Valid {
+		return sql.NullString{}, nil
+	}
+
+	ciphertext, err := s.Encrypt(ctx, customerID, plaintext.String)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: ciphertext, Valid: true}, nil
+}
+
+func (s *svc) DecryptNull(ctx context.Context, customerID uuid.UUID, ciphertext sql.NullString) (sql.NullString, error) {
+	if !ciphertext.Valid {
+		return sql.NullString{}, nil
+	}
+
+	plaintext, err := s.Decrypt(ctx, customerID, ciphertext.String)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: plaintext, Valid: true}, nil
+}
+
+func (s *svc) ShredCustomerKey(ctx context.Context, customerID uuid.UUID) error {
+	s.forgetDataKey(customerID)
+
+	shreddedCount, err := s.store.DeleteCustomerDataKey(ctx, customerID)
+	if err != nil {
+		return fmt.Errorf("failed running DeleteCustomerDataKey: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Str("customer_id", customerID.String()).
+		Int64("shredded_count", shreddedCount).
+		Msg("shredded customer data key")
+	return nil
+}
+
+// dataKey returns the customer's data key from the cache or the database, a customer without a data key gets a new one
+// when create is true, and ErrDataKeyShredded otherwise as there is nothing of theirs to decrypt.
+func (s *svc) dataKey(ctx context.Context, customerID uuid.UUID, create bool) (*dataKey, error) {
+	s.mu.Lock()
+	key, ok := s.dataKeys[customerID]
+	s.mu.Unlock()
+	if ok && time.Since(key.loadedAt) < dataKeyCacheTTL {
+		keyID, err := s.store.GetCustomerDataKeyId(ctx, customerID)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, fmt.Errorf("failed running GetCustomerDataKeyId: %w", err)
+		}
+		if err == nil && keyID == key.id {
+			return key, nil
+		}
+		s.forgetDataKey(customerID)
+	}
+
+	row, err := s.store.GetCustomerDataKey(ctx, customerID)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return nil, fmt.Errorf("failed running GetCustomerDataKey: %w", err)
+		}
+		if !create {
+			return nil, ErrDataKeyShredded
+		}
+		row, err = s.createDataKey(ctx, customerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plainKey, err := s.keyring.Unwrap(ctx, row.MasterKeyID, row.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap the data key of customer %s: %w", customerID, err)
+	}
+	if row.MasterKeyID != s.keyring.CurrentMasterKeyID() {
+		s.rewrapDataKey(ctx, row, plainKey)
+	}
+
+	block, err := aes.NewCipher(plainKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	key = &dataKey{
+		id:       row.ID,
+		aead:     aead,
+		loadedAt: time.Now(),
+	}
+	s.mu.Lock()
+	s.dataKeys[customerID] = key
+	s.mu.Unlock()
+	return key, nil
+}
+
+// createDataKey makes a data key for the customer, the key another instance made first is returned when both make
+// one at the same time
+func (s *svc) createDataKey(ctx context.Context, customerID uuid.UUID) (store.CustomerDataKey, error) {
+	plainKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(plainKey); err != nil {
+		return store.CustomerDataKey{}, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	wrappedKey, masterKeyID, err := s.keyring.Wrap(ctx, plainKey)
+	if err != nil {
+		return store.CustomerDataKey{}, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	row, err := s.store.CreateCustomerDataKey(ctx, store.CreateCustomerDataKeyParams{
+		CustomerID:  customerID,
+		WrappedKey:  wrappedKey,
+		MasterKeyID: masterKeyID,
+	})
+	if err != nil {
+		return store.CustomerDataKey{}, fmt.Errorf("failed running CreateCustomerDataKey: %w", err)
+	}
+	return row, nil
+}
+
+// rewrapDataKey wraps a data key that was wrapped with an older master key with the current one, so the older master
+// key can be taken out of the keyring once every data key was used with the new one. It is only logged when it fails
+// as the data key can still be unwrapped.
+func (s *svc) rewrapDataKey(ctx context.Context, row store.CustomerDataKey, plainKey []byte) {
+	wrappedKey, masterKeyID, err := s.keyring.Wrap(ctx, plainKey)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Str("customer_id", row.CustomerID.String()).Msg("failed to rewrap data key")
+		return
+	}
+
+	err = s.store.RewrapCustomerDataKey(ctx, store.RewrapCustomerDataKeyParams{
+		ID:          row.ID,
+		WrappedKey:  wrappedKey,
+		MasterKeyID: masterKeyID,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Str("customer_id", row.CustomerID.String()).Msg("failed running RewrapCustomerDataKey")
+	}
+}
+
+// decryptLegacy decrypts a value that was not re-encrypted with a data key yet with the legacy keys, so the values
+// ReencryptLegacyData did not get to yet can still be read while it runs
+func (s *svc) decryptLegacy(ctx context.Context, ciphertext string) (string, error) {
+	for _, legacyKey := range []string{s.legacyKeys.CalDAVPassword, s.legacyKeys.WhatsappMessages} {
+		if legacyKey == "" {
+			continue
+		}
+
+		plaintext, err := s.store.DecryptLegacyValue(ctx, store.DecryptLegacyValueParams{
+			Value:         ciphertext,
+			EncryptionKey: legacyKey,
+		})
+		if err == nil {
+			return plaintext, nil
+		}
+	}
+	return "", ErrInvalidCiphertext
+}
+
+func (s *svc) forgetDataKey(customerID uuid.UUID) {
+	s.mu.Lock()
+	delete(s.dataKeys, customerID)
+	s.mu.Unlock()
+}
+
+// NewSvc returns the encryption service, legacyKeys are used to decrypt the values that are still encrypted with
+// them until ReencryptLegacyData re-encrypted them
+func NewSvc(store store.Queries, keyring keyring.Keyring, legacyKeys LegacyKeys) Svc {
+	return &svc{
+		store:      store,
+		keyring:    keyring,
+		legacyKeys: legacyKeys,
+		dataKeys:   make(map[uuid.UUID]*dataKey),
+	}
+}
@@ -0,0 +1,32 @@
+package encryptionsvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrDataKeyShredded is returned when decrypting a value whose data key was deleted, the value can not be read
+	// anymore
+	ErrDataKeyShredded   = errors.New("the data key the value was encrypted with was shredded")
+	ErrInvalidCiphertext = errors.New("the value is not encrypted with a data key")
+)
+
+// Svc encrypts the customers' data with a data key of their own, the data keys are kept in the database wrapped with
+// a master key from the keyring so neither the keys nor the plaintext ever reach Postgres.
+type Svc interface {
+	// Encrypt encrypts the value with the customer's data key, the key is made the first time it is needed
+	Encrypt(ctx context.Context, customerID uuid.UUID, plaintext string) (string, error)
+	// Decrypt decrypts a value Encrypt returned for the customer
+	Decrypt(ctx context.Context, customerID uuid.UUID, ciphertext string) (string, error)
+	// EncryptNull encrypts the value when it is valid
+	EncryptNull(ctx context.Context, customerID uuid.UUID, plaintext sql.NullString) (sql.NullString, error)
+	// DecryptNull decrypts the value when it is valid
+	DecryptNull(ctx context.Context, customerID uuid.UUID, ciphertext sql.NullString) (sql.NullString, error)
+	// ShredCustomerKey deletes the customer's data key, which makes everything encrypted with it unreadable. The
+	// customer gets a new data key the next time something of theirs is encrypted.
+	ShredCustomerKey(ctx context.Context, customerID uuid.UUID) error
+}
@@ -36,6 +36,12 @@ func (s *svc) SendNotificationToCustomerDevices(ctx context.Context, r *SendNoti
 		AlertTitle(r.AlertTitle).
 		AlertBody(r.AlertBody).
 		Sound("default")
+	if r.Category != nil {
+		foregroundPayload = foregroundPayload.Category(*r.Category)
+	}
+	if r.DetectedEventID != nil {
+		foregroundPayload = foregroundPayload.Custom("detected_event_id", *r.DetectedEventID)
+	}
 
 	var backgroundPayload *payload.Payload
 	if r.EventUID != nil && r.EventTitle != nil && r.EventStartDate != nil && r.EventEndDate != nil {
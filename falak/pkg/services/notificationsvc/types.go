@@ -7,6 +7,12 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// Category_DetectedEvent is the category of the alerts asking the customer to add an event found in a chat, the app
+	// registers it with an "Add" and a "Dismiss" action that call ConfirmDetectedEvent and RejectDetectedEvent
+	Category_DetectedEvent = "DETECTED_EVENT"
+)
+
 type SendNotificationToCustomerDevicesRequest struct {
 	CustomerId     uuid.UUID
 	AlertTitle     string
@@ -16,6 +22,10 @@ type SendNotificationToCustomerDevicesRequest struct {
 	EventStartDate *time.Time
 	EventEndDate   *time.Time
 	CalendarName   *string
+	// Category makes the alert actionable, the actions are the ones the app registered for it
+	Category *string
+	// DetectedEventID is the detected event the actions of the alert apply to
+	DetectedEventID *string
 }
 
 type Svc interface {
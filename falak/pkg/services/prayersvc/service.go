@@ -0,0 +1,85 @@
+package prayersvc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayer"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+)
+
+const (
+	defaultLatitude  = 24.7136
+	defaultLongitude = 46.6753
+	defaultTimezone  = "Asia/Riyadh"
+
+	defaultJumuahLeaveBy = 30 * time.Minute
+)
+
+type svc struct {
+	store store.Queries
+}
+
+func (s *svc) GetCustomerSettings(ctx context.Context, customerID uuid.UUID) (*CustomerSettings, error) {
+	setting, err := s.store.GetPrayerSettingByCustomerId(ctx, customerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoSettings
+		}
+		return nil, err
+	}
+
+	tz, err := time.LoadLocation(setting.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q in prayer settings: %w", setting.Timezone, err)
+	}
+
+	return &CustomerSettings{
+		Location: prayer.Location{
+			Latitude:  setting.Latitude,
+			Longitude: setting.Longitude,
+			Timezone:  tz,
+		},
+		Config: prayer.Config{
+			Method:      prayer.Method(setting.CalculationMethod),
+			AsrJuristic: prayer.AsrJuristic(setting.AsrJuristic),
+		},
+		Jumuah: JumuahSettings{
+			KhutbahTime: setting.JumuahKhutbahTime.String,
+			LeaveBy:     time.Duration(setting.JumuahLeaveByMinutes) * time.Minute,
+		},
+	}, nil
+}
+
+// DefaultSettings are used for customers who have not set their prayer settings yet, it is Riyadh with the Umm al-Qura method
+func DefaultSettings() *CustomerSettings {
+	tz, err := time.LoadLocation(defaultTimezone)
+	if err != nil {
+		tz = time.FixedZone(defaultTimezone, 3*60*60)
+	}
+
+	return &CustomerSettings{
+		Location: prayer.Location{
+			Latitude:  defaultLatitude,
+			Longitude: defaultLongitude,
+			Timezone:  tz,
+		},
+		Config: prayer.Config{
+			Method:      prayer.Method_UmmAlQura,
+			AsrJuristic: prayer.AsrJuristic_Shafi,
+		},
+		Jumuah: JumuahSettings{
+			LeaveBy: defaultJumuahLeaveBy,
+		},
+	}
+}
+
+func NewSvc(store store.Queries) Svc {
+	return &svc{
+		store: store,
+	}
+}
@@ -0,0 +1,34 @@
+package prayersvc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayer"
+)
+
+var (
+	ErrNoSettings = errors.New("customer has no prayer settings")
+)
+
+// CustomerSettings is what is needed to compute the prayer times of a customer
+type CustomerSettings struct {
+	Location prayer.Location
+	Config   prayer.Config
+	Jumuah   JumuahSettings
+}
+
+type JumuahSettings struct {
+	// KhutbahTime is the time the khutbah starts at the customer's masjid in the HH:mm format, empty to follow Dhuhr
+	KhutbahTime string
+	// LeaveBy is how long before the khutbah the customer is reminded to leave
+	LeaveBy time.Duration
+}
+
+// Svc defines the prayer service interface
+type Svc interface {
+	// GetCustomerSettings returns the prayer settings of a customer, ErrNoSettings is returned if the customer has not set them yet
+	GetCustomerSettings(ctx context.Context, customerID uuid.UUID) (*CustomerSettings, error)
+}
@@ -7,23 +7,21 @@ package store
 
 import (
 	"context"
-	"time"
 
 	"github.com/google/uuid"
 )
 
 const createCalDavAccount = `-- name: CreateCalDavAccount :one
 INSERT INTO caldav_account (customer_id, email, username, password)
-VALUES ($1, $2, $3, pgp_sym_encrypt($4::text, $5::text, 'cipher-algo=aes256'))
+VALUES ($1, $2, $3, $4)
 RETURNING id, customer_id, email, username, password, created_at, updated_at
 `
 
 type CreateCalDavAccountParams struct {
-	CustomerID    uuid.UUID
-	Email         string
-	Username      string
-	Password      string
-	EncryptionKey string
+	CustomerID uuid.UUID
+	Email      string
+	Username   string
+	Password   string
 }
 
 func (q *Queries) CreateCalDavAccount(ctx context.Context, arg CreateCalDavAccountParams) (CaldavAccount, error) {
@@ -32,7 +30,6 @@ func (q *Queries) CreateCalDavAccount(ctx context.Context, arg CreateCalDavAccou
 		arg.Email,
 		arg.Username,
 		arg.Password,
-		arg.EncryptionKey,
 	)
 	var i CaldavAccount
 	err := row.Scan(
@@ -47,31 +44,24 @@ func (q *Queries) CreateCalDavAccount(ctx context.Context, arg CreateCalDavAccou
 	return i, err
 }
 
-const getCalDavAccountByCustomerId = `-- name: GetCalDavAccountByCustomerId :one
-SELECT id, customer_id, email, username, password, created_at, updated_at, pgp_sym_decrypt(ca.password::bytea, $2::text) AS decrypted_password
-FROM caldav_account ca
-WHERE ca.customer_id = $1
+const deleteCalDavAccountByCustomerId = `-- name: DeleteCalDavAccountByCustomerId :exec
+DELETE FROM caldav_account WHERE customer_id = $1
 `
 
-type GetCalDavAccountByCustomerIdParams struct {
-	CustomerID    uuid.UUID
-	EncryptionKey string
+func (q *Queries) DeleteCalDavAccountByCustomerId(ctx context.Context, customerID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteCalDavAccountByCustomerId, customerID)
+	return err
 }
 
-type GetCalDavAccountByCustomerIdRow struct {
-	ID                uuid.UUID
-	CustomerID        uuid.UUID
-	Email             string
-	Username          string
-	Password          string
-	CreatedAt         time.Time
-	UpdatedAt         time.Time
-	DecryptedPassword string
-}
+const getCalDavAccountByCustomerId = `-- name: GetCalDavAccountByCustomerId :one
+SELECT id, customer_id, email, username, password, created_at, updated_at
+FROM caldav_account
+WHERE customer_id = $1
+`
 
-func (q *Queries) GetCalDavAccountByCustomerId(ctx context.Context, arg GetCalDavAccountByCustomerIdParams) (GetCalDavAccountByCustomerIdRow, error) {
-	row := q.db.QueryRowContext(ctx, getCalDavAccountByCustomerId, arg.CustomerID, arg.EncryptionKey)
-	var i GetCalDavAccountByCustomerIdRow
+func (q *Queries) GetCalDavAccountByCustomerId(ctx context.Context, customerID uuid.UUID) (CaldavAccount, error) {
+	row := q.db.QueryRowContext(ctx, getCalDavAccountByCustomerId, customerID)
+	var i CaldavAccount
 	err := row.Scan(
 		&i.ID,
 		&i.CustomerID,
@@ -80,7 +70,6 @@ func (q *Queries) GetCalDavAccountByCustomerId(ctx context.Context, arg GetCalDa
 		&i.Password,
 		&i.CreatedAt,
 		&i.UpdatedAt,
-		&i.DecryptedPassword,
 	)
 	return i, err
 }
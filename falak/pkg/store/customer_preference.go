@@ -0,0 +1,18 @@
+package store
+
+// DefaultWasappMessageRetentionHours is how long WhatsApp messages are kept for customers who did not pick it when no
+// default retention is configured
+const DefaultWasappMessageRetentionHours = 30 * 24
+
+// DefaultCustomerPreference is the preference of a customer who never changed it, it matches the defaults of the
+// customer_preference columns
+func DefaultCustomerPreference() CustomerPreference {
+	return CustomerPreference{
+		HijriDateAnnotation:  HijriDateAnnotationOff,
+		PrayerConflictMode:   PrayerConflictModeWarn,
+		EventApprovalMode:    EventApprovalModeAuto,
+		TentativeHolds:       false,
+		GroupAgreementRule:   GroupAgreementRuleCustomer,
+		WasappMonitoringMode: WasappMonitoringModeAll,
+	}
+}
@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS update_calendar_feed_updated_at ON calendar_feed;
+DROP TABLE IF EXISTS calendar_feed;
+DROP TYPE IF EXISTS calendar_feed_type;
+
+DROP TRIGGER IF EXISTS update_prayer_setting_updated_at ON prayer_setting;
+DROP TABLE IF EXISTS prayer_setting;
@@ -0,0 +1,37 @@
+CREATE TABLE prayer_setting (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    customer_id UUID NOT NULL UNIQUE REFERENCES customer(id) ON DELETE CASCADE,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    timezone TEXT NOT NULL,
+    calculation_method TEXT NOT NULL,
+    asr_juristic TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE TRIGGER update_prayer_setting_updated_at
+    BEFORE UPDATE ON prayer_setting
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
+
+CREATE TYPE calendar_feed_type AS ENUM (
+  'occasions'
+);
+
+CREATE TABLE calendar_feed (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    customer_id UUID NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+    feed_type calendar_feed_type NOT NULL,
+    token_hash CHAR(44) NOT NULL UNIQUE,
+    lang VARCHAR(2) NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    UNIQUE (customer_id, feed_type)
+);
+CREATE TRIGGER update_calendar_feed_updated_at
+    BEFORE UPDATE ON calendar_feed
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
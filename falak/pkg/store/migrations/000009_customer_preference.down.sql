@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_customer_preference_updated_at ON customer_preference;
+DROP TABLE IF EXISTS customer_preference;
+DROP TYPE IF EXISTS hijri_date_annotation;
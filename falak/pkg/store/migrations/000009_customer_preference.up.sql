@@ -0,0 +1,18 @@
+CREATE TYPE hijri_date_annotation AS ENUM (
+  'off',
+  'arabic',
+  'english'
+);
+
+CREATE TABLE customer_preference (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    customer_id UUID NOT NULL UNIQUE REFERENCES customer(id) ON DELETE CASCADE,
+    hijri_date_annotation hijri_date_annotation NOT NULL DEFAULT 'off',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE TRIGGER update_customer_preference_updated_at
+    BEFORE UPDATE ON customer_preference
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
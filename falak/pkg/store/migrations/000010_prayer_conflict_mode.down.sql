@@ -0,0 +1,2 @@
+ALTER TABLE customer_preference DROP COLUMN IF EXISTS prayer_conflict_mode;
+DROP TYPE IF EXISTS prayer_conflict_mode;
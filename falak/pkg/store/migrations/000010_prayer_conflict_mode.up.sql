@@ -0,0 +1,8 @@
+CREATE TYPE prayer_conflict_mode AS ENUM (
+  'off',
+  'warn',
+  'buffer',
+  'shift'
+);
+
+ALTER TABLE customer_preference ADD COLUMN prayer_conflict_mode prayer_conflict_mode NOT NULL DEFAULT 'warn';
@@ -0,0 +1,10 @@
+ALTER TABLE prayer_setting DROP COLUMN IF EXISTS jumuah_leave_by_minutes;
+ALTER TABLE prayer_setting DROP COLUMN IF EXISTS jumuah_khutbah_time;
+
+DELETE FROM calendar_feed WHERE feed_type = 'prayer';
+ALTER TYPE calendar_feed_type RENAME TO calendar_feed_type_old;
+CREATE TYPE calendar_feed_type AS ENUM (
+  'occasions'
+);
+ALTER TABLE calendar_feed ALTER COLUMN feed_type TYPE calendar_feed_type USING feed_type::text::calendar_feed_type;
+DROP TYPE calendar_feed_type_old;
@@ -0,0 +1,4 @@
+ALTER TYPE calendar_feed_type ADD VALUE 'prayer';
+
+ALTER TABLE prayer_setting ADD COLUMN jumuah_khutbah_time VARCHAR(5);
+ALTER TABLE prayer_setting ADD COLUMN jumuah_leave_by_minutes INTEGER NOT NULL DEFAULT 30;
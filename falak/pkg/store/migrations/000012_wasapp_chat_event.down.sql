@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_wasapp_chat_event_updated_at ON wasapp_chat_event;
+DROP TABLE IF EXISTS wasapp_chat_event;
@@ -0,0 +1,17 @@
+CREATE TABLE wasapp_chat_event (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    customer_id UUID NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+    chat_id TEXT NOT NULL,
+    event_uid TEXT NOT NULL UNIQUE,
+    summary TEXT NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX wasapp_chat_event_customer_id_chat_id_idx ON wasapp_chat_event (customer_id, chat_id);
+CREATE TRIGGER update_wasapp_chat_event_updated_at
+    BEFORE UPDATE ON wasapp_chat_event
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
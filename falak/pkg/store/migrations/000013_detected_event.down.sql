@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_detected_event_updated_at ON detected_event;
+DROP TABLE IF EXISTS detected_event;
+DROP TYPE IF EXISTS detected_event_state;
@@ -0,0 +1,32 @@
+CREATE TYPE detected_event_state AS ENUM (
+  'proposed',
+  'added',
+  'updated',
+  'cancelled',
+  'rejected'
+);
+
+CREATE TABLE detected_event (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    customer_id UUID NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+    chat_id TEXT NOT NULL,
+    message_ids JSONB NOT NULL,
+    analysis_status TEXT NOT NULL,
+    raw_analysis JSONB NOT NULL,
+    model TEXT NOT NULL,
+    prompt_version TEXT NOT NULL,
+    state detected_event_state NOT NULL DEFAULT 'proposed',
+    summary TEXT,
+    start_time TIMESTAMPTZ,
+    end_time TIMESTAMPTZ,
+    caldav_uid TEXT UNIQUE,
+    caldav_path TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX detected_event_customer_id_created_at_idx ON detected_event (customer_id, created_at DESC);
+CREATE TRIGGER update_detected_event_updated_at
+    BEFORE UPDATE ON detected_event
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
@@ -0,0 +1,12 @@
+CREATE TYPE event_approval_mode AS ENUM (
+  'auto',
+  'ask'
+);
+
+ALTER TABLE customer_preference ADD COLUMN event_approval_mode event_approval_mode NOT NULL DEFAULT 'auto';
+
+ALTER TYPE detected_event_state ADD VALUE 'pending';
+ALTER TYPE detected_event_state ADD VALUE 'expired';
+
+ALTER TABLE detected_event ADD COLUMN description TEXT;
+ALTER TABLE detected_event ADD COLUMN flexible_time BOOLEAN NOT NULL DEFAULT false;
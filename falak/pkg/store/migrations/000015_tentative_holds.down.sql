@@ -0,0 +1,19 @@
+ALTER TABLE wasapp_chat_event DROP COLUMN IF EXISTS tentative;
+
+UPDATE detected_event SET state = 'proposed' WHERE state = 'tentative';
+ALTER TABLE detected_event ALTER COLUMN state DROP DEFAULT;
+ALTER TYPE detected_event_state RENAME TO detected_event_state_old;
+CREATE TYPE detected_event_state AS ENUM (
+  'proposed',
+  'added',
+  'updated',
+  'cancelled',
+  'rejected',
+  'pending',
+  'expired'
+);
+ALTER TABLE detected_event ALTER COLUMN state TYPE detected_event_state USING state::text::detected_event_state;
+ALTER TABLE detected_event ALTER COLUMN state SET DEFAULT 'proposed';
+DROP TYPE detected_event_state_old;
+
+ALTER TABLE customer_preference DROP COLUMN IF EXISTS tentative_holds;
@@ -0,0 +1,5 @@
+ALTER TABLE customer_preference ADD COLUMN tentative_holds BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TYPE detected_event_state ADD VALUE 'tentative';
+
+ALTER TABLE wasapp_chat_event ADD COLUMN tentative BOOLEAN NOT NULL DEFAULT false;
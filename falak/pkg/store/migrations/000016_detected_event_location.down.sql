@@ -0,0 +1,4 @@
+ALTER TABLE detected_event DROP COLUMN IF EXISTS attendees;
+ALTER TABLE detected_event DROP COLUMN IF EXISTS longitude;
+ALTER TABLE detected_event DROP COLUMN IF EXISTS latitude;
+ALTER TABLE detected_event DROP COLUMN IF EXISTS location;
@@ -0,0 +1,4 @@
+ALTER TABLE detected_event ADD COLUMN location TEXT;
+ALTER TABLE detected_event ADD COLUMN latitude DOUBLE PRECISION;
+ALTER TABLE detected_event ADD COLUMN longitude DOUBLE PRECISION;
+ALTER TABLE detected_event ADD COLUMN attendees JSONB NOT NULL DEFAULT '[]';
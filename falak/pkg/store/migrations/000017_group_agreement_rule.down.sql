@@ -0,0 +1,2 @@
+ALTER TABLE customer_preference DROP COLUMN IF EXISTS group_agreement_rule;
+DROP TYPE IF EXISTS group_agreement_rule;
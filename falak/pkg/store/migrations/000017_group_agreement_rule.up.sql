@@ -0,0 +1,7 @@
+CREATE TYPE group_agreement_rule AS ENUM (
+  'customer',
+  'majority',
+  'organizer'
+);
+
+ALTER TABLE customer_preference ADD COLUMN group_agreement_rule group_agreement_rule NOT NULL DEFAULT 'customer';
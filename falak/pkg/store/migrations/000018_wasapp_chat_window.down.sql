@@ -0,0 +1,3 @@
+ALTER TABLE wasapp_chat
+    DROP COLUMN event_activity_at,
+    DROP COLUMN summary;
@@ -0,0 +1,3 @@
+ALTER TABLE wasapp_chat
+    ADD COLUMN summary TEXT,
+    ADD COLUMN event_activity_at TIMESTAMPTZ NOT NULL DEFAULT now();
@@ -0,0 +1,6 @@
+ALTER TABLE wasapp_message
+    DROP COLUMN quoted_body,
+    DROP COLUMN quoted_timestamp,
+    DROP COLUMN quoted_is_sender_me,
+    DROP COLUMN quoted_sender_name,
+    DROP COLUMN quoted_message_id;
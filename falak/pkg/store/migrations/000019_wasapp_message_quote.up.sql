@@ -0,0 +1,6 @@
+ALTER TABLE wasapp_message
+    ADD COLUMN quoted_message_id TEXT,
+    ADD COLUMN quoted_sender_name TEXT,
+    ADD COLUMN quoted_is_sender_me BOOLEAN,
+    ADD COLUMN quoted_timestamp BIGINT,
+    ADD COLUMN quoted_body TEXT;
@@ -0,0 +1,13 @@
+CREATE TABLE wasapp_analysis_failure (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    customer_id UUID NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+    chat_id TEXT NOT NULL,
+    message_ids JSONB NOT NULL,
+    model TEXT NOT NULL,
+    prompt_version TEXT NOT NULL,
+    raw_analysis TEXT NOT NULL,
+    error TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX wasapp_analysis_failure_customer_id_created_at_idx ON wasapp_analysis_failure (customer_id, created_at DESC);
@@ -0,0 +1,2 @@
+ALTER TABLE wasapp_analysis_failure DROP COLUMN analyzer_backend;
+ALTER TABLE detected_event DROP COLUMN analyzer_backend;
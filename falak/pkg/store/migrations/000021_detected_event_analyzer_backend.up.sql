@@ -0,0 +1,2 @@
+ALTER TABLE detected_event ADD COLUMN analyzer_backend TEXT NOT NULL DEFAULT 'llm';
+ALTER TABLE wasapp_analysis_failure ADD COLUMN analyzer_backend TEXT NOT NULL DEFAULT 'llm';
@@ -0,0 +1 @@
+DROP TABLE wasapp_analysis_stat;
@@ -0,0 +1,10 @@
+CREATE TABLE wasapp_analysis_stat (
+    day DATE NOT NULL,
+    analyzer_backend TEXT NOT NULL,
+    prompt_version TEXT NOT NULL,
+    model TEXT NOT NULL,
+    successes INTEGER NOT NULL DEFAULT 0,
+    failures INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (day, analyzer_backend, prompt_version, model)
+);
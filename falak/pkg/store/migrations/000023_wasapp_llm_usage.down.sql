@@ -0,0 +1,3 @@
+ALTER TABLE wasapp_chat DROP COLUMN skipped_messages;
+
+DROP TABLE wasapp_llm_usage;
@@ -0,0 +1,13 @@
+CREATE TABLE wasapp_llm_usage (
+    customer_id UUID NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    model TEXT NOT NULL,
+    calls INTEGER NOT NULL DEFAULT 0,
+    prompt_tokens BIGINT NOT NULL DEFAULT 0,
+    completion_tokens BIGINT NOT NULL DEFAULT 0,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (customer_id, day, model)
+);
+
+ALTER TABLE wasapp_chat ADD COLUMN skipped_messages INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_wasapp_chat_pending_until;
+
+ALTER TABLE wasapp_chat
+    DROP COLUMN analyzing_until,
+    DROP COLUMN pending_version,
+    DROP COLUMN pending_until,
+    DROP COLUMN pending_since;
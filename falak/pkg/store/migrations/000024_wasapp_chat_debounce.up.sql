@@ -0,0 +1,7 @@
+ALTER TABLE wasapp_chat
+    ADD COLUMN pending_since TIMESTAMPTZ,
+    ADD COLUMN pending_until TIMESTAMPTZ,
+    ADD COLUMN pending_version INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN analyzing_until TIMESTAMPTZ;
+
+CREATE INDEX idx_wasapp_chat_pending_until ON wasapp_chat(pending_until) WHERE pending_until IS NOT NULL;
@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS update_wasapp_chat_setting_updated_at ON wasapp_chat_setting;
+DROP TABLE IF EXISTS wasapp_chat_setting;
+
+ALTER TABLE customer_preference DROP COLUMN wasapp_monitoring_mode;
+
+DROP TYPE IF EXISTS wasapp_monitoring_mode;
+DROP TYPE IF EXISTS chat_monitoring;
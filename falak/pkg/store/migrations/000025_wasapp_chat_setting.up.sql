@@ -0,0 +1,32 @@
+CREATE TYPE chat_monitoring AS ENUM (
+  'default',
+  'included',
+  'excluded',
+  'muted'
+);
+
+CREATE TYPE wasapp_monitoring_mode AS ENUM (
+  'all',
+  'allowlist'
+);
+
+ALTER TABLE customer_preference ADD COLUMN wasapp_monitoring_mode wasapp_monitoring_mode NOT NULL DEFAULT 'all';
+
+CREATE TABLE wasapp_chat_setting (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    customer_id UUID NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+    chat_id TEXT NOT NULL,
+    chat_name TEXT NOT NULL DEFAULT '',
+    monitoring chat_monitoring NOT NULL DEFAULT 'default',
+    muted_until TIMESTAMPTZ,
+    last_message_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+
+    UNIQUE (customer_id, chat_id)
+);
+CREATE TRIGGER update_wasapp_chat_setting_updated_at
+    BEFORE UPDATE ON wasapp_chat_setting
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
@@ -0,0 +1,6 @@
+ALTER TABLE detected_event DROP COLUMN IF EXISTS chat_import_id;
+
+DROP TRIGGER IF EXISTS update_wasapp_chat_import_updated_at ON wasapp_chat_import;
+DROP TABLE IF EXISTS wasapp_chat_import;
+
+DROP TYPE IF EXISTS wasapp_chat_import_status;
@@ -0,0 +1,36 @@
+CREATE TYPE wasapp_chat_import_status AS ENUM (
+  'pending',
+  'analyzing',
+  'done',
+  'failed'
+);
+
+CREATE TABLE wasapp_chat_import (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    customer_id UUID NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+    chat_name TEXT NOT NULL DEFAULT '',
+    is_group BOOLEAN NOT NULL DEFAULT FALSE,
+    -- the name the customer's own messages are under in the export
+    sender_name TEXT NOT NULL DEFAULT '',
+    status wasapp_chat_import_status NOT NULL DEFAULT 'pending',
+    -- the encrypted text of the export, it is cleared once the import is done with
+    export TEXT,
+    messages_count INTEGER NOT NULL,
+    windows_count INTEGER NOT NULL DEFAULT 0,
+    windows_analyzed INTEGER NOT NULL DEFAULT 0,
+    events_count INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    analyzing_until TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX idx_wasapp_chat_import_customer_id ON wasapp_chat_import(customer_id);
+CREATE INDEX idx_wasapp_chat_import_unfinished ON wasapp_chat_import(created_at) WHERE status IN ('pending', 'analyzing');
+CREATE TRIGGER update_wasapp_chat_import_updated_at
+    BEFORE UPDATE ON wasapp_chat_import
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
+
+ALTER TABLE detected_event ADD COLUMN chat_import_id UUID REFERENCES wasapp_chat_import(id) ON DELETE CASCADE;
+CREATE INDEX idx_detected_event_chat_import_id ON detected_event(chat_import_id) WHERE chat_import_id IS NOT NULL;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS wasapp_retention_stat;
+
+DROP INDEX IF EXISTS idx_wasapp_message_created_at;
+
+ALTER TABLE wasapp_chat DROP COLUMN summary_since;
+
+ALTER TABLE customer_preference DROP COLUMN wasapp_message_retention_hours;
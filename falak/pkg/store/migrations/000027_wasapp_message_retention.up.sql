@@ -0,0 +1,15 @@
+-- the hours a customer's messages are kept for, the configured default is used when it is NULL
+ALTER TABLE customer_preference ADD COLUMN wasapp_message_retention_hours INTEGER;
+
+-- when the oldest message folded into the summary was stored, the summary expires along with it
+ALTER TABLE wasapp_chat ADD COLUMN summary_since TIMESTAMPTZ;
+UPDATE wasapp_chat SET summary_since = updated_at WHERE summary IS NOT NULL;
+
+CREATE INDEX idx_wasapp_message_created_at ON wasapp_message(created_at);
+
+CREATE TABLE wasapp_retention_stat (
+    day DATE PRIMARY KEY,
+    messages_purged BIGINT NOT NULL DEFAULT 0,
+    summaries_purged BIGINT NOT NULL DEFAULT 0,
+    chats_purged BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_customer_data_key_updated_at ON customer_data_key;
+DROP TABLE IF EXISTS customer_data_key;
@@ -0,0 +1,14 @@
+CREATE TABLE customer_data_key (
+    id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+    customer_id UUID NOT NULL UNIQUE REFERENCES customer(id) ON DELETE CASCADE,
+    -- the data key the customer's messages and passwords are encrypted with, encrypted with the master key
+    wrapped_key BYTEA NOT NULL,
+    master_key_id TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE TRIGGER update_customer_data_key_updated_at
+    BEFORE UPDATE ON customer_data_key
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
@@ -0,0 +1,2 @@
+-- the locale of the phone the chat was exported from, it tells if the dates in the export put the day or the month first
+ALTER TABLE wasapp_chat_import ADD COLUMN locale TEXT NOT NULL DEFAULT '';
@@ -0,0 +1 @@
+ALTER TABLE wasapp_chat DROP COLUMN analysis_attempts;
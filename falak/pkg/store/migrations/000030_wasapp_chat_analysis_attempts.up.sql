@@ -0,0 +1,2 @@
+-- how many times in a row the chat failed to be analyzed, the chat is given up on after too many
+ALTER TABLE wasapp_chat ADD COLUMN analysis_attempts INTEGER NOT NULL DEFAULT 0;
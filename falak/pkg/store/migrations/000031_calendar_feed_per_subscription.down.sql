@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_calendar_feed_customer_id;
+
+DELETE FROM calendar_feed f
+WHERE EXISTS (
+  SELECT 1
+  FROM calendar_feed newer
+  WHERE newer.customer_id = f.customer_id AND newer.feed_type = f.feed_type AND newer.created_at > f.created_at
+);
+
+ALTER TABLE calendar_feed ADD CONSTRAINT calendar_feed_customer_id_feed_type_key UNIQUE (customer_id, feed_type);
@@ -0,0 +1,4 @@
+-- every subscription to a feed gets its own token, so subscribing again does not break the earlier subscriptions
+ALTER TABLE calendar_feed DROP CONSTRAINT calendar_feed_customer_id_feed_type_key;
+
+CREATE INDEX idx_calendar_feed_customer_id ON calendar_feed(customer_id, feed_type);
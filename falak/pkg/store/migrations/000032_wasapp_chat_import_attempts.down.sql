@@ -0,0 +1 @@
+ALTER TABLE wasapp_chat_import DROP COLUMN attempts;
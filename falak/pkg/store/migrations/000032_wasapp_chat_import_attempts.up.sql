@@ -0,0 +1,2 @@
+-- how many times in a row the import failed to be analyzed, the import fails after too many
+ALTER TABLE wasapp_chat_import ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0;
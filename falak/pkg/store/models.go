@@ -7,12 +7,275 @@ package store
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type CalendarFeedType string
+
+const (
+	CalendarFeedTypeOccasions CalendarFeedType = "occasions"
+	CalendarFeedTypePrayer    CalendarFeedType = "prayer"
+)
+
+func (e *CalendarFeedType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CalendarFeedType(s)
+	case string:
+		*e = CalendarFeedType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CalendarFeedType: %T", src)
+	}
+	return nil
+}
+
+type NullCalendarFeedType struct {
+	CalendarFeedType CalendarFeedType
+	Valid            bool // Valid is true if CalendarFeedType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCalendarFeedType) Scan(value interface{}) error {
+	if value == nil {
+		ns.CalendarFeedType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CalendarFeedType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCalendarFeedType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CalendarFeedType), nil
+}
+
+type ChatMonitoring string
+
+const (
+	ChatMonitoringDefault  ChatMonitoring = "default"
+	ChatMonitoringIncluded ChatMonitoring = "included"
+	ChatMonitoringExcluded ChatMonitoring = "excluded"
+	ChatMonitoringMuted    ChatMonitoring = "muted"
+)
+
+func (e *ChatMonitoring) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ChatMonitoring(s)
+	case string:
+		*e = ChatMonitoring(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ChatMonitoring: %T", src)
+	}
+	return nil
+}
+
+type NullChatMonitoring struct {
+	ChatMonitoring ChatMonitoring
+	Valid          bool // Valid is true if ChatMonitoring is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullChatMonitoring) Scan(value interface{}) error {
+	if value == nil {
+		ns.ChatMonitoring, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ChatMonitoring.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullChatMonitoring) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ChatMonitoring), nil
+}
+
+type DetectedEventState string
+
+const (
+	DetectedEventStateProposed  DetectedEventState = "proposed"
+	DetectedEventStateAdded     DetectedEventState = "added"
+	DetectedEventStateUpdated   DetectedEventState = "updated"
+	DetectedEventStateCancelled DetectedEventState = "cancelled"
+	DetectedEventStateRejected  DetectedEventState = "rejected"
+	DetectedEventStatePending   DetectedEventState = "pending"
+	DetectedEventStateExpired   DetectedEventState = "expired"
+	DetectedEventStateTentative DetectedEventState = "tentative"
+)
+
+func (e *DetectedEventState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DetectedEventState(s)
+	case string:
+		*e = DetectedEventState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DetectedEventState: %T", src)
+	}
+	return nil
+}
+
+type NullDetectedEventState struct {
+	DetectedEventState DetectedEventState
+	Valid              bool // Valid is true if DetectedEventState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDetectedEventState) Scan(value interface{}) error {
+	if value == nil {
+		ns.DetectedEventState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DetectedEventState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDetectedEventState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DetectedEventState), nil
+}
+
+type EventApprovalMode string
+
+const (
+	EventApprovalModeAuto EventApprovalMode = "auto"
+	EventApprovalModeAsk  EventApprovalMode = "ask"
+)
+
+func (e *EventApprovalMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EventApprovalMode(s)
+	case string:
+		*e = EventApprovalMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EventApprovalMode: %T", src)
+	}
+	return nil
+}
+
+type NullEventApprovalMode struct {
+	EventApprovalMode EventApprovalMode
+	Valid             bool // Valid is true if EventApprovalMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEventApprovalMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.EventApprovalMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EventApprovalMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEventApprovalMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EventApprovalMode), nil
+}
+
+type GroupAgreementRule string
+
+const (
+	GroupAgreementRuleCustomer  GroupAgreementRule = "customer"
+	GroupAgreementRuleMajority  GroupAgreementRule = "majority"
+	GroupAgreementRuleOrganizer GroupAgreementRule = "organizer"
+)
+
+func (e *GroupAgreementRule) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = GroupAgreementRule(s)
+	case string:
+		*e = GroupAgreementRule(s)
+	default:
+		return fmt.Errorf("unsupported scan type for GroupAgreementRule: %T", src)
+	}
+	return nil
+}
+
+type NullGroupAgreementRule struct {
+	GroupAgreementRule GroupAgreementRule
+	Valid              bool // Valid is true if GroupAgreementRule is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullGroupAgreementRule) Scan(value interface{}) error {
+	if value == nil {
+		ns.GroupAgreementRule, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.GroupAgreementRule.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullGroupAgreementRule) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.GroupAgreementRule), nil
+}
+
+type HijriDateAnnotation string
+
+const (
+	HijriDateAnnotationOff     HijriDateAnnotation = "off"
+	HijriDateAnnotationArabic  HijriDateAnnotation = "arabic"
+	HijriDateAnnotationEnglish HijriDateAnnotation = "english"
+)
+
+func (e *HijriDateAnnotation) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = HijriDateAnnotation(s)
+	case string:
+		*e = HijriDateAnnotation(s)
+	default:
+		return fmt.Errorf("unsupported scan type for HijriDateAnnotation: %T", src)
+	}
+	return nil
+}
+
+type NullHijriDateAnnotation struct {
+	HijriDateAnnotation HijriDateAnnotation
+	Valid               bool // Valid is true if HijriDateAnnotation is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullHijriDateAnnotation) Scan(value interface{}) error {
+	if value == nil {
+		ns.HijriDateAnnotation, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.HijriDateAnnotation.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullHijriDateAnnotation) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.HijriDateAnnotation), nil
+}
+
 type MagicTokenType string
 
 const (
@@ -55,6 +318,136 @@ func (ns NullMagicTokenType) Value() (driver.Value, error) {
 	return string(ns.MagicTokenType), nil
 }
 
+type PrayerConflictMode string
+
+const (
+	PrayerConflictModeOff    PrayerConflictMode = "off"
+	PrayerConflictModeWarn   PrayerConflictMode = "warn"
+	PrayerConflictModeBuffer PrayerConflictMode = "buffer"
+	PrayerConflictModeShift  PrayerConflictMode = "shift"
+)
+
+func (e *PrayerConflictMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PrayerConflictMode(s)
+	case string:
+		*e = PrayerConflictMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PrayerConflictMode: %T", src)
+	}
+	return nil
+}
+
+type NullPrayerConflictMode struct {
+	PrayerConflictMode PrayerConflictMode
+	Valid              bool // Valid is true if PrayerConflictMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPrayerConflictMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.PrayerConflictMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PrayerConflictMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPrayerConflictMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PrayerConflictMode), nil
+}
+
+type WasappChatImportStatus string
+
+const (
+	WasappChatImportStatusPending   WasappChatImportStatus = "pending"
+	WasappChatImportStatusAnalyzing WasappChatImportStatus = "analyzing"
+	WasappChatImportStatusDone      WasappChatImportStatus = "done"
+	WasappChatImportStatusFailed    WasappChatImportStatus = "failed"
+)
+
+func (e *WasappChatImportStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WasappChatImportStatus(s)
+	case string:
+		*e = WasappChatImportStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WasappChatImportStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWasappChatImportStatus struct {
+	WasappChatImportStatus WasappChatImportStatus
+	Valid                  bool // Valid is true if WasappChatImportStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWasappChatImportStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WasappChatImportStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WasappChatImportStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWasappChatImportStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WasappChatImportStatus), nil
+}
+
+type WasappMonitoringMode string
+
+const (
+	WasappMonitoringModeAll       WasappMonitoringMode = "all"
+	WasappMonitoringModeAllowlist WasappMonitoringMode = "allowlist"
+)
+
+func (e *WasappMonitoringMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WasappMonitoringMode(s)
+	case string:
+		*e = WasappMonitoringMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WasappMonitoringMode: %T", src)
+	}
+	return nil
+}
+
+type NullWasappMonitoringMode struct {
+	WasappMonitoringMode WasappMonitoringMode
+	Valid                bool // Valid is true if WasappMonitoringMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWasappMonitoringMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.WasappMonitoringMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WasappMonitoringMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWasappMonitoringMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WasappMonitoringMode), nil
+}
+
 type AuthGoogle struct {
 	ID         uuid.UUID
 	CustomerID uuid.UUID
@@ -73,6 +466,16 @@ type CaldavAccount struct {
 	UpdatedAt  time.Time
 }
 
+type CalendarFeed struct {
+	ID         uuid.UUID
+	CustomerID uuid.UUID
+	FeedType   CalendarFeedType
+	TokenHash  string
+	Lang       string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
 type Customer struct {
 	ID        uuid.UUID
 	Name      string
@@ -81,6 +484,56 @@ type Customer struct {
 	UpdatedAt time.Time
 }
 
+type CustomerDataKey struct {
+	ID          uuid.UUID
+	CustomerID  uuid.UUID
+	WrappedKey  []byte
+	MasterKeyID string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type CustomerPreference struct {
+	ID                          uuid.UUID
+	CustomerID                  uuid.UUID
+	HijriDateAnnotation         HijriDateAnnotation
+	CreatedAt                   time.Time
+	UpdatedAt                   time.Time
+	PrayerConflictMode          PrayerConflictMode
+	EventApprovalMode           EventApprovalMode
+	TentativeHolds              bool
+	GroupAgreementRule          GroupAgreementRule
+	WasappMonitoringMode        WasappMonitoringMode
+	WasappMessageRetentionHours sql.NullInt32
+}
+
+type DetectedEvent struct {
+	ID              uuid.UUID
+	CustomerID      uuid.UUID
+	ChatID          string
+	MessageIds      json.RawMessage
+	AnalysisStatus  string
+	RawAnalysis     json.RawMessage
+	Model           string
+	PromptVersion   string
+	State           DetectedEventState
+	Summary         sql.NullString
+	StartTime       sql.NullTime
+	EndTime         sql.NullTime
+	CaldavUid       sql.NullString
+	CaldavPath      sql.NullString
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Description     sql.NullString
+	FlexibleTime    bool
+	Location        sql.NullString
+	Latitude        sql.NullFloat64
+	Longitude       sql.NullFloat64
+	Attendees       json.RawMessage
+	AnalyzerBackend string
+	ChatImportID    uuid.NullUUID
+}
+
 type Device struct {
 	ID         uuid.UUID
 	CustomerID uuid.UUID
@@ -100,23 +553,135 @@ type MagicToken struct {
 	TokenType  MagicTokenType
 }
 
+type PrayerSetting struct {
+	ID                   uuid.UUID
+	CustomerID           uuid.UUID
+	Latitude             float64
+	Longitude            float64
+	Timezone             string
+	CalculationMethod    string
+	AsrJuristic          string
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	JumuahKhutbahTime    sql.NullString
+	JumuahLeaveByMinutes int32
+}
+
+type WasappAnalysisFailure struct {
+	ID              uuid.UUID
+	CustomerID      uuid.UUID
+	ChatID          string
+	MessageIds      json.RawMessage
+	Model           string
+	PromptVersion   string
+	RawAnalysis     string
+	Error           string
+	CreatedAt       time.Time
+	AnalyzerBackend string
+}
+
+type WasappAnalysisStat struct {
+	Day             time.Time
+	AnalyzerBackend string
+	PromptVersion   string
+	Model           string
+	Successes       int32
+	Failures        int32
+}
+
 type WasappChat struct {
+	ID               uuid.UUID
+	CustomerID       uuid.UUID
+	ChatID           string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Summary          sql.NullString
+	EventActivityAt  time.Time
+	SkippedMessages  int32
+	PendingSince     sql.NullTime
+	PendingUntil     sql.NullTime
+	PendingVersion   int32
+	AnalyzingUntil   sql.NullTime
+	SummarySince     sql.NullTime
+	AnalysisAttempts int32
+}
+
+type WasappChatEvent struct {
 	ID         uuid.UUID
 	CustomerID uuid.UUID
 	ChatID     string
+	EventUid   string
+	Summary    string
+	StartTime  time.Time
+	EndTime    time.Time
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	Tentative  bool
+}
+
+type WasappChatImport struct {
+	ID              uuid.UUID
+	CustomerID      uuid.UUID
+	ChatName        string
+	IsGroup         bool
+	SenderName      string
+	Status          WasappChatImportStatus
+	Export          sql.NullString
+	MessagesCount   int32
+	WindowsCount    int32
+	WindowsAnalyzed int32
+	EventsCount     int32
+	Error           sql.NullString
+	AnalyzingUntil  sql.NullTime
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Locale          string
+	Attempts        int32
+}
+
+type WasappChatSetting struct {
+	ID            uuid.UUID
+	CustomerID    uuid.UUID
+	ChatID        string
+	ChatName      string
+	Monitoring    ChatMonitoring
+	MutedUntil    sql.NullTime
+	LastMessageAt sql.NullTime
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type WasappLlmUsage struct {
+	CustomerID       uuid.UUID
+	Day              time.Time
+	Model            string
+	Calls            int32
+	PromptTokens     int64
+	CompletionTokens int64
+	LatencyMs        int64
 }
 
 type WasappMessage struct {
-	ID           uuid.UUID
-	WasappChatID uuid.UUID
-	MessageID    string
-	SenderName   string
-	SenderNumber string
-	IsSenderMe   bool
-	Body         string
-	Timestamp    int64
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID               uuid.UUID
+	WasappChatID     uuid.UUID
+	MessageID        string
+	SenderName       string
+	SenderNumber     string
+	IsSenderMe       bool
+	Body             string
+	Timestamp        int64
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	QuotedMessageID  sql.NullString
+	QuotedSenderName sql.NullString
+	QuotedIsSenderMe sql.NullBool
+	QuotedTimestamp  sql.NullInt64
+	QuotedBody       sql.NullString
+}
+
+type WasappRetentionStat struct {
+	Day             time.Time
+	MessagesPurged  int64
+	SummariesPurged int64
+	ChatsPurged     int64
 }
@@ -1,9 +1,12 @@
 -- name: CreateCalDavAccount :one
 INSERT INTO caldav_account (customer_id, email, username, password)
-VALUES ($1, $2, $3, pgp_sym_encrypt(@password::text, @encryption_key::text, 'cipher-algo=aes256'))
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: GetCalDavAccountByCustomerId :one
-SELECT *, pgp_sym_decrypt(ca.password::bytea, @encryption_key::text) AS decrypted_password
-FROM caldav_account ca
-WHERE ca.customer_id = $1;
\ No newline at end of file
+SELECT *
+FROM caldav_account
+WHERE customer_id = $1;
+
+-- name: DeleteCalDavAccountByCustomerId :exec
+DELETE FROM caldav_account WHERE customer_id = $1;
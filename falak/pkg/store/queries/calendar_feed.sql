@@ -0,0 +1,9 @@
+-- name: GetCalendarFeedByTokenHash :one
+SELECT *
+FROM calendar_feed
+WHERE token_hash = $1 AND feed_type = $2;
+
+-- name: CreateCalendarFeed :one
+INSERT INTO calendar_feed (customer_id, feed_type, token_hash, lang)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
@@ -0,0 +1,25 @@
+-- name: GetCustomerDataKey :one
+SELECT *
+FROM customer_data_key
+WHERE customer_id = $1;
+
+-- name: GetCustomerDataKeyId :one
+SELECT id
+FROM customer_data_key
+WHERE customer_id = $1;
+
+-- name: CreateCustomerDataKey :one
+INSERT INTO customer_data_key (customer_id, wrapped_key, master_key_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (customer_id) DO UPDATE
+SET customer_id = customer_data_key.customer_id
+RETURNING *;
+
+-- name: RewrapCustomerDataKey :exec
+UPDATE customer_data_key
+SET wrapped_key = $2,
+    master_key_id = $3
+WHERE id = $1;
+
+-- name: DeleteCustomerDataKey :execrows
+DELETE FROM customer_data_key WHERE customer_id = $1;
@@ -0,0 +1,34 @@
+-- name: GetCustomerPreferenceByCustomerId :one
+SELECT *
+FROM customer_preference
+WHERE customer_id = $1;
+
+-- name: UpsertCustomerPreference :one
+INSERT INTO customer_preference (customer_id, hijri_date_annotation, prayer_conflict_mode, event_approval_mode, tentative_holds, group_agreement_rule, wasapp_message_retention_hours)
+VALUES (
+  $1,
+  COALESCE(sqlc.narg(hijri_date_annotation)::hijri_date_annotation, 'off'),
+  COALESCE(sqlc.narg(prayer_conflict_mode)::prayer_conflict_mode, 'warn'),
+  COALESCE(sqlc.narg(event_approval_mode)::event_approval_mode, 'auto'),
+  COALESCE(sqlc.narg(tentative_holds)::boolean, false),
+  COALESCE(sqlc.narg(group_agreement_rule)::group_agreement_rule, 'customer'),
+  sqlc.narg(wasapp_message_retention_hours)::int
+)
+ON CONFLICT (customer_id) DO UPDATE
+SET hijri_date_annotation = COALESCE(sqlc.narg(hijri_date_annotation)::hijri_date_annotation, customer_preference.hijri_date_annotation),
+    prayer_conflict_mode = COALESCE(sqlc.narg(prayer_conflict_mode)::prayer_conflict_mode, customer_preference.prayer_conflict_mode),
+    event_approval_mode = COALESCE(sqlc.narg(event_approval_mode)::event_approval_mode, customer_preference.event_approval_mode),
+    tentative_holds = COALESCE(sqlc.narg(tentative_holds)::boolean, customer_preference.tentative_holds),
+    group_agreement_rule = COALESCE(sqlc.narg(group_agreement_rule)::group_agreement_rule, customer_preference.group_agreement_rule),
+    wasapp_message_retention_hours = CASE
+      WHEN @reset_wasapp_message_retention_hours::boolean THEN NULL
+      ELSE COALESCE(sqlc.narg(wasapp_message_retention_hours)::int, customer_preference.wasapp_message_retention_hours)
+    END
+RETURNING *;
+
+-- name: SetWasappMonitoringMode :one
+INSERT INTO customer_preference (customer_id, wasapp_monitoring_mode)
+VALUES ($1, $2)
+ON CONFLICT (customer_id) DO UPDATE
+SET wasapp_monitoring_mode = EXCLUDED.wasapp_monitoring_mode
+RETURNING *;
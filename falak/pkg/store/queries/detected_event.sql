@@ -0,0 +1,92 @@
+-- name: CreateDetectedEvent :one
+INSERT INTO detected_event (
+  customer_id,
+  chat_id,
+  message_ids,
+  analysis_status,
+  raw_analysis,
+  model,
+  prompt_version,
+  state,
+  summary,
+  description,
+  start_time,
+  end_time,
+  flexible_time,
+  caldav_uid,
+  location,
+  latitude,
+  longitude,
+  attendees,
+  analyzer_backend,
+  chat_import_id
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+RETURNING *;
+
+-- name: UpdateDetectedEventAnalysisByCaldavUid :exec
+UPDATE detected_event
+SET message_ids = $3,
+    analysis_status = $4,
+    raw_analysis = $5,
+    model = $6,
+    prompt_version = $7,
+    summary = $8,
+    description = $9,
+    start_time = $10,
+    end_time = $11,
+    flexible_time = $12,
+    location = $13,
+    latitude = $14,
+    longitude = $15,
+    attendees = $16,
+    analyzer_backend = $17
+WHERE customer_id = $1 AND caldav_uid = $2;
+
+-- name: SetDetectedEventStateByCaldavUid :exec
+UPDATE detected_event
+SET state = $3,
+    caldav_path = COALESCE(sqlc.narg(caldav_path), caldav_path)
+WHERE customer_id = $1 AND caldav_uid = $2;
+
+-- name: SetDetectedEventStateById :exec
+UPDATE detected_event
+SET state = $2
+WHERE id = $1;
+
+-- name: ProposePendingDetectedEvent :one
+UPDATE detected_event
+SET state = 'proposed'
+WHERE id = $1 AND customer_id = $2 AND state = 'pending'
+RETURNING *;
+
+-- name: GetDetectedEventById :one
+SELECT *
+FROM detected_event
+WHERE id = $1 AND customer_id = $2;
+
+-- name: GetDetectedEventByCaldavUid :one
+SELECT *
+FROM detected_event
+WHERE customer_id = $1 AND caldav_uid = $2;
+
+-- name: ExpirePendingDetectedEvents :exec
+UPDATE detected_event
+SET state = 'expired'
+WHERE customer_id = $1 AND state = 'pending' AND start_time <= now();
+
+-- name: ListDetectedEventsByCustomerId :many
+SELECT *
+FROM detected_event
+WHERE customer_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: ListDetectedEventsByChatImportId :many
+SELECT *
+FROM detected_event
+WHERE customer_id = $1 AND chat_import_id = $2
+ORDER BY start_time;
+
+-- name: DeleteDetectedEventsByCustomerId :exec
+DELETE FROM detected_event WHERE customer_id = $1;
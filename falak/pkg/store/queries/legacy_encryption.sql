@@ -0,0 +1,47 @@
+-- name: ListLegacyCalDavPasswords :many
+SELECT id, customer_id, password
+FROM caldav_account
+WHERE left(password, 2) = '\x'
+  AND id > @after_id::uuid
+ORDER BY id
+LIMIT @batch_size::int;
+
+-- name: SetCalDavAccountPassword :exec
+UPDATE caldav_account SET password = $2 WHERE id = $1;
+
+-- name: ListLegacyWasappMessages :many
+SELECT m.id, c.customer_id, m.body, m.quoted_body
+FROM wasapp_message m
+JOIN wasapp_chat c ON c.id = m.wasapp_chat_id
+WHERE left(m.body, 2) = '\x'
+  AND m.id > @after_id::uuid
+ORDER BY m.id
+LIMIT @batch_size::int;
+
+-- name: SetWasappMessageBodies :exec
+UPDATE wasapp_message SET body = $2, quoted_body = $3 WHERE id = $1;
+
+-- name: ListLegacyChatSummaries :many
+SELECT id, customer_id, summary::text AS summary
+FROM wasapp_chat
+WHERE left(summary, 2) = '\x'
+  AND id > @after_id::uuid
+ORDER BY id
+LIMIT @batch_size::int;
+
+-- name: SetChatSummary :exec
+UPDATE wasapp_chat SET summary = $2 WHERE id = $1;
+
+-- name: ListLegacyWasappChatImportExports :many
+SELECT id, customer_id, export::text AS export
+FROM wasapp_chat_import
+WHERE left(export, 2) = '\x'
+  AND id > @after_id::uuid
+ORDER BY id
+LIMIT @batch_size::int;
+
+-- name: SetWasappChatImportExport :exec
+UPDATE wasapp_chat_import SET export = $2 WHERE id = $1;
+
+-- name: DecryptLegacyValue :one
+SELECT pgp_sym_decrypt(CAST(@value::text AS bytea), @encryption_key::text)::text AS decrypted;
@@ -0,0 +1,17 @@
+-- name: GetPrayerSettingByCustomerId :one
+SELECT *
+FROM prayer_setting
+WHERE customer_id = $1;
+
+-- name: UpsertPrayerSetting :one
+INSERT INTO prayer_setting (customer_id, latitude, longitude, timezone, calculation_method, asr_juristic, jumuah_khutbah_time, jumuah_leave_by_minutes)
+VALUES ($1, $2, $3, $4, $5, $6, $7, COALESCE(sqlc.narg(jumuah_leave_by_minutes)::int, 30))
+ON CONFLICT (customer_id) DO UPDATE
+SET latitude = EXCLUDED.latitude,
+    longitude = EXCLUDED.longitude,
+    timezone = EXCLUDED.timezone,
+    calculation_method = EXCLUDED.calculation_method,
+    asr_juristic = EXCLUDED.asr_juristic,
+    jumuah_khutbah_time = EXCLUDED.jumuah_khutbah_time,
+    jumuah_leave_by_minutes = COALESCE(sqlc.narg(jumuah_leave_by_minutes)::int, prayer_setting.jumuah_leave_by_minutes)
+RETURNING *;
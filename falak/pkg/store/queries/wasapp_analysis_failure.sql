@@ -0,0 +1,7 @@
+-- name: CreateWasappAnalysisFailure :one
+INSERT INTO wasapp_analysis_failure (customer_id, chat_id, message_ids, model, prompt_version, raw_analysis, error, analyzer_backend)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: DeleteWasappAnalysisFailuresByCustomerId :exec
+DELETE FROM wasapp_analysis_failure WHERE customer_id = $1;
@@ -0,0 +1,6 @@
+-- name: IncrementWasappAnalysisStat :exec
+INSERT INTO wasapp_analysis_stat (day, analyzer_backend, prompt_version, model, successes, failures)
+VALUES (CURRENT_DATE, $1, $2, $3, $4, $5)
+ON CONFLICT (day, analyzer_backend, prompt_version, model) DO UPDATE
+SET successes = wasapp_analysis_stat.successes + EXCLUDED.successes,
+    failures = wasapp_analysis_stat.failures + EXCLUDED.failures;
@@ -1,39 +1,134 @@
--- name: AddMessageToChatReturningMessages :many
-WITH new_chat AS (
-  INSERT INTO wasapp_chat (customer_id, chat_id)
-  VALUES ($1, $2)
-  ON CONFLICT (chat_id) DO NOTHING
-  RETURNING *
-),
-inserted_message AS (
-  INSERT INTO wasapp_message (
-    wasapp_chat_id,
-    message_id,
-    sender_name,
-    sender_number,
-    is_sender_me,
-    body,
-    timestamp
-  )
-  SELECT 
-    COALESCE((SELECT id FROM new_chat), (SELECT id FROM wasapp_chat WHERE chat_id = $2)),
-    $3,
-    $4,
-    $5,
-    $6,
-    pgp_sym_encrypt(@body::text, @encryption_key::text, 'cipher-algo=aes256'),
-    $7
-  ON CONFLICT (message_id) DO NOTHING
-  RETURNING *
+-- name: AddMessageToChat :exec
+WITH pending_chat AS (
+  INSERT INTO wasapp_chat (customer_id, chat_id, pending_since, pending_until, pending_version)
+  VALUES ($1, $2, now(), now() + make_interval(secs => @quiet_seconds::float8), 1)
+  ON CONFLICT (chat_id) DO UPDATE
+  SET pending_since = COALESCE(wasapp_chat.pending_since, now()),
+      pending_until = LEAST(
+        now() + make_interval(secs => @quiet_seconds::float8),
+        COALESCE(wasapp_chat.pending_since, now()) + make_interval(secs => @max_delay_seconds::float8)
+      ),
+      pending_version = wasapp_chat.pending_version + 1
+  RETURNING id
 )
+INSERT INTO wasapp_message (
+  wasapp_chat_id,
+  message_id,
+  sender_name,
+  sender_number,
+  is_sender_me,
+  body,
+  timestamp,
+  quoted_message_id,
+  quoted_sender_name,
+  quoted_is_sender_me,
+  quoted_timestamp,
+  quoted_body
+)
+SELECT 
+  (SELECT id FROM pending_chat),
+  $3,
+  $4,
+  $5,
+  $6,
+  @body::text,
+  $7,
+  sqlc.narg(quoted_message_id),
+  sqlc.narg(quoted_sender_name),
+  sqlc.narg(quoted_is_sender_me),
+  sqlc.narg(quoted_timestamp),
+  CASE
+    WHEN EXISTS (SELECT 1 FROM wasapp_message WHERE message_id = sqlc.narg(quoted_message_id) AND wasapp_chat_id = (SELECT id FROM pending_chat)) THEN NULL
+    ELSE sqlc.narg(quoted_body)::text
+  END
+ON CONFLICT (message_id) DO NOTHING;
+
+-- name: ListChatMessages :many
 SELECT 
   m.*,
-  pgp_sym_decrypt(m.body::bytea, @encryption_key::text) AS decrypted_body,
   c.chat_id,
-  c.customer_id
+  c.customer_id,
+  q.body AS quoted_message_body
 FROM wasapp_message m
 JOIN wasapp_chat c ON c.id = m.wasapp_chat_id
-WHERE c.chat_id = $2;
+LEFT JOIN wasapp_message q ON q.message_id = m.quoted_message_id AND q.wasapp_chat_id = m.wasapp_chat_id
+WHERE c.chat_id = $1 AND c.customer_id = $2
+ORDER BY m.timestamp;
+
+-- name: DeleteChatMessage :exec
+DELETE FROM wasapp_message WHERE id = $1;
 
 -- name: DeleteChat :exec
-DELETE FROM wasapp_chat WHERE chat_id = $1 AND customer_id = $2;
\ No newline at end of file
+DELETE FROM wasapp_chat WHERE chat_id = $1 AND customer_id = $2;
+
+-- name: DeleteChatsByCustomerId :exec
+DELETE FROM wasapp_chat WHERE customer_id = $1;
+
+-- name: GetChatSummary :one
+SELECT summary
+FROM wasapp_chat
+WHERE chat_id = $1 AND customer_id = $2 AND summary IS NOT NULL;
+
+-- name: SummarizeChat :exec
+WITH summarized_chat AS (
+  UPDATE wasapp_chat
+  SET summary = @summary::text,
+      summary_since = COALESCE(summary_since, (
+        SELECT MIN(m.created_at) FROM wasapp_message m WHERE m.wasapp_chat_id = wasapp_chat.id AND m.timestamp < @summarized_until::bigint
+      ))
+  WHERE chat_id = $1 AND customer_id = $2
+  RETURNING id
+)
+DELETE FROM wasapp_message
+WHERE wasapp_chat_id = (SELECT id FROM summarized_chat) AND timestamp < @summarized_until::bigint;
+
+-- name: TouchChatEventActivity :exec
+UPDATE wasapp_chat SET event_activity_at = now() WHERE chat_id = $1 AND customer_id = $2;
+
+-- name: DeleteInactiveChats :execrows
+DELETE FROM wasapp_chat c
+WHERE c.event_activity_at < @inactive_since::timestamptz
+  AND NOT EXISTS (
+    SELECT 1
+    FROM wasapp_chat_event e
+    WHERE e.customer_id = c.customer_id AND e.chat_id = c.chat_id AND e.end_time > now()
+  );
+
+-- name: CountSkippedChatMessage :one
+UPDATE wasapp_chat
+SET skipped_messages = CASE WHEN skipped_messages + 1 >= @every_nth::int THEN 0 ELSE skipped_messages + 1 END
+WHERE chat_id = $1 AND customer_id = $2
+RETURNING skipped_messages;
+
+-- name: ClaimDueChats :many
+UPDATE wasapp_chat
+SET analyzing_until = now() + make_interval(secs => @lease_seconds::float8)
+WHERE id IN (
+  SELECT id
+  FROM wasapp_chat
+  WHERE pending_until <= now() AND (analyzing_until IS NULL OR analyzing_until <= now())
+  ORDER BY pending_until
+  LIMIT @max_chats::int
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING customer_id, chat_id, pending_version;
+
+-- name: FinishChatAnalysis :exec
+UPDATE wasapp_chat
+SET pending_since = CASE WHEN pending_version = @pending_version::int THEN NULL ELSE pending_since END,
+    pending_until = CASE WHEN pending_version = @pending_version::int THEN NULL ELSE pending_until END,
+    analyzing_until = NULL,
+    analysis_attempts = 0
+WHERE chat_id = $1 AND customer_id = $2;
+
+-- name: PostponeChatAnalysis :one
+UPDATE wasapp_chat
+SET pending_since = CASE WHEN analysis_attempts + 1 >= @max_attempts::int THEN NULL ELSE pending_since END,
+    pending_until = CASE
+      WHEN analysis_attempts + 1 >= @max_attempts::int THEN NULL
+      ELSE now() + make_interval(secs => @retry_seconds::float8)
+    END,
+    analyzing_until = NULL,
+    analysis_attempts = CASE WHEN analysis_attempts + 1 >= @max_attempts::int THEN 0 ELSE analysis_attempts + 1 END
+WHERE chat_id = $1 AND customer_id = $2
+RETURNING (pending_until IS NULL)::boolean AS gave_up;
@@ -0,0 +1,30 @@
+-- name: AddWasappChatEvent :one
+INSERT INTO wasapp_chat_event (customer_id, chat_id, event_uid, summary, start_time, end_time, tentative)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListUpcomingWasappChatEvents :many
+SELECT *
+FROM wasapp_chat_event
+WHERE customer_id = $1 AND chat_id = $2 AND end_time > now()
+ORDER BY start_time;
+
+-- name: UpdateWasappChatEvent :one
+UPDATE wasapp_chat_event
+SET summary = $3,
+    start_time = $4,
+    end_time = $5
+WHERE customer_id = $1 AND event_uid = $2
+RETURNING *;
+
+-- name: SetWasappChatEventTentative :exec
+UPDATE wasapp_chat_event SET tentative = $3 WHERE customer_id = $1 AND event_uid = $2;
+
+-- name: DeleteWasappChatEventByUid :exec
+DELETE FROM wasapp_chat_event WHERE customer_id = $1 AND event_uid = $2;
+
+-- name: DeletePassedWasappChatEvents :execrows
+DELETE FROM wasapp_chat_event WHERE customer_id = $1 AND chat_id = $2 AND end_time <= now();
+
+-- name: DeleteWasappChatEventsByCustomerId :exec
+DELETE FROM wasapp_chat_event WHERE customer_id = $1;
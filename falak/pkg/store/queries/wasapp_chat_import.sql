@@ -0,0 +1,57 @@
+-- name: CreateWasappChatImport :one
+INSERT INTO wasapp_chat_import (customer_id, chat_name, is_group, sender_name, messages_count, export, locale)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetWasappChatImport :one
+SELECT *
+FROM wasapp_chat_import
+WHERE id = $1 AND customer_id = $2;
+
+-- name: ClaimWasappChatImports :many
+UPDATE wasapp_chat_import
+SET status = 'analyzing',
+    analyzing_until = now() + make_interval(secs => @lease_seconds::float8)
+WHERE id IN (
+  SELECT id
+  FROM wasapp_chat_import
+  WHERE status IN ('pending', 'analyzing') AND (analyzing_until IS NULL OR analyzing_until <= now())
+  ORDER BY created_at
+  LIMIT @max_imports::int
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, customer_id, chat_name, is_group, sender_name, windows_analyzed, export, locale, created_at;
+
+-- name: SetWasappChatImportProgress :exec
+UPDATE wasapp_chat_import
+SET windows_count = $2,
+    windows_analyzed = $3,
+    attempts = 0,
+    analyzing_until = now() + make_interval(secs => @lease_seconds::float8)
+WHERE id = $1;
+
+-- name: PostponeWasappChatImport :one
+UPDATE wasapp_chat_import
+SET attempts = attempts + 1,
+    analyzing_until = now() + make_interval(secs => @retry_seconds::float8)
+WHERE id = $1
+RETURNING attempts;
+
+-- name: FinishWasappChatImport :exec
+UPDATE wasapp_chat_import
+SET status = 'done',
+    events_count = $2,
+    export = NULL,
+    analyzing_until = NULL
+WHERE id = $1;
+
+-- name: FailWasappChatImport :exec
+UPDATE wasapp_chat_import
+SET status = 'failed',
+    error = $2,
+    export = NULL,
+    analyzing_until = NULL
+WHERE id = $1;
+
+-- name: DeleteWasappChatImportsByCustomerId :exec
+DELETE FROM wasapp_chat_import WHERE customer_id = $1;
@@ -0,0 +1,36 @@
+-- name: TouchWasappChatSetting :one
+WITH chat_setting AS (
+  INSERT INTO wasapp_chat_setting (customer_id, chat_id, chat_name, last_message_at)
+  VALUES ($1, $2, $3, now())
+  ON CONFLICT (customer_id, chat_id) DO UPDATE
+  SET chat_name = COALESCE(NULLIF(EXCLUDED.chat_name, ''), wasapp_chat_setting.chat_name),
+      last_message_at = EXCLUDED.last_message_at
+  RETURNING monitoring, muted_until
+)
+SELECT s.monitoring, s.muted_until, COALESCE(p.wasapp_monitoring_mode, 'all')::wasapp_monitoring_mode AS monitoring_mode
+FROM chat_setting s
+LEFT JOIN customer_preference p ON p.customer_id = $1;
+
+-- name: ListWasappChatSettings :many
+SELECT *
+FROM wasapp_chat_setting
+WHERE customer_id = $1
+ORDER BY last_message_at DESC NULLS LAST, chat_name
+LIMIT $2;
+
+-- name: UpsertWasappChatSetting :one
+INSERT INTO wasapp_chat_setting (customer_id, chat_id, monitoring, muted_until)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (customer_id, chat_id) DO UPDATE
+SET monitoring = EXCLUDED.monitoring,
+    muted_until = EXCLUDED.muted_until
+RETURNING *;
+
+-- name: DeleteChatsNotIncluded :execrows
+DELETE FROM wasapp_chat c
+WHERE c.customer_id = $1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM wasapp_chat_setting s
+    WHERE s.customer_id = c.customer_id AND s.chat_id = c.chat_id AND s.monitoring = 'included'
+  );
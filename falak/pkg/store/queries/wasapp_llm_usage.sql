@@ -0,0 +1,21 @@
+-- name: AddWasappLlmUsage :exec
+INSERT INTO wasapp_llm_usage (customer_id, day, model, calls, prompt_tokens, completion_tokens, latency_ms)
+VALUES ($1, CURRENT_DATE, $2, 1, $3, $4, $5)
+ON CONFLICT (customer_id, day, model) DO UPDATE
+SET calls = wasapp_llm_usage.calls + 1,
+    prompt_tokens = wasapp_llm_usage.prompt_tokens + EXCLUDED.prompt_tokens,
+    completion_tokens = wasapp_llm_usage.completion_tokens + EXCLUDED.completion_tokens,
+    latency_ms = wasapp_llm_usage.latency_ms + EXCLUDED.latency_ms;
+
+-- name: GetWasappLlmTokensForDays :one
+SELECT COALESCE(SUM(prompt_tokens + completion_tokens), 0)::BIGINT AS tokens
+FROM wasapp_llm_usage
+WHERE customer_id = $1 AND day > CURRENT_DATE - @days::int;
+
+-- name: ListWasappLlmUsage :many
+SELECT u.customer_id, c.email, u.day, u.model, u.calls, u.prompt_tokens, u.completion_tokens, u.latency_ms
+FROM wasapp_llm_usage u
+JOIN customer c ON c.id = u.customer_id
+WHERE u.day BETWEEN @from_day AND @to_day
+  AND (sqlc.narg(customer_id)::UUID IS NULL OR u.customer_id = sqlc.narg(customer_id))
+ORDER BY u.day, c.email, u.model;
@@ -0,0 +1,70 @@
+-- name: PurgeExpiredMessages :execrows
+DELETE FROM wasapp_message
+WHERE id IN (
+  SELECT m.id
+  FROM wasapp_message m
+  JOIN wasapp_chat c ON c.id = m.wasapp_chat_id
+  LEFT JOIN customer_preference p ON p.customer_id = c.customer_id
+  WHERE m.created_at < now() - make_interval(hours => COALESCE(p.wasapp_message_retention_hours, @default_retention_hours::int))
+  LIMIT @batch_size::int
+);
+
+-- name: PurgeExpiredChatSummaries :execrows
+UPDATE wasapp_chat c
+SET summary = NULL,
+    summary_since = NULL
+WHERE c.summary_since < now() - make_interval(hours => COALESCE(
+    (SELECT p.wasapp_message_retention_hours FROM customer_preference p WHERE p.customer_id = c.customer_id),
+    @default_retention_hours::int
+  ));
+
+-- name: PurgeExpiredDetectedEvents :execrows
+DELETE FROM detected_event d
+WHERE d.created_at < now() - make_interval(hours => COALESCE(
+    (SELECT p.wasapp_message_retention_hours FROM customer_preference p WHERE p.customer_id = d.customer_id),
+    @default_retention_hours::int
+  ))
+  AND (d.start_time IS NULL OR COALESCE(d.end_time, d.start_time) < now());
+
+-- name: ScrubExpiredDetectedEvents :execrows
+UPDATE detected_event d
+SET message_ids = '[]',
+    raw_analysis = '{}',
+    description = NULL,
+    location = NULL,
+    latitude = NULL,
+    longitude = NULL,
+    attendees = '[]'
+WHERE d.created_at < now() - make_interval(hours => COALESCE(
+    (SELECT p.wasapp_message_retention_hours FROM customer_preference p WHERE p.customer_id = d.customer_id),
+    @default_retention_hours::int
+  ))
+  AND (d.raw_analysis <> '{}' OR d.message_ids <> '[]' OR d.attendees <> '[]' OR d.description IS NOT NULL OR d.location IS NOT NULL);
+
+-- name: PurgeExpiredAnalysisFailures :execrows
+DELETE FROM wasapp_analysis_failure f
+WHERE f.created_at < now() - make_interval(hours => COALESCE(
+    (SELECT p.wasapp_message_retention_hours FROM customer_preference p WHERE p.customer_id = f.customer_id),
+    @default_retention_hours::int
+  ));
+
+-- name: DeleteEmptyChats :execrows
+DELETE FROM wasapp_chat c
+WHERE c.summary IS NULL
+  AND c.pending_until IS NULL
+  AND (c.analyzing_until IS NULL OR c.analyzing_until <= now())
+  AND NOT EXISTS (SELECT 1 FROM wasapp_message m WHERE m.wasapp_chat_id = c.id);
+
+-- name: AddWasappRetentionStat :exec
+INSERT INTO wasapp_retention_stat (day, messages_purged, summaries_purged, chats_purged)
+VALUES (CURRENT_DATE, $1, $2, $3)
+ON CONFLICT (day) DO UPDATE
+SET messages_purged = wasapp_retention_stat.messages_purged + EXCLUDED.messages_purged,
+    summaries_purged = wasapp_retention_stat.summaries_purged + EXCLUDED.summaries_purged,
+    chats_purged = wasapp_retention_stat.chats_purged + EXCLUDED.chats_purged;
+
+-- name: ListWasappRetentionStats :many
+SELECT *
+FROM wasapp_retention_stat
+WHERE day BETWEEN @from_day AND @to_day
+ORDER BY day;
@@ -7,79 +7,78 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-const addMessageToChatReturningMessages = `-- name: AddMessageToChatReturningMessages :many
-WITH new_chat AS (
-  INSERT INTO wasapp_chat (customer_id, chat_id)
-  VALUES ($1, $2)
-  ON CONFLICT (chat_id) DO NOTHING
-  RETURNING id, customer_id, chat_id, created_at, updated_at
-),
-inserted_message AS (
-  INSERT INTO wasapp_message (
-    wasapp_chat_id,
-    message_id,
-    sender_name,
-    sender_number,
-    is_sender_me,
-    body,
-    timestamp
-  )
-  SELECT 
-    COALESCE((SELECT id FROM new_chat), (SELECT id FROM wasapp_chat WHERE chat_id = $2)),
-    $3,
-    $4,
-    $5,
-    $6,
-    pgp_sym_encrypt($9::text, $8::text, 'cipher-algo=aes256'),
-    $7
-  ON CONFLICT (message_id) DO NOTHING
-  RETURNING id, wasapp_chat_id, message_id, sender_name, sender_number, is_sender_me, body, timestamp, created_at, updated_at
+const addMessageToChat = `-- name: AddMessageToChat :exec
+WITH pending_chat AS (
+  INSERT INTO wasapp_chat (customer_id, chat_id, pending_since, pending_until, pending_version)
+  VALUES ($1, $2, now(), now() + make_interval(secs => $14::float8), 1)
+  ON CONFLICT (chat_id) DO UPDATE
+  SET pending_since = COALESCE(wasapp_chat.pending_since, now()),
+      pending_until = LEAST(
+        now() + make_interval(secs => $14::float8),
+        COALESCE(wasapp_chat.pending_since, now()) + make_interval(secs => $15::float8)
+      ),
+      pending_version = wasapp_chat.pending_version + 1
+  RETURNING id
+)
+INSERT INTO wasapp_message (
+  wasapp_chat_id,
+  message_id,
+  sender_name,
+  sender_number,
+  is_sender_me,
+  body,
+  timestamp,
+  quoted_message_id,
+  quoted_sender_name,
+  quoted_is_sender_me,
+  quoted_timestamp,
+  quoted_body
 )
 SELECT 
-  m.id, m.wasapp_chat_id, m.message_id, m.sender_name, m.sender_number, m.is_sender_me, m.body, m.timestamp, m.created_at, m.updated_at,
-  pgp_sym_decrypt(m.body::bytea, $8::text) AS decrypted_body,
-  c.chat_id,
-  c.customer_id
-FROM wasapp_message m
-JOIN wasapp_chat c ON c.id = m.wasapp_chat_id
-WHERE c.chat_id = $2
+  (SELECT id FROM pending_chat),
+  $3,
+  $4,
+  $5,
+  $6,
+  $8::text,
+  $7,
+  $9,
+  $10,
+  $11,
+  $12,
+  CASE
+    WHEN EXISTS (SELECT 1 FROM wasapp_message WHERE message_id = $9 AND wasapp_chat_id = (SELECT id FROM pending_chat)) THEN NULL
+    ELSE $13::text
+  END
+ON CONFLICT (message_id) DO NOTHING
 `
 
-type AddMessageToChatReturningMessagesParams struct {
-	CustomerID    uuid.UUID
-	ChatID        string
-	MessageID     string
-	SenderName    string
-	SenderNumber  string
-	IsSenderMe    bool
-	Timestamp     int64
-	EncryptionKey string
-	Body          string
-}
-
-type AddMessageToChatReturningMessagesRow struct {
-	ID            uuid.UUID
-	WasappChatID  uuid.UUID
-	MessageID     string
-	SenderName    string
-	SenderNumber  string
-	IsSenderMe    bool
-	Body          string
-	Timestamp     int64
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	DecryptedBody string
-	ChatID        string
-	CustomerID    uuid.UUID
-}
-
-func (q *Queries) AddMessageToChatReturningMessages(ctx context.Context, arg AddMessageToChatReturningMessagesParams) ([]AddMessageToChatReturningMessagesRow, error) {
-	rows, err := q.db.QueryContext(ctx, addMessageToChatReturningMessages,
+type AddMessageToChatParams struct {
+	CustomerID       uuid.UUID
+	ChatID           string
+	MessageID        string
+	SenderName       string
+	SenderNumber     string
+	IsSenderMe       bool
+	Timestamp        int64
+	Body             string
+	QuotedMessageID  sql.NullString
+	QuotedSenderName sql.NullString
+	QuotedIsSenderMe sql.NullBool
+	QuotedTimestamp  sql.NullInt64
+	QuotedBody       sql.NullString
+	QuietSeconds     float64
+	MaxDelaySeconds  float64
+}
+
+func (q *Queries) AddMessageToChat(ctx context.Context, arg AddMessageToChatParams) error {
+	_, err := q.db.ExecContext(ctx, addMessageToChat,
 		arg.CustomerID,
 		arg.ChatID,
 		arg.MessageID,
@@ -87,16 +86,222 @@ func (q *Queries) AddMessageToChatReturningMessages(ctx context.Context, arg Add
 		arg.SenderNumber,
 		arg.IsSenderMe,
 		arg.Timestamp,
-		arg.EncryptionKey,
 		arg.Body,
+		arg.QuotedMessageID,
+		arg.QuotedSenderName,
+		arg.QuotedIsSenderMe,
+		arg.QuotedTimestamp,
+		arg.QuotedBody,
+		arg.QuietSeconds,
+		arg.MaxDelaySeconds,
 	)
+	return err
+}
+
+const claimDueChats = `-- name: ClaimDueChats :many
+UPDATE wasapp_chat
+SET analyzing_until = now() + make_interval(secs => $1::float8)
+WHERE id IN (
+  SELECT id
+  FROM wasapp_chat
+  WHERE pending_until <= now() AND (analyzing_until IS NULL OR analyzing_until <= now())
+  ORDER BY pending_until
+  LIMIT $2::int
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING customer_id, chat_id, pending_version
+`
+
+type ClaimDueChatsParams struct {
+	LeaseSeconds float64
+	MaxChats     int32
+}
+
+type ClaimDueChatsRow struct {
+	CustomerID     uuid.UUID
+	ChatID         string
+	PendingVersion int32
+}
+
+func (q *Queries) ClaimDueChats(ctx context.Context, arg ClaimDueChatsParams) ([]ClaimDueChatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, claimDueChats, arg.LeaseSeconds, arg.MaxChats)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueChatsRow
+	for rows.Next() {
+		var i ClaimDueChatsRow
+		if err := rows.Scan(&i.CustomerID, &i.ChatID, &i.PendingVersion); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countSkippedChatMessage = `-- name: CountSkippedChatMessage :one
+UPDATE wasapp_chat
+SET skipped_messages = CASE WHEN skipped_messages + 1 >= $3::int THEN 0 ELSE skipped_messages + 1 END
+WHERE chat_id = $1 AND customer_id = $2
+RETURNING skipped_messages
+`
+
+type CountSkippedChatMessageParams struct {
+	ChatID     string
+	CustomerID uuid.UUID
+	EveryNth   int32
+}
+
+func (q *Queries) CountSkippedChatMessage(ctx context.Context, arg CountSkippedChatMessageParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countSkippedChatMessage, arg.ChatID, arg.CustomerID, arg.EveryNth)
+	var skipped_messages int32
+	err := row.Scan(&skipped_messages)
+	return skipped_messages, err
+}
+
+const deleteChat = `-- name: DeleteChat :exec
+DELETE FROM wasapp_chat WHERE chat_id = $1 AND customer_id = $2
+`
+
+type DeleteChatParams struct {
+	ChatID     string
+	CustomerID uuid.UUID
+}
+
+func (q *Queries) DeleteChat(ctx context.Context, arg DeleteChatParams) error {
+	_, err := q.db.ExecContext(ctx, deleteChat, arg.ChatID, arg.CustomerID)
+	return err
+}
+
+const deleteChatMessage = `-- name: DeleteChatMessage :exec
+DELETE FROM wasapp_message WHERE id = $1
+`
+
+func (q *Queries) DeleteChatMessage(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteChatMessage, id)
+	return err
+}
+
+const deleteChatsByCustomerId = `-- name: DeleteChatsByCustomerId :exec
+DELETE FROM wasapp_chat WHERE customer_id = $1
+`
+
+func (q *Queries) DeleteChatsByCustomerId(ctx context.Context, customerID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteChatsByCustomerId, customerID)
+	return err
+}
+
+const deleteInactiveChats = `-- name: DeleteInactiveChats :execrows
+DELETE FROM wasapp_chat c
+WHERE c.event_activity_at < $1::timestamptz
+  AND NOT EXISTS (
+    SELECT 1
+    FROM wasapp_chat_event e
+    WHERE e.customer_id = c.customer_id AND e.chat_id = c.chat_id AND e.end_time > now()
+  )
+`
+
+func (q *Queries) DeleteInactiveChats(ctx context.Context, inactiveSince time.Time) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteInactiveChats, inactiveSince)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const finishChatAnalysis = `-- name: FinishChatAnalysis :exec
+UPDATE wasapp_chat
+SET pending_since = CASE WHEN pending_version = $3::int THEN NULL ELSE pending_since END,
+    pending_until = CASE WHEN pending_version = $3::int THEN NULL ELSE pending_until END,
+    analyzing_until = NULL,
+    analysis_attempts = 0
+WHERE chat_id = $1 AND customer_id = $2
+`
+
+type FinishChatAnalysisParams struct {
+	ChatID         string
+	CustomerID     uuid.UUID
+	PendingVersion int32
+}
+
+func (q *Queries) FinishChatAnalysis(ctx context.Context, arg FinishChatAnalysisParams) error {
+	_, err := q.db.ExecContext(ctx, finishChatAnalysis, arg.ChatID, arg.CustomerID, arg.PendingVersion)
+	return err
+}
+
+const getChatSummary = `-- name: GetChatSummary :one
+SELECT summary
+FROM wasapp_chat
+WHERE chat_id = $1 AND customer_id = $2 AND summary IS NOT NULL
+`
+
+type GetChatSummaryParams struct {
+	ChatID     string
+	CustomerID uuid.UUID
+}
+
+func (q *Queries) GetChatSummary(ctx context.Context, arg GetChatSummaryParams) (sql.NullString, error) {
+	row := q.db.QueryRowContext(ctx, getChatSummary, arg.ChatID, arg.CustomerID)
+	var summary sql.NullString
+	err := row.Scan(&summary)
+	return summary, err
+}
+
+const listChatMessages = `-- name: ListChatMessages :many
+SELECT 
+  m.id, m.wasapp_chat_id, m.message_id, m.sender_name, m.sender_number, m.is_sender_me, m.body, m.timestamp, m.created_at, m.updated_at, m.quoted_message_id, m.quoted_sender_name, m.quoted_is_sender_me, m.quoted_timestamp, m.quoted_body,
+  c.chat_id,
+  c.customer_id,
+  q.body AS quoted_message_body
+FROM wasapp_message m
+JOIN wasapp_chat c ON c.id = m.wasapp_chat_id
+LEFT JOIN wasapp_message q ON q.message_id = m.quoted_message_id AND q.wasapp_chat_id = m.wasapp_chat_id
+WHERE c.chat_id = $1 AND c.customer_id = $2
+ORDER BY m.timestamp
+`
+
+type ListChatMessagesParams struct {
+	ChatID     string
+	CustomerID uuid.UUID
+}
+
+type ListChatMessagesRow struct {
+	ID                uuid.UUID
+	WasappChatID      uuid.UUID
+	MessageID         string
+	SenderName        string
+	SenderNumber      string
+	IsSenderMe        bool
+	Body              string
+	Timestamp         int64
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	QuotedMessageID   sql.NullString
+	QuotedSenderName  sql.NullString
+	QuotedIsSenderMe  sql.NullBool
+	QuotedTimestamp   sql.NullInt64
+	QuotedBody        sql.NullString
+	ChatID            string
+	CustomerID        uuid.UUID
+	QuotedMessageBody sql.NullString
+}
+
+func (q *Queries) ListChatMessages(ctx context.Context, arg ListChatMessagesParams) ([]ListChatMessagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listChatMessages, arg.ChatID, arg.CustomerID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []AddMessageToChatReturningMessagesRow
+	var items []ListChatMessagesRow
 	for rows.Next() {
-		var i AddMessageToChatReturningMessagesRow
+		var i ListChatMessagesRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.WasappChatID,
@@ -108,9 +313,14 @@ func (q *Queries) AddMessageToChatReturningMessages(ctx context.Context, arg Add
 			&i.Timestamp,
 			&i.CreatedAt,
 			&i.UpdatedAt,
-			&i.DecryptedBody,
+			&i.QuotedMessageID,
+			&i.QuotedSenderName,
+			&i.QuotedIsSenderMe,
+			&i.QuotedTimestamp,
+			&i.QuotedBody,
 			&i.ChatID,
 			&i.CustomerID,
+			&i.QuotedMessageBody,
 		); err != nil {
 			return nil, err
 		}
@@ -125,16 +335,79 @@ func (q *Queries) AddMessageToChatReturningMessages(ctx context.Context, arg Add
 	return items, nil
 }
 
-const deleteChat = `-- name: DeleteChat :exec
-DELETE FROM wasapp_chat WHERE chat_id = $1 AND customer_id = $2
+const postponeChatAnalysis = `-- name: PostponeChatAnalysis :one
+UPDATE wasapp_chat
+SET pending_since = CASE WHEN analysis_attempts + 1 >= $3::int THEN NULL ELSE pending_since END,
+    pending_until = CASE
+      WHEN analysis_attempts + 1 >= $3::int THEN NULL
+      ELSE now() + make_interval(secs => $4::float8)
+    END,
+    analyzing_until = NULL,
+    analysis_attempts = CASE WHEN analysis_attempts + 1 >= $3::int THEN 0 ELSE analysis_attempts + 1 END
+WHERE chat_id = $1 AND customer_id = $2
+RETURNING (pending_until IS NULL)::boolean AS gave_up
 `
 
-type DeleteChatParams struct {
+type PostponeChatAnalysisParams struct {
+	ChatID       string
+	CustomerID   uuid.UUID
+	MaxAttempts  int32
+	RetrySeconds float64
+}
+
+func (q *Queries) PostponeChatAnalysis(ctx context.Context, arg PostponeChatAnalysisParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, postponeChatAnalysis,
+		arg.ChatID,
+		arg.CustomerID,
+		arg.MaxAttempts,
+		arg.RetrySeconds,
+	)
+	var gave_up bool
+	err := row.Scan(&gave_up)
+	return gave_up, err
+}
+
+const summarizeChat = `-- name: SummarizeChat :exec
+WITH summarized_chat AS (
+  UPDATE wasapp_chat
+  SET summary = $4::text,
+      summary_since = COALESCE(summary_since, (
+        SELECT MIN(m.created_at) FROM wasapp_message m WHERE m.wasapp_chat_id = wasapp_chat.id AND m.timestamp < $3::bigint
+      ))
+  WHERE chat_id = $1 AND customer_id = $2
+  RETURNING id
+)
+DELETE FROM wasapp_message
+WHERE wasapp_chat_id = (SELECT id FROM summarized_chat) AND timestamp < $3::bigint
+`
+
+type SummarizeChatParams struct {
+	ChatID          string
+	CustomerID      uuid.UUID
+	SummarizedUntil int64
+	Summary         string
+}
+
+func (q *Queries) SummarizeChat(ctx context.Context, arg SummarizeChatParams) error {
+	_, err := q.db.ExecContext(ctx, summarizeChat,
+		arg.ChatID,
+		arg.CustomerID,
+		arg.SummarizedUntil,
+		arg.Summary,
+	)
+	return err
+}
+
+const touchChatEventActivity = `-- name: TouchChatEventActivity :exec
+UPDATE wasapp_chat SET event_activity_at = now() WHERE chat_id = $1 AND customer_id = $2
+`
+
+type TouchChatEventActivityParams struct {
 	ChatID     string
 	CustomerID uuid.UUID
 }
 
-func (q *Queries) DeleteChat(ctx context.Context, arg DeleteChatParams) error {
-	_, err := q.db.ExecContext(ctx, deleteChat, arg.ChatID, arg.CustomerID)
+func (q *Queries) TouchChatEventActivity(ctx context.Context, arg TouchChatEventActivityParams) error {
+	_, err := q.db.ExecContext(ctx, touchChatEventActivity, arg.ChatID, arg.CustomerID)
 	return err
 }
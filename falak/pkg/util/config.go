@@ -10,49 +10,70 @@ import (
 // FalakConfig stores all configuration of the application.
 // The values are read by viper from a config file or environment variables.
 type FalakConfig struct {
-	Port                          string `mapstructure:"PORT"`
-	JWTPublicKey                  string `mapstructure:"JWT_PUBLIC_KEY"`
-	JWTPrivateKey                 string `mapstructure:"JWT_PRIVATE_KEY"`
-	DBUser                        string `mapstructure:"DB_USER"`
-	DBPassword                    string `mapstructure:"DB_PASSWORD"`
-	DBHost                        string `mapstructure:"DB_HOST"`
-	DBPort                        string `mapstructure:"DB_PORT"`
-	DBName                        string `mapstructure:"DB_NAME"`
-	DBSSLMode                     string `mapstructure:"DB_SSL_MODE"`
-	EmailerName                   string `mapstructure:"EMAILER_NAME"`
-	SMTPHost                      string `mapstructure:"SMTP_HOST"`
-	SMTPPort                      string `mapstructure:"SMTP_PORT"`
-	SMTPUSername                  string `mapstructure:"SMTP_USERNAME"`
-	SMTPPasword                   string `mapstructure:"SMTP_PASSWORD"`
-	Domain                        string `mapstructure:"DOMAIN"`
-	ResendApiKey                  string `mapstructure:"RESEND_API_KEY"`
-	GoogleClientBaseUrl           string `mapstructure:"GOOGLE_CLIENT_BASE_URL"`
-	GoogleOAuthClientId           string `mapstructure:"GOOGLE_OAUTH_CLIENT_ID"`
-	LokiEndpoint                  string `mapstructure:"LOKI_ENDPOINT"`
-	LokiPushIntervalSeconds       int    `mapstructure:"LOKI_PUSH_INTERVAL_SECONDS"`
-	LokiMaxBatchSize              int    `mapstructure:"LOKI_MAX_BATCH_SIZE"`
-	BaikalHost                    string `mapstructure:"BAIKAL_HOST"`
-	BaikalPhpSessionID            string `mapstructure:"BAIKAL_PHPSESSID"`
-	CalDAVPasswordEncryptionKey   string `mapstructure:"CALDAV_PASSWORD_ENCRYPTION_KEY"`
-	WasappBaseUrl                 string `mapstructure:"WASAPP_BASE_URL"`
-	RabbitMqUser                  string `mapstructure:"RABBITMQ_USERNAME"`
-	RabbitMqPass                  string `mapstructure:"RABBITMQ_PASSWORD"`
-	RabbitMqHost                  string `mapstructure:"RABBITMQ_HOSTNAME"`
-	RabbitMqPort                  string `mapstructure:"RABBITMQ_PORT"`
-	WasappMessagesQueueName       string `mapstructure:"WASAPP_MESSAGES_QUEUE_NAME"`
-	OpenAiBaseUrl                 string `mapstructure:"OPEN_AI_BASE_URL"`
-	OpenAiApiKey                  string `mapstructure:"OPEN_AI_API_KEY"`
-	OpenAiModelName               string `mapstructure:"OPEN_AI_MODEL_NAME"`
-	WasappCalendarEventsQueueName string `mapstructure:"WASAPP_CALENDAR_EVENTS_QUEUE_NAME"`
-	WhatsappMessagesEncryptionKey string `mapstructure:"WHATSAPP_MESSAGES_ENCRYPTION_KEY"`
-	ApnsAuthKey                   string `mapstructure:"APNS_AUTH_KEY"`
-	ApnsKeyID                     string `mapstructure:"APNS_KEY_ID"`
-	ApnsTeamID                    string `mapstructure:"APNS_TEAM_ID"`
-	IsProd                        bool   `mapstructure:"IS_PROD"`
-	CaldavHost                    string `mapstructure:"CALDAV_HOST"`
-	ProxyUrl                      string `mapstructure:"PROXY_URL"`
-	PrayerTimeBaseUrl             string `mapstructure:"PRAYER_TIME_BASE_URL"`
-	GeoLocationBaseUrl            string `mapstructure:"GEO_LOCATION_BASE_URL"`
+	Port                          string  `mapstructure:"PORT"`
+	JWTPublicKey                  string  `mapstructure:"JWT_PUBLIC_KEY"`
+	JWTPrivateKey                 string  `mapstructure:"JWT_PRIVATE_KEY"`
+	DBUser                        string  `mapstructure:"DB_USER"`
+	DBPassword                    string  `mapstructure:"DB_PASSWORD"`
+	DBHost                        string  `mapstructure:"DB_HOST"`
+	DBPort                        string  `mapstructure:"DB_PORT"`
+	DBName                        string  `mapstructure:"DB_NAME"`
+	DBSSLMode                     string  `mapstructure:"DB_SSL_MODE"`
+	EmailerName                   string  `mapstructure:"EMAILER_NAME"`
+	SMTPHost                      string  `mapstructure:"SMTP_HOST"`
+	SMTPPort                      string  `mapstructure:"SMTP_PORT"`
+	SMTPUSername                  string  `mapstructure:"SMTP_USERNAME"`
+	SMTPPasword                   string  `mapstructure:"SMTP_PASSWORD"`
+	Domain                        string  `mapstructure:"DOMAIN"`
+	ResendApiKey                  string  `mapstructure:"RESEND_API_KEY"`
+	GoogleClientBaseUrl           string  `mapstructure:"GOOGLE_CLIENT_BASE_URL"`
+	GoogleOAuthClientId           string  `mapstructure:"GOOGLE_OAUTH_CLIENT_ID"`
+	LokiEndpoint                  string  `mapstructure:"LOKI_ENDPOINT"`
+	LokiPushIntervalSeconds       int     `mapstructure:"LOKI_PUSH_INTERVAL_SECONDS"`
+	LokiMaxBatchSize              int     `mapstructure:"LOKI_MAX_BATCH_SIZE"`
+	BaikalHost                    string  `mapstructure:"BAIKAL_HOST"`
+	BaikalPhpSessionID            string  `mapstructure:"BAIKAL_PHPSESSID"`
+	CalDAVPasswordEncryptionKey   string  `mapstructure:"CALDAV_PASSWORD_ENCRYPTION_KEY"`
+	WasappBaseUrl                 string  `mapstructure:"WASAPP_BASE_URL"`
+	RabbitMqUser                  string  `mapstructure:"RABBITMQ_USERNAME"`
+	RabbitMqPass                  string  `mapstructure:"RABBITMQ_PASSWORD"`
+	RabbitMqHost                  string  `mapstructure:"RABBITMQ_HOSTNAME"`
+	RabbitMqPort                  string  `mapstructure:"RABBITMQ_PORT"`
+	WasappMessagesQueueName       string  `mapstructure:"WASAPP_MESSAGES_QUEUE_NAME"`
+	OpenAiBaseUrl                 string  `mapstructure:"OPEN_AI_BASE_URL"`
+	OpenAiApiKey                  string  `mapstructure:"OPEN_AI_API_KEY"`
+	OpenAiModelName               string  `mapstructure:"OPEN_AI_MODEL_NAME"`
+	OpenAiStructuredOutputs       bool    `mapstructure:"OPEN_AI_STRUCTURED_OUTPUTS"`
+	OpenAiPromptTokenPrice        float64 `mapstructure:"OPEN_AI_PROMPT_TOKEN_PRICE"`
+	OpenAiCompletionTokenPrice    float64 `mapstructure:"OPEN_AI_COMPLETION_TOKEN_PRICE"`
+	WasappAnalyzerBackends        string  `mapstructure:"WASAPP_ANALYZER_BACKENDS"`
+	WasappAnalyzerTimeoutSeconds  int     `mapstructure:"WASAPP_ANALYZER_TIMEOUT_SECONDS"`
+	WasappAnalyzerPromptRollout   string  `mapstructure:"WASAPP_ANALYZER_PROMPT_ROLLOUT"`
+	WasappRedaction               string  `mapstructure:"WASAPP_REDACTION"`
+	WasappLlmDailyTokenQuota      int64   `mapstructure:"WASAPP_LLM_DAILY_TOKEN_QUOTA"`
+	WasappLlmQuotaMode            string  `mapstructure:"WASAPP_LLM_QUOTA_MODE"`
+	WasappLlmQuotaEveryNth        int     `mapstructure:"WASAPP_LLM_QUOTA_EVERY_NTH"`
+	WasappCalendarEventsQueueName string  `mapstructure:"WASAPP_CALENDAR_EVENTS_QUEUE_NAME"`
+	WhatsappMessagesEncryptionKey string  `mapstructure:"WHATSAPP_MESSAGES_ENCRYPTION_KEY"`
+	KeyringProvider               string  `mapstructure:"KEYRING_PROVIDER"`
+	KeyringMasterKeys             string  `mapstructure:"KEYRING_MASTER_KEYS"`
+	KeyringMasterKeysFile         string  `mapstructure:"KEYRING_MASTER_KEYS_FILE"`
+	WasappWindowMaxMessages       int     `mapstructure:"WASAPP_WINDOW_MAX_MESSAGES"`
+	WasappWindowMaxHours          int     `mapstructure:"WASAPP_WINDOW_MAX_HOURS"`
+	WasappDebounceQuietSeconds    int     `mapstructure:"WASAPP_DEBOUNCE_QUIET_SECONDS"`
+	WasappDebounceMaxDelaySeconds int     `mapstructure:"WASAPP_DEBOUNCE_MAX_DELAY_SECONDS"`
+	WasappChatInactiveDays        int     `mapstructure:"WASAPP_CHAT_INACTIVE_DAYS"`
+	WasappMessageRetentionHours   int     `mapstructure:"WASAPP_MESSAGE_RETENTION_HOURS"`
+	ApnsAuthKey                   string  `mapstructure:"APNS_AUTH_KEY"`
+	ApnsKeyID                     string  `mapstructure:"APNS_KEY_ID"`
+	ApnsTeamID                    string  `mapstructure:"APNS_TEAM_ID"`
+	IsProd                        bool    `mapstructure:"IS_PROD"`
+	CaldavHost                    string  `mapstructure:"CALDAV_HOST"`
+	ProxyUrl                      string  `mapstructure:"PROXY_URL"`
+	PrayerTimeBaseUrl             string  `mapstructure:"PRAYER_TIME_BASE_URL"`
+	GeoLocationBaseUrl            string  `mapstructure:"GEO_LOCATION_BASE_URL"`
+	FalakHost                     string  `mapstructure:"FALAK_HOST"`
+	AdminApiKey                   string  `mapstructure:"ADMIN_API_KEY"`
 }
 
 // LoadFalakConfig reads configuration from the environment variables.
@@ -0,0 +1,117 @@
+package wasappanalyzereval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ReportDiff is how a report changed from the baseline it is compared with
+type ReportDiff struct {
+	Metrics []MetricChange `json:"metrics"`
+	// Regressed has the fixtures that passed in the baseline and fail now, Fixed has the other way around
+	Regressed []string `json:"regressed"`
+	Fixed     []string `json:"fixed"`
+	// Added and Removed have the fixtures that are only in the report or only in the baseline
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
+type MetricChange struct {
+	Name     string  `json:"name"`
+	Baseline float64 `json:"baseline"`
+	Current  float64 `json:"current"`
+}
+
+func (c MetricChange) Delta() float64 {
+	return c.Current - c.Baseline
+}
+
+// HasRegressions tells if a fixture that passed now fails or any metric got worse
+func (d *ReportDiff) HasRegressions() bool {
+	if len(d.Regressed) > 0 {
+		return true
+	}
+	for _, metric := range d.Metrics {
+		if metric.Delta() < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff compares the overall metrics and the fixture results of the report with the baseline
+func Diff(baseline, current *Report) *ReportDiff {
+	diff := &ReportDiff{}
+
+	addMetric := func(name string, baselineValue, currentValue float64) {
+		diff.Metrics = append(diff.Metrics, MetricChange{
+			Name:     name,
+			Baseline: baselineValue,
+			Current:  currentValue,
+		})
+	}
+	addMetric("status_accuracy", baseline.Overall.StatusAccuracy, current.Overall.StatusAccuracy)
+	for _, field := range fields {
+		baselineScore, currentScore := baseline.Overall.Fields[field], current.Overall.Fields[field]
+		if baselineScore == nil || currentScore == nil {
+			continue
+		}
+		addMetric(fmt.Sprintf("%s_precision", field), baselineScore.Precision, currentScore.Precision)
+		addMetric(fmt.Sprintf("%s_recall", field), baselineScore.Recall, currentScore.Recall)
+	}
+
+	baselineResults := map[string]FixtureResult{}
+	for _, result := range baseline.Results {
+		baselineResults[result.Name] = result
+	}
+	currentNames := map[string]bool{}
+	for _, result := range current.Results {
+		currentNames[result.Name] = true
+
+		baselineResult, ok := baselineResults[result.Name]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, result.Name)
+		case baselineResult.Passed && !result.Passed:
+			diff.Regressed = append(diff.Regressed, result.Name)
+		case !baselineResult.Passed && result.Passed:
+			diff.Fixed = append(diff.Fixed, result.Name)
+		}
+	}
+	for _, result := range baseline.Results {
+		if !currentNames[result.Name] {
+			diff.Removed = append(diff.Removed, result.Name)
+		}
+	}
+
+	return diff
+}
+
+func LoadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report %s: %w", path, err)
+	}
+
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse report %s: %w", path, err)
+	}
+	if report.Overall == nil {
+		return nil, fmt.Errorf("report %s has no overall scores", path)
+	}
+	return &report, nil
+}
+
+func SaveReport(path string, report *Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write report %s: %w", path, err)
+	}
+	return nil
+}
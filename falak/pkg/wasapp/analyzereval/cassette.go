@@ -0,0 +1,163 @@
+package wasappanalyzereval
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+type CassetteMode string
+
+const (
+	// CassetteMode_Record sends the requests on and keeps their responses
+	CassetteMode_Record CassetteMode = "record"
+	// CassetteMode_Replay answers the requests with the kept responses and never sends them, so a run is offline
+	CassetteMode_Replay CassetteMode = "replay"
+)
+
+// Cassette is an http.RoundTripper that records the LLM responses and replays them later, a request is replayed when
+// its method, path and body are the same as a recorded one. The API key and other headers are never kept.
+type Cassette struct {
+	path string
+	mode CassetteMode
+	next http.RoundTripper
+
+	mu           sync.Mutex
+	interactions map[string]interaction
+}
+
+type interaction struct {
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	RequestBody  string `json:"request_body"`
+	StatusCode   int    `json:"status_code"`
+	ResponseBody string `json:"response_body"`
+}
+
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the request body: %w", err)
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+	key := interactionKey(req.Method, req.URL.Path, reqBody)
+
+	if c.mode == CassetteMode_Replay {
+		c.mu.Lock()
+		recorded, ok := c.interactions[key]
+		c.mu.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("no recorded response for %s %s, record the cassette again", req.Method, req.URL.Path)
+		}
+		return recorded.response(req), nil
+	}
+
+	resp, err := c.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response body: %w", err)
+	}
+
+	recorded := interaction{
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		RequestBody:  string(reqBody),
+		StatusCode:   resp.StatusCode,
+		ResponseBody: string(respBody),
+	}
+	// failed requests are not kept, so recording again retries them
+	if resp.StatusCode < http.StatusBadRequest {
+		c.mu.Lock()
+		c.interactions[key] = recorded
+		c.mu.Unlock()
+	}
+	return recorded.response(req), nil
+}
+
+// Save writes the recorded responses to the cassette file, it does nothing when replaying
+func (c *Cassette) Save() error {
+	if c.mode != CassetteMode_Record {
+		return nil
+	}
+
+	c.mu.Lock()
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to marshal the cassette: %w", err)
+	}
+
+	if err := os.WriteFile(c.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write the cassette %s: %w", c.path, err)
+	}
+	return nil
+}
+
+func (i interaction) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader([]byte(i.ResponseBody))),
+		ContentLength: int64(len(i.ResponseBody)),
+		Request:       req,
+	}
+}
+
+func interactionKey(method, path string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method + " " + path + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// NewCassette opens the cassette at path, recording adds to the responses already in it and next is only used then.
+// A cassette that does not exist yet can only be recorded.
+func NewCassette(path string, mode CassetteMode, next http.RoundTripper) (*Cassette, error) {
+	if mode != CassetteMode_Record && mode != CassetteMode_Replay {
+		return nil, fmt.Errorf("unknown cassette mode: %s", mode)
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	cassette := &Cassette{
+		path:         path,
+		mode:         mode,
+		next:         next,
+		interactions: map[string]interaction{},
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist) && mode == CassetteMode_Record:
+		return cassette, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to read the cassette %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(data, &cassette.interactions); err != nil {
+		return nil, fmt.Errorf("failed to parse the cassette %s: %w", path, err)
+	}
+	return cassette, nil
+}
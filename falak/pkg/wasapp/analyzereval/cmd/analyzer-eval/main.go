@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/util"
+	wasappanalyzereval "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/analyzereval"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+	"github.com/openai/openai-go"
+	openaioption "github.com/openai/openai-go/option"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func main() {
+	fixturesDir := flag.String("fixtures", "pkg/wasapp/analyzereval/fixtures", "directory of the labeled conversations")
+	backends := flag.String("backends", "", "comma separated analyzer backends to chain, defaults to WASAPP_ANALYZER_BACKENDS")
+	promptVersion := flag.String("prompt-version", "", "analyze prompt version to evaluate, defaults to the latest one")
+	cassettePath := flag.String("cassette", "", "file to replay the LLM responses from, nothing is sent to the LLM when set")
+	record := flag.Bool("record", false, "send the requests to the LLM and record the responses to -cassette")
+	baselinePath := flag.String("baseline", "", "report to compare the results with")
+	saveBaselinePath := flag.String("save-baseline", "", "file to save the report to, to compare later runs with")
+	asJson := flag.Bool("json", false, "print the report as JSON")
+	failOnRegression := flag.Bool("fail-on-regression", false, "exit with an error when the results got worse than -baseline")
+	flag.Parse()
+
+	// the analyzers log every analysis, which buries the report
+	zerolog.SetGlobalLevel(zerolog.WarnLevel)
+	ctx := log.Logger.WithContext(context.Background())
+
+	config, err := util.LoadFalakConfig()
+	if err != nil {
+		log.Fatal().Msgf("cannot load config: %v", err)
+	}
+
+	fixtures, err := wasappanalyzereval.LoadFixtures(*fixturesDir)
+	if err != nil {
+		log.Fatal().Msgf("cannot load fixtures: %v", err)
+	}
+
+	// ======== LLM CLI ========
+	llmHttpCli := &http.Client{}
+	var cassette *wasappanalyzereval.Cassette
+	if *cassettePath != "" {
+		mode := wasappanalyzereval.CassetteMode_Replay
+		if *record {
+			mode = wasappanalyzereval.CassetteMode_Record
+		}
+		cassette, err = wasappanalyzereval.NewCassette(*cassettePath, mode, http.DefaultTransport)
+		if err != nil {
+			log.Fatal().Msgf("cannot open cassette: %v", err)
+		}
+		llmHttpCli.Transport = cassette
+	} else if *record {
+		log.Fatal().Msg("-record needs a -cassette to record to")
+	}
+
+	llmCli := openai.NewClient(
+		openaioption.WithBaseURL(config.OpenAiBaseUrl),
+		openaioption.WithAPIKey(config.OpenAiApiKey),
+		openaioption.WithHTTPClient(llmHttpCli),
+		// a replayed failure is not going to succeed on a retry
+		openaioption.WithMaxRetries(0),
+	)
+	// ======== LLM CLI ========
+
+	// ======== MSG ANALYZER ========
+	backendsValue := config.WasappAnalyzerBackends
+	if *backends != "" {
+		backendsValue = *backends
+	}
+
+	promptRolloutValue := ""
+	if *promptVersion != "" {
+		promptRolloutValue = *promptVersion + ":100"
+	}
+	promptRollout, err := wasappmsganalyzer.ParsePromptRollout(promptRolloutValue)
+	if err != nil {
+		log.Fatal().Msgf("failed to parse the prompt version: %v", err)
+	}
+
+	redaction, err := wasappmsganalyzer.ParseRedaction(config.WasappRedaction)
+	if err != nil {
+		log.Fatal().Msgf("failed to parse the redaction: %v", err)
+	}
+
+	msgAnalyzerRegistry := wasappmsganalyzer.NewRegistry()
+	msgAnalyzerRegistry.Register(wasappmsganalyzer.Backend_LLM, wasappmsganalyzer.NewRedactingAnalyzer(
+		wasappmsganalyzer.NewAnalyzer(&llmCli, config.OpenAiModelName, config.OpenAiStructuredOutputs, promptRollout),
+		redaction,
+	))
+	msgAnalyzerRegistry.Register(wasappmsganalyzer.Backend_Rules, wasappmsganalyzer.NewRulesAnalyzer())
+	msgAnalyzer, err := msgAnalyzerRegistry.Chain(
+		wasappmsganalyzer.ParseBackends(backendsValue),
+		time.Duration(config.WasappAnalyzerTimeoutSeconds)*time.Second,
+	)
+	if err != nil {
+		log.Fatal().Msgf("failed to create the msg analyzer: %v", err)
+	}
+	// ======== MSG ANALYZER ========
+
+	report, err := wasappanalyzereval.Run(ctx, msgAnalyzer, fixtures)
+	if err != nil {
+		log.Fatal().Msgf("failed to run the evaluation: %v", err)
+	}
+
+	if cassette != nil {
+		if err := cassette.Save(); err != nil {
+			log.Fatal().Msgf("failed to save the cassette: %v", err)
+		}
+	}
+
+	var diff *wasappanalyzereval.ReportDiff
+	if *baselinePath != "" {
+		baseline, err := wasappanalyzereval.LoadReport(*baselinePath)
+		if err != nil {
+			log.Fatal().Msgf("cannot load baseline: %v", err)
+		}
+		diff = wasappanalyzereval.Diff(baseline, report)
+	}
+
+	if *asJson {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(struct {
+			Report *wasappanalyzereval.Report     `json:"report"`
+			Diff   *wasappanalyzereval.ReportDiff `json:"diff,omitempty"`
+		}{report, diff})
+	} else {
+		err = wasappanalyzereval.WriteReport(os.Stdout, report)
+		if err == nil && diff != nil {
+			err = wasappanalyzereval.WriteDiff(os.Stdout, diff)
+		}
+	}
+	if err != nil {
+		log.Fatal().Msgf("failed to print the report: %v", err)
+	}
+
+	if *saveBaselinePath != "" {
+		if err := wasappanalyzereval.SaveReport(*saveBaselinePath, report); err != nil {
+			log.Fatal().Msgf("failed to save the baseline: %v", err)
+		}
+	}
+
+	if *failOnRegression && diff != nil && diff.HasRegressions() {
+		log.Fatal().Msg("the results regressed from the baseline")
+	}
+}
@@ -0,0 +1,326 @@
+package wasappanalyzereval
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+)
+
+// Field is an event field that is scored on its own
+type Field string
+
+const (
+	Field_Date     Field = "date"
+	Field_Time     Field = "time"
+	Field_Title    Field = "title"
+	Field_Location Field = "location"
+)
+
+var fields = []Field{Field_Date, Field_Time, Field_Title, Field_Location}
+
+// statuses is the order the statuses are shown in the confusion matrices
+var statuses = []wasappmsganalyzer.AnalyzeMessagesStatus{
+	wasappmsganalyzer.AnalyzeMessagesStatus_NoEvent,
+	wasappmsganalyzer.AnalyzeMessagesStatus_HasEventButNotConfirmed,
+	wasappmsganalyzer.AnalyzeMessagesStatus_HasEventAgreed,
+	wasappmsganalyzer.AnalyzeMessagesStatus_HasEventDenied,
+	wasappmsganalyzer.AnalyzeMessagesStatus_EventUpdated,
+	wasappmsganalyzer.AnalyzeMessagesStatus_EventCancelled,
+}
+
+type Report struct {
+	Overall    *Scores            `json:"overall"`
+	ByLanguage map[string]*Scores `json:"by_language"`
+	Results    []FixtureResult    `json:"results"`
+}
+
+type Scores struct {
+	// Events counts the expected events paired with the analyzed ones, a missing or an extra event counts as
+	// AnalyzeMessagesStatus_NoEvent on its missing side
+	Events         int                   `json:"events"`
+	StatusCorrect  int                   `json:"status_correct"`
+	StatusAccuracy float64               `json:"status_accuracy"`
+	Fields         map[Field]*FieldScore `json:"fields"`
+	Confusion      ConfusionMatrix       `json:"confusion"`
+}
+
+// ConfusionMatrix counts the analyzed statuses for every expected status
+type ConfusionMatrix map[wasappmsganalyzer.AnalyzeMessagesStatus]map[wasappmsganalyzer.AnalyzeMessagesStatus]int
+
+type FieldScore struct {
+	TruePositives  int     `json:"true_positives"`
+	FalsePositives int     `json:"false_positives"`
+	FalseNegatives int     `json:"false_negatives"`
+	Precision      float64 `json:"precision"`
+	Recall         float64 `json:"recall"`
+}
+
+type FixtureResult struct {
+	Name     string `json:"name"`
+	Language string `json:"language"`
+	Passed   bool   `json:"passed"`
+	// Error is set when the analyzer failed, the fixture then counts as if no events were found
+	Error         string   `json:"error,omitempty"`
+	Backend       string   `json:"backend,omitempty"`
+	Model         string   `json:"model,omitempty"`
+	PromptVersion string   `json:"prompt_version,omitempty"`
+	Mismatches    []string `json:"mismatches,omitempty"`
+}
+
+// Run analyzes every fixture with analyzer and scores the results, a failing analysis is reported with the fixture
+// instead of stopping the run.
+func Run(ctx context.Context, analyzer wasappmsganalyzer.Analyzer, fixtures []Fixture) (*Report, error) {
+	report := &Report{
+		Overall:    newScores(),
+		ByLanguage: map[string]*Scores{},
+	}
+
+	for _, fixture := range fixtures {
+		req, err := fixture.request()
+		if err != nil {
+			return nil, err
+		}
+
+		result := FixtureResult{
+			Name:     fixture.Name,
+			Language: fixture.Language,
+		}
+		var analyzedEvents []wasappmsganalyzer.AnalyzedEvent
+		resp, err := analyzer.AnalyzeMessages(ctx, req)
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			analyzedEvents = resp.Events
+			result.Backend = string(resp.Backend)
+			result.Model = resp.Model
+			result.PromptVersion = resp.PromptVersion
+		}
+
+		languageScores, ok := report.ByLanguage[fixture.Language]
+		if !ok {
+			languageScores = newScores()
+			report.ByLanguage[fixture.Language] = languageScores
+		}
+
+		for _, pair := range pairEvents(fixture.Expected, analyzedEvents) {
+			result.Mismatches = append(result.Mismatches, pair.score(report.Overall, languageScores)...)
+		}
+		result.Passed = result.Error == "" && len(result.Mismatches) == 0
+		report.Results = append(report.Results, result)
+	}
+
+	report.Overall.finish()
+	for _, scores := range report.ByLanguage {
+		scores.finish()
+	}
+	return report, nil
+}
+
+func newScores() *Scores {
+	scores := &Scores{
+		Fields:    map[Field]*FieldScore{},
+		Confusion: ConfusionMatrix{},
+	}
+	for _, field := range fields {
+		scores.Fields[field] = &FieldScore{}
+	}
+	return scores
+}
+
+func (s *Scores) finish() {
+	s.StatusAccuracy = ratio(s.StatusCorrect, s.Events)
+	for _, score := range s.Fields {
+		score.Precision = ratio(score.TruePositives, score.TruePositives+score.FalsePositives)
+		score.Recall = ratio(score.TruePositives, score.TruePositives+score.FalseNegatives)
+	}
+}
+
+// ratio is 1 when there is nothing to count, as nothing was gotten wrong
+func ratio(n, total int) float64 {
+	if total == 0 {
+		return 1
+	}
+	return float64(n) / float64(total)
+}
+
+// eventPair is an expected event and the analyzed event it is scored against, either may be nil when the analyzer
+// found fewer or more events than expected
+type eventPair struct {
+	expected *ExpectedEvent
+	analyzed *wasappmsganalyzer.AnalyzedEvent
+}
+
+// pairEvents pairs the events with the same status first, so finding the events in another order is not punished,
+// then pairs what is left in order.
+func pairEvents(expected []ExpectedEvent, analyzed []wasappmsganalyzer.AnalyzedEvent) []eventPair {
+	if len(expected) == 0 && len(analyzed) == 0 {
+		// a conversation without events that got none is a correct AnalyzeMessagesStatus_NoEvent
+		return []eventPair{{}}
+	}
+
+	pairs := make([]eventPair, len(expected))
+	used := make([]bool, len(analyzed))
+	for idx := range expected {
+		pairs[idx].expected = &expected[idx]
+		for analyzedIdx := range analyzed {
+			if !used[analyzedIdx] && analyzed[analyzedIdx].Status == expected[idx].Status {
+				pairs[idx].analyzed = &analyzed[analyzedIdx]
+				used[analyzedIdx] = true
+				break
+			}
+		}
+	}
+
+	for idx := range pairs {
+		if pairs[idx].analyzed != nil {
+			continue
+		}
+		for analyzedIdx := range analyzed {
+			if !used[analyzedIdx] {
+				pairs[idx].analyzed = &analyzed[analyzedIdx]
+				used[analyzedIdx] = true
+				break
+			}
+		}
+	}
+
+	for analyzedIdx := range analyzed {
+		if !used[analyzedIdx] {
+			pairs = append(pairs, eventPair{analyzed: &analyzed[analyzedIdx]})
+		}
+	}
+	return pairs
+}
+
+// score adds the pair to every one of the scores and returns what the analyzer got wrong
+func (p eventPair) score(scores ...*Scores) []string {
+	expected := p.expected
+	if expected == nil {
+		expected = &ExpectedEvent{Status: wasappmsganalyzer.AnalyzeMessagesStatus_NoEvent}
+	}
+	analyzedStatus := wasappmsganalyzer.AnalyzeMessagesStatus_NoEvent
+	if p.analyzed != nil {
+		analyzedStatus = p.analyzed.Status
+	}
+
+	var mismatches []string
+	statusCorrect := analyzedStatus == expected.Status
+	if !statusCorrect {
+		mismatches = append(mismatches, fmt.Sprintf("status: expected %s, got %s", expected.Status, analyzedStatus))
+	}
+	if uid := analyzedEventUID(p.analyzed); uid != expected.EventUID {
+		statusCorrect = false
+		mismatches = append(mismatches, fmt.Sprintf("event_uid: expected %q, got %q", expected.EventUID, uid))
+	}
+
+	fieldValues := analyzedFieldValues(p.analyzed)
+	fieldResults := map[Field]fieldResult{}
+	for _, field := range fields {
+		expectedValue := expectedFieldValue(expected, field)
+		fieldResults[field] = compareField(field, expectedValue, fieldValues[field])
+		if !fieldResults[field].matched {
+			mismatches = append(mismatches, fmt.Sprintf("%s: expected %q, got %q", field, expectedValue, fieldValues[field]))
+		}
+	}
+
+	for _, s := range scores {
+		s.Events++
+		if statusCorrect {
+			s.StatusCorrect++
+		}
+		if s.Confusion[expected.Status] == nil {
+			s.Confusion[expected.Status] = map[wasappmsganalyzer.AnalyzeMessagesStatus]int{}
+		}
+		s.Confusion[expected.Status][analyzedStatus]++
+
+		for field, result := range fieldResults {
+			fieldScore := s.Fields[field]
+			if result.truePositive {
+				fieldScore.TruePositives++
+			}
+			if result.falsePositive {
+				fieldScore.FalsePositives++
+			}
+			if result.falseNegative {
+				fieldScore.FalseNegatives++
+			}
+		}
+	}
+	return mismatches
+}
+
+type fieldResult struct {
+	matched       bool
+	truePositive  bool
+	falsePositive bool
+	falseNegative bool
+}
+
+// compareField scores an analyzed value against the expected one, a wrong value is both a false positive and a
+// false negative. Titles and locations are written freely, so they match when one has the other in it.
+func compareField(field Field, expected, analyzed string) fieldResult {
+	expected, analyzed = normalizeValue(expected), normalizeValue(analyzed)
+
+	matched := expected == analyzed
+	if !matched && expected != "" && analyzed != "" && (field == Field_Title || field == Field_Location) {
+		matched = strings.Contains(expected, analyzed) || strings.Contains(analyzed, expected)
+	}
+
+	return fieldResult{
+		matched:       matched,
+		truePositive:  matched && expected != "",
+		falsePositive: !matched && analyzed != "",
+		falseNegative: !matched && expected != "",
+	}
+}
+
+func normalizeValue(value string) string {
+	return strings.Join(strings.Fields(strings.ToLower(value)), " ")
+}
+
+func expectedFieldValue(expected *ExpectedEvent, field Field) string {
+	switch field {
+	case Field_Date:
+		return expected.StartDate
+	case Field_Time:
+		return expected.StartTime
+	case Field_Title:
+		return expected.Title
+	case Field_Location:
+		return expected.Location
+	}
+	return ""
+}
+
+func analyzedFieldValues(analyzedEvent *wasappmsganalyzer.AnalyzedEvent) map[Field]string {
+	values := map[Field]string{}
+	if analyzedEvent == nil || analyzedEvent.Event == nil {
+		return values
+	}
+
+	event := analyzedEvent.Event
+	values[Field_Date] = valueOf(event.StartDate)
+	values[Field_Title] = valueOf(event.Title)
+	values[Field_Location] = valueOf(event.Location)
+	values[Field_Time] = valueOf(event.StartTime)
+	if anchor := event.StartPrayerAnchor; anchor != nil && values[Field_Time] == "" {
+		values[Field_Time] = fmt.Sprintf("%s %s", anchor.Relation, anchor.Prayer)
+	}
+	return values
+}
+
+func analyzedEventUID(analyzedEvent *wasappmsganalyzer.AnalyzedEvent) string {
+	if analyzedEvent == nil {
+		return ""
+	}
+	return valueOf(analyzedEvent.EventUID)
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,98 @@
+package wasappanalyzereval
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+	"github.com/openai/openai-go"
+	openaioption "github.com/openai/openai-go/option"
+)
+
+const (
+	fixturesDir       = "fixtures"
+	rulesBaselinePath = "baselines/rules.json"
+	// llmCassettePath has the responses of the LLM to the fixtures, it is recorded again when the prompt or the fixtures
+	// change with OPEN_AI_MODEL_NAME=gpt-4o-mini and OPEN_AI_STRUCTURED_OUTPUTS=true by running
+	// `analyzer-eval -backends llm -cassette pkg/wasapp/analyzereval/cassettes/llm.json -record`
+	llmCassettePath = "cassettes/llm.json"
+	llmModelName    = "gpt-4o-mini"
+)
+
+func TestRulesAnalyzer(t *testing.T) {
+	report := runFixtures(t, wasappmsganalyzer.NewRulesAnalyzer())
+	checkAgainstBaseline(t, report, rulesBaselinePath)
+}
+
+func TestLLMAnalyzer(t *testing.T) {
+	cassette, err := NewCassette(llmCassettePath, CassetteMode_Replay, nil)
+	if err != nil {
+		t.Fatalf("failed to open the cassette: %v", err)
+	}
+
+	llmCli := openai.NewClient(
+		openaioption.WithBaseURL("http://llm.invalid/v1"),
+		openaioption.WithAPIKey("replayed"),
+		openaioption.WithHTTPClient(&http.Client{Transport: cassette}),
+		openaioption.WithMaxRetries(0),
+	)
+	promptRollout, err := wasappmsganalyzer.ParsePromptRollout("")
+	if err != nil {
+		t.Fatalf("failed to parse the prompt rollout: %v", err)
+	}
+	// the same redaction the cassette is recorded with by default
+	redaction, err := wasappmsganalyzer.ParseRedaction("")
+	if err != nil {
+		t.Fatalf("failed to parse the redaction: %v", err)
+	}
+
+	report := runFixtures(t, wasappmsganalyzer.NewRedactingAnalyzer(
+		wasappmsganalyzer.NewAnalyzer(&llmCli, llmModelName, true, promptRollout),
+		redaction,
+	))
+	for _, result := range report.Results {
+		if result.Error != "" {
+			t.Errorf("fixture %s failed, the cassette may need recording again: %s", result.Name, result.Error)
+		}
+	}
+	// the LLM is expected to do at least as well as the rules
+	checkAgainstBaseline(t, report, rulesBaselinePath)
+}
+
+func runFixtures(t *testing.T, analyzer wasappmsganalyzer.Analyzer) *Report {
+	t.Helper()
+
+	fixtures, err := LoadFixtures(fixturesDir)
+	if err != nil {
+		t.Fatalf("failed to load the fixtures: %v", err)
+	}
+
+	report, err := Run(context.Background(), analyzer, fixtures)
+	if err != nil {
+		t.Fatalf("failed to run the evaluation: %v", err)
+	}
+	return report
+}
+
+func checkAgainstBaseline(t *testing.T, report *Report, baselinePath string) {
+	t.Helper()
+
+	baseline, err := LoadReport(baselinePath)
+	if err != nil {
+		t.Fatalf("failed to load the baseline: %v", err)
+	}
+
+	diff := Diff(baseline, report)
+	for _, name := range diff.Regressed {
+		t.Errorf("fixture %s passed in the baseline and fails now", name)
+	}
+	for _, name := range diff.Removed {
+		t.Errorf("fixture %s is in the baseline but was not run", name)
+	}
+	for _, metric := range diff.Metrics {
+		if metric.Delta() < 0 {
+			t.Errorf("%s went down from %.3f to %.3f", metric.Name, metric.Baseline, metric.Current)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package wasappanalyzereval
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultFixtureTimezone = "Asia/Riyadh"
+
+// Fixture is a labeled conversation, it is written in YAML or JSON with one fixture per file
+type Fixture struct {
+	Name string `yaml:"name"`
+	// Language is what the chat is written in, like "ar", "en" or "mixed", the scores are also reported per language
+	Language      string                          `yaml:"language"`
+	IsGroup       bool                            `yaml:"is_group"`
+	AgreementRule wasappmsganalyzer.AgreementRule `yaml:"agreement_rule"`
+	// Timezone is where the customer is, defaultFixtureTimezone is used when it is empty
+	Timezone string `yaml:"timezone"`
+	// Now is when the conversation is analyzed, the messages are sent just before it unless they say otherwise
+	Now         time.Time           `yaml:"now"`
+	Summary     string              `yaml:"summary"`
+	KnownEvents []FixtureKnownEvent `yaml:"known_events"`
+	Messages    []FixtureMessage    `yaml:"messages"`
+	// Expected has the events a correct analysis finds, it is empty when the conversation has none
+	Expected []ExpectedEvent `yaml:"expected"`
+}
+
+type FixtureKnownEvent struct {
+	UID   string    `yaml:"uid"`
+	Title string    `yaml:"title"`
+	Start time.Time `yaml:"start"`
+	End   time.Time `yaml:"end"`
+}
+
+type FixtureMessage struct {
+	Sender string `yaml:"sender"`
+	// Me is set for the messages the customer sent
+	Me   bool   `yaml:"me"`
+	Body string `yaml:"body"`
+	// At is when the message was sent, a message without it is sent a minute after the one before it
+	At *time.Time `yaml:"at"`
+}
+
+// ExpectedEvent is what an analyzed event is scored against, the fields left empty are expected to be empty too
+type ExpectedEvent struct {
+	Status    wasappmsganalyzer.AnalyzeMessagesStatus `yaml:"status"`
+	EventUID  string                                  `yaml:"event_uid"`
+	Title     string                                  `yaml:"title"`
+	StartDate string                                  `yaml:"start_date"`
+	// StartTime is either HH:mm or a prayer anchor like "after isha"
+	StartTime string `yaml:"start_time"`
+	Location  string `yaml:"location"`
+}
+
+// LoadFixtures reads the fixtures in dir, ordered by file name
+func LoadFixtures(dir string) ([]Fixture, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the fixtures dir: %w", err)
+	}
+
+	var fixtures []Fixture
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || !slices.Contains([]string{".yaml", ".yml", ".json"}, ext) {
+			continue
+		}
+
+		fixture, err := loadFixture(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	if len(fixtures) == 0 {
+		return nil, fmt.Errorf("no fixtures found in %s", dir)
+	}
+	return fixtures, nil
+}
+
+func loadFixture(path string) (Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Fixture{}, fmt.Errorf("failed to read fixture %s: %w", path, err)
+	}
+
+	// JSON is valid YAML, so both are read the same way
+	var fixture Fixture
+	if err := yaml.Unmarshal(data, &fixture); err != nil {
+		return Fixture{}, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+
+	if fixture.Name == "" {
+		fixture.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if fixture.Now.IsZero() {
+		return Fixture{}, fmt.Errorf("fixture %s has no now", fixture.Name)
+	}
+	if len(fixture.Messages) == 0 {
+		return Fixture{}, fmt.Errorf("fixture %s has no messages", fixture.Name)
+	}
+	return fixture, nil
+}
+
+// request turns the fixture into the request an analyzer is given in production
+func (f Fixture) request() (*wasappmsganalyzer.AnalyzeMessagesRequest, error) {
+	timezone := f.Timezone
+	if timezone == "" {
+		timezone = defaultFixtureTimezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q in fixture %s: %w", timezone, f.Name, err)
+	}
+
+	sentAt := f.Now.Add(-time.Duration(len(f.Messages)) * time.Minute)
+	msgs := make([]wasappmsganalyzer.MessageForAnalysis, len(f.Messages))
+	for idx, msg := range f.Messages {
+		sentAt = sentAt.Add(time.Minute)
+		if msg.At != nil {
+			sentAt = *msg.At
+		}
+		msgs[idx] = wasappmsganalyzer.MessageForAnalysis{
+			SenderName: msg.Sender,
+			IsSenderMe: msg.Me,
+			Body:       msg.Body,
+			Timestamp:  sentAt.Unix(),
+		}
+	}
+
+	knownEvents := make([]wasappmsganalyzer.KnownEvent, len(f.KnownEvents))
+	for idx, event := range f.KnownEvents {
+		knownEvents[idx] = wasappmsganalyzer.KnownEvent{
+			UID:   event.UID,
+			Title: event.Title,
+			Start: event.Start.In(loc),
+			End:   event.End.In(loc),
+		}
+	}
+
+	agreementRule := f.AgreementRule
+	if agreementRule == "" {
+		agreementRule = wasappmsganalyzer.AgreementRule_Customer
+	}
+
+	return &wasappmsganalyzer.AnalyzeMessagesRequest{
+		Messages:      msgs,
+		Summary:       f.Summary,
+		Events:        knownEvents,
+		IsGroup:       f.IsGroup,
+		AgreementRule: agreementRule,
+		Timezone:      loc,
+		Now:           f.Now.In(loc),
+	}, nil
+}
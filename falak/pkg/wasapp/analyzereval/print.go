@@ -0,0 +1,95 @@
+package wasappanalyzereval
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+// WriteReport writes the report as tables meant for a terminal
+func WriteReport(w io.Writer, report *Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "FIXTURE\tLANGUAGE\tRESULT\tDETAILS")
+	for _, result := range report.Results {
+		outcome, details := "pass", ""
+		if !result.Passed {
+			outcome = "FAIL"
+			details = strings.Join(result.Mismatches, "; ")
+			if result.Error != "" {
+				details = "error: " + result.Error
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.Name, result.Language, outcome, details)
+	}
+	fmt.Fprintln(tw)
+
+	writeScores(tw, "overall", report.Overall)
+	languages := make([]string, 0, len(report.ByLanguage))
+	for language := range report.ByLanguage {
+		languages = append(languages, language)
+	}
+	slices.Sort(languages)
+	for _, language := range languages {
+		writeScores(tw, language, report.ByLanguage[language])
+	}
+
+	return tw.Flush()
+}
+
+func writeScores(w io.Writer, name string, scores *Scores) {
+	fmt.Fprintf(w, "== %s ==\n", name)
+	fmt.Fprintf(w, "status accuracy\t%.2f\t(%d/%d)\n", scores.StatusAccuracy, scores.StatusCorrect, scores.Events)
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "FIELD\tPRECISION\tRECALL\tTP\tFP\tFN")
+	for _, field := range fields {
+		score := scores.Fields[field]
+		fmt.Fprintf(w, "%s\t%.2f\t%.2f\t%d\t%d\t%d\n", field, score.Precision, score.Recall, score.TruePositives, score.FalsePositives, score.FalseNegatives)
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprint(w, "EXPECTED \\ ANALYZED")
+	for _, status := range statuses {
+		fmt.Fprintf(w, "\t%s", status)
+	}
+	fmt.Fprintln(w)
+	for _, expected := range statuses {
+		fmt.Fprint(w, expected)
+		for _, analyzed := range statuses {
+			fmt.Fprintf(w, "\t%d", scores.Confusion[expected][analyzed])
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w)
+}
+
+// WriteDiff writes how the report changed from the baseline
+func WriteDiff(w io.Writer, diff *ReportDiff) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "== compared with the baseline ==")
+	fmt.Fprintln(tw, "METRIC\tBASELINE\tCURRENT\tDELTA")
+	for _, metric := range diff.Metrics {
+		fmt.Fprintf(tw, "%s\t%.2f\t%.2f\t%+.2f\n", metric.Name, metric.Baseline, metric.Current, metric.Delta())
+	}
+	fmt.Fprintln(tw)
+
+	for _, group := range []struct {
+		name     string
+		fixtures []string
+	}{
+		{"regressed", diff.Regressed},
+		{"fixed", diff.Fixed},
+		{"added", diff.Added},
+		{"removed", diff.Removed},
+	} {
+		if len(group.fixtures) > 0 {
+			fmt.Fprintf(tw, "%s\t%s\n", group.name, strings.Join(group.fixtures, ", "))
+		}
+	}
+
+	return tw.Flush()
+}
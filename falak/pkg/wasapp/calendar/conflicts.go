@@ -0,0 +1,159 @@
+package wasappcalendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayer"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/calendarsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/notificationsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	"github.com/rs/zerolog"
+)
+
+// maxShifts caps how many times an event is pushed after a prayer, an event longer than the gap
+// between two prayers would otherwise be shifted forever.
+const maxShifts = 3
+
+// prayerConflicts is the outcome of checking an event against the customer's prayer times
+type prayerConflicts struct {
+	mode    store.PrayerConflictMode
+	windows []prayer.Window
+	shifted bool
+}
+
+// checkPrayerConflicts finds the prayers the event overlaps, and moves the event after them when the customer
+// asked for it and the event has no agreed on time. The event is updated in place.
+func (c *consumer) checkPrayerConflicts(ctx context.Context, logger zerolog.Logger, eventData *CalendarEventData, mode store.PrayerConflictMode) (*prayerConflicts, error) {
+	res := &prayerConflicts{mode: mode}
+	if mode == store.PrayerConflictModeOff {
+		return res, nil
+	}
+
+	settings, err := c.prayerSvc.GetCustomerSettings(ctx, eventData.CustomerID)
+	if err != nil {
+		if errors.Is(err, prayersvc.ErrNoSettings) {
+			logger.Debug().Msg("customer has no prayer settings, skipping prayer conflicts check")
+			return res, nil
+		}
+		return nil, err
+	}
+
+	res.windows, err = prayer.OverlappingWindows(eventData.StartTime, eventData.EndTime, settings.Location, settings.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode != store.PrayerConflictModeShift || !eventData.FlexibleTime {
+		return res, nil
+	}
+
+	for i := 0; i < maxShifts && len(res.windows) > 0; i++ {
+		duration := eventData.EndTime.Sub(eventData.StartTime)
+		eventData.StartTime = res.windows[0].End
+		eventData.EndTime = eventData.StartTime.Add(duration)
+		res.shifted = true
+
+		res.windows, err = prayer.OverlappingWindows(eventData.StartTime, eventData.EndTime, settings.Location, settings.Config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// note describes the conflicts so they can be added to the event description, it is empty when there is nothing to say
+func (pc *prayerConflicts) note() string {
+	var lines []string
+	if pc.shifted {
+		lines = append(lines, "🕌 Moved to after the prayer time.")
+	}
+	if len(pc.windows) > 0 && pc.mode != store.PrayerConflictModeBuffer {
+		lines = append(lines, fmt.Sprintf("⚠️ Overlaps %s.", pc.describeWindows()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (pc *prayerConflicts) describeWindows() string {
+	parts := make([]string, len(pc.windows))
+	for idx, w := range pc.windows {
+		parts[idx] = fmt.Sprintf("%s prayer (%s)", w.Prayer.Name(), w.Start.Format("15:04"))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// prayerBufferUID is the UID of the event blocking the prayer window w for the event with eventUID
+func prayerBufferUID(w prayer.Window, eventUID string) string {
+	return fmt.Sprintf("prayer-%s-%s-%s", w.Prayer, w.Start.UTC().Format("20060102"), eventUID)
+}
+
+// addPrayerBuffers adds an event blocking each prayer window the event overlaps
+func (c *consumer) addPrayerBuffers(ctx context.Context, eventData CalendarEventData, eventUID string, conflicts *prayerConflicts, hijriDate bool) error {
+	for _, w := range conflicts.windows {
+		err := c.calendarSvc.AddEvent(ctx, &calendarsvc.AddEventRequest{
+			CustomerID:  eventData.CustomerID,
+			PathSuffix:  whatsAppCalendarPathSuffix,
+			Summary:     fmt.Sprintf("🕌 %s prayer", w.Prayer.Name()),
+			Description: fmt.Sprintf("Prayer time during '%s'", eventData.Summary),
+			StartTime:   w.Start,
+			EndTime:     w.End,
+			UID:         prayerBufferUID(w, eventUID),
+			HijriDate:   hijriDate,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removePrayerBuffers deletes the events blocking the prayer windows of the event with eventUID, so they do not stay
+// behind when the event is removed or moved
+func (c *consumer) removePrayerBuffers(ctx context.Context, customerID uuid.UUID, eventUID string) error {
+	uids, err := c.calendarSvc.ListEventUIDs(ctx, &calendarsvc.ListEventUIDsRequest{
+		CustomerID: customerID,
+		PathSuffix: whatsAppCalendarPathSuffix,
+	})
+	if err != nil {
+		return fmt.Errorf("failed running ListEventUIDs: %w", err)
+	}
+
+	for _, uid := range uids {
+		if !strings.HasPrefix(uid, "prayer-") || !strings.HasSuffix(uid, "-"+eventUID) {
+			continue
+		}
+
+		err = c.calendarSvc.DeleteEvent(ctx, &calendarsvc.DeleteEventRequest{
+			CustomerID: customerID,
+			PathSuffix: whatsAppCalendarPathSuffix,
+			UID:        uid,
+		})
+		if err != nil && !errors.Is(err, calendarsvc.ErrEventNotFound) {
+			return fmt.Errorf("failed running DeleteEvent: %w", err)
+		}
+	}
+	return nil
+}
+
+// warnAboutPrayerConflicts lets the customer know the event they agreed on overlaps prayers
+func (c *consumer) warnAboutPrayerConflicts(ctx context.Context, eventData CalendarEventData, conflicts *prayerConflicts) error {
+	if len(conflicts.windows) == 0 || conflicts.mode == store.PrayerConflictModeBuffer {
+		return nil
+	}
+
+	return c.notificationSvc.SendNotificationToCustomerDevices(ctx, &notificationsvc.SendNotificationToCustomerDevicesRequest{
+		CustomerId: eventData.CustomerID,
+		AlertTitle: "🕌 Prayer Time Conflict",
+		AlertBody: fmt.Sprintf("'%s' on %s overlaps %s",
+			eventData.Summary,
+			eventData.StartTime.Format(time.DateOnly),
+			conflicts.describeWindows(),
+		),
+	})
+}
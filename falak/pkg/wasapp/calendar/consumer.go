@@ -2,15 +2,22 @@ package wasappcalendar
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ThreeDotsLabs/watermill-amqp/v3/pkg/amqp"
 	"github.com/ThreeDotsLabs/watermill/message"
 	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/hijri"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/calendarsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/notificationsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappclient "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/client"
 	"github.com/rs/zerolog/log"
 )
 
@@ -18,16 +25,18 @@ const (
 	whatsAppCalendarName       = "📱 WhatsApp Events"
 	whatsAppCalendarPathSuffix = "whatsapp-events/"
 	whatsAppCalendarColor      = "#2ECC71" // Green color
+	whatsAppSourcePrefix       = "whatsapp:"
 	consumerTag                = "falak-calendar"
 )
 
 type consumer struct {
-	subscriber                  *amqp.Subscriber
-	calendarEventsQueueName     string
-	store                       store.Queries
-	calendarSvc                 calendarsvc.Svc
-	calDAVPasswordEncryptionKey string
-	notificationSvc             notificationsvc.Svc
+	subscriber              *amqp.Subscriber
+	calendarEventsQueueName string
+	store                   store.Queries
+	calendarSvc             calendarsvc.Svc
+	encryptionSvc           encryptionsvc.Svc
+	notificationSvc         notificationsvc.Svc
+	prayerSvc               prayersvc.Svc
 }
 
 func (c *consumer) Start(ctx context.Context) error {
@@ -80,10 +89,7 @@ func (c *consumer) processMessage(ctx context.Context, msg *message.Message) {
 
 	logger.Info().Msg("processing calendar event")
 
-	credentials, err := c.store.GetCalDavAccountByCustomerId(ctx, store.GetCalDavAccountByCustomerIdParams{
-		CustomerID:    eventData.CustomerID,
-		EncryptionKey: c.calDAVPasswordEncryptionKey,
-	})
+	credentials, err := c.store.GetCalDavAccountByCustomerId(ctx, eventData.CustomerID)
 	if err != nil {
 		logger.Err(err).Msg("failed to get customer credentials")
 		if didNotSendAck := msg.Nack(); didNotSendAck {
@@ -92,12 +98,27 @@ func (c *consumer) processMessage(ctx context.Context, msg *message.Message) {
 		return
 	}
 
-	uid := fmt.Sprintf("%s@jadwal.app", uuid.New().String())
+	password, err := c.encryptionSvc.Decrypt(ctx, eventData.CustomerID, credentials.Password)
+	if errors.Is(err, encryptionsvc.ErrInvalidCiphertext) || errors.Is(err, encryptionsvc.ErrDataKeyShredded) {
+		// the password can never be read, redelivering the message would fail the same way
+		logger.Err(err).Msg("customer credentials cannot be decrypted, dropping the calendar event")
+		if didNotSendNack := msg.Ack(); didNotSendNack {
+			logger.Err(err).Msg("failed to acknowledge message, cuz Nack was already sent")
+		}
+		return
+	}
+	if err != nil {
+		logger.Err(err).Msg("failed to decrypt customer credentials")
+		if didNotSendAck := msg.Nack(); didNotSendAck {
+			log.Ctx(ctx).Err(err).Msg("failed to Nack the message, cuz Ack already sent")
+		}
+		return
+	}
 
 	err = c.calendarSvc.InitCalendar(ctx, &calendarsvc.InitCalendarRequest{
 		CustomerID:  eventData.CustomerID,
 		Username:    credentials.Username,
-		Password:    credentials.DecryptedPassword,
+		Password:    password,
 		PathSuffix:  whatsAppCalendarPathSuffix,
 		DisplayName: whatsAppCalendarName,
 		Color:       whatsAppCalendarColor,
@@ -112,23 +133,191 @@ func (c *consumer) processMessage(ctx context.Context, msg *message.Message) {
 
 	logger.Debug().Msg("initialized WhatsApp calendar")
 
-	err = c.calendarSvc.AddEvent(ctx, &calendarsvc.AddEventRequest{
-		CustomerID:  eventData.CustomerID,
-		Summary:     eventData.Summary,
-		Description: eventData.Description,
-		StartTime:   eventData.StartTime,
-		EndTime:     eventData.EndTime,
-		UID:         uid,
-	})
+	if eventData.Action == CalendarEventAction_Cancel || eventData.Action == CalendarEventAction_Release {
+		err = c.calendarSvc.DeleteEvent(ctx, &calendarsvc.DeleteEventRequest{
+			CustomerID: eventData.CustomerID,
+			PathSuffix: whatsAppCalendarPathSuffix,
+			UID:        eventData.UID,
+		})
+		if errors.Is(err, calendarsvc.ErrEventNotFound) {
+			// the customer removed it already, redelivering the message would not change that
+			logger.Info().Str("uid", eventData.UID).Msg("event was already removed from the calendar")
+			err = nil
+		}
+		if err != nil {
+			logger.Err(err).Str("uid", eventData.UID).Msg("failed to delete event from calendar")
+			if didNotSendAck := msg.Nack(); didNotSendAck {
+				log.Ctx(ctx).Err(err).Msg("failed to Nack the message, cuz Ack already sent")
+			}
+			return
+		}
+
+		logger.Info().Str("uid", eventData.UID).Str("action", string(eventData.Action)).Msg("successfully deleted event from WhatsApp calendar")
+
+		if err := c.removePrayerBuffers(ctx, eventData.CustomerID, eventData.UID); err != nil {
+			logger.Err(err).Msg("failed to remove prayer buffer events")
+		}
+
+		detectedEventState := store.DetectedEventStateCancelled
+		if eventData.Action == CalendarEventAction_Release {
+			detectedEventState = store.DetectedEventStateRejected
+		}
+		if err := c.setDetectedEventState(ctx, eventData.CustomerID, eventData.UID, detectedEventState); err != nil {
+			logger.Err(err).Msg("failed to set detected event state")
+		}
+
+		// a hold was never agreed on, so its removal is not worth a notification
+		if eventData.Action == CalendarEventAction_Release {
+			if didNotSendNack := msg.Ack(); didNotSendNack {
+				logger.Err(err).Msg("failed to acknowledge message, cuz Nack was already sent")
+			}
+			return
+		}
+
+		err = c.notificationSvc.SendNotificationToCustomerDevices(ctx, &notificationsvc.SendNotificationToCustomerDevicesRequest{
+			CustomerId: eventData.CustomerID,
+			AlertTitle: "🗑️ WhatsApp Event Cancelled",
+			AlertBody:  fmt.Sprintf("Event '%s' was cancelled in the chat and removed from your WhatsApp calendar", eventData.Summary),
+		})
+		if err != nil {
+			logger.Err(err).Msg("failed to send push notification")
+		}
+
+		if didNotSendNack := msg.Ack(); didNotSendNack {
+			logger.Err(err).Msg("failed to acknowledge message, cuz Nack was already sent")
+		}
+		return
+	}
+
+	preference := store.DefaultCustomerPreference()
+	storedPreference, err := c.store.GetCustomerPreferenceByCustomerId(ctx, eventData.CustomerID)
+	if err == nil {
+		preference = storedPreference
+	} else if err != sql.ErrNoRows {
+		logger.Err(err).Msg("failed to get customer preference, using the default preference")
+	}
+
+	conflicts, err := c.checkPrayerConflicts(ctx, logger, &eventData, preference.PrayerConflictMode)
+	if err != nil {
+		logger.Err(err).Msg("failed to check prayer conflicts, adding the event without checking")
+		conflicts = &prayerConflicts{mode: store.PrayerConflictModeOff}
+	}
+
+	description := eventData.Description
+	if note := conflicts.note(); note != "" {
+		description += "\n\n" + note
+	}
+	description = annotateHijriDate(description, eventData.StartTime, preference.HijriDateAnnotation)
+	hijriDate := preference.HijriDateAnnotation != store.HijriDateAnnotationOff
+
+	uid := eventData.UID
+	if uid == "" {
+		uid = fmt.Sprintf("%s@jadwal.app", uuid.New().String())
+	}
+
+	alertTitle := "📅 New WhatsApp Event Added"
+	alertBody := fmt.Sprintf("Event '%s' was added to your WhatsApp calendar", eventData.Summary)
+	if eventData.Action == CalendarEventAction_Update {
+		err = c.calendarSvc.UpdateEvent(ctx, &calendarsvc.UpdateEventRequest{
+			CustomerID:  eventData.CustomerID,
+			PathSuffix:  whatsAppCalendarPathSuffix,
+			UID:         uid,
+			Summary:     eventData.Summary,
+			Description: description,
+			StartTime:   eventData.StartTime,
+			EndTime:     eventData.EndTime,
+			Tentative:   eventData.Tentative,
+			Location:    eventData.Location,
+			Geo:         mapEventGeo(eventData.Geo),
+			Attendees:   mapEventAttendees(eventData.Attendees),
+			URL:         wasappclient.ChatURL(eventData.ChatID),
+			Source:      whatsAppSourcePrefix + eventData.ChatID,
+			HijriDate:   hijriDate,
+		})
+		if errors.Is(err, calendarsvc.ErrEventNotFound) {
+			// the customer removed it from the calendar, redelivering the message would not bring it back
+			logger.Info().Str("uid", uid).Msg("updated event was already removed from the calendar")
+			if err := c.removePrayerBuffers(ctx, eventData.CustomerID, uid); err != nil {
+				logger.Err(err).Msg("failed to remove prayer buffer events")
+			}
+			if err := c.setDetectedEventState(ctx, eventData.CustomerID, uid, store.DetectedEventStateCancelled); err != nil {
+				logger.Err(err).Msg("failed to set detected event state")
+			}
+
+			if didNotSendNack := msg.Ack(); didNotSendNack {
+				logger.Err(err).Msg("failed to acknowledge message, cuz Nack was already sent")
+			}
+			return
+		}
+		alertTitle = "✏️ WhatsApp Event Updated"
+		alertBody = fmt.Sprintf("Event '%s' was changed in the chat and updated in your WhatsApp calendar", eventData.Summary)
+	} else {
+		err = c.calendarSvc.AddEvent(ctx, &calendarsvc.AddEventRequest{
+			CustomerID:  eventData.CustomerID,
+			PathSuffix:  whatsAppCalendarPathSuffix,
+			Summary:     eventData.Summary,
+			Description: description,
+			StartTime:   eventData.StartTime,
+			EndTime:     eventData.EndTime,
+			UID:         uid,
+			Tentative:   eventData.Tentative,
+			Location:    eventData.Location,
+			Geo:         mapEventGeo(eventData.Geo),
+			Attendees:   mapEventAttendees(eventData.Attendees),
+			URL:         wasappclient.ChatURL(eventData.ChatID),
+			Source:      whatsAppSourcePrefix + eventData.ChatID,
+			HijriDate:   hijriDate,
+		})
+	}
 	if err != nil {
-		logger.Err(err).Msg("failed to add event to calendar")
+		logger.Err(err).Str("action", string(eventData.Action)).Msg("failed to put event in calendar")
 		if didNotSendAck := msg.Nack(); didNotSendAck {
 			log.Ctx(ctx).Err(err).Msg("failed to Nack the message, cuz Ack already sent")
 		}
 		return
 	}
 
-	logger.Info().Msg("successfully added event to WhatsApp calendar")
+	logger.Info().
+		Str("action", string(eventData.Action)).
+		Bool("tentative", eventData.Tentative).
+		Msg("successfully put event in WhatsApp calendar")
+
+	if eventData.Tentative {
+		// the hold stays tentative when the suggestion changes, it is confirmed by adding it again
+		if eventData.Action != CalendarEventAction_Update {
+			if err := c.setDetectedEventState(ctx, eventData.CustomerID, uid, store.DetectedEventStateTentative); err != nil {
+				logger.Err(err).Msg("failed to set detected event state")
+			}
+		}
+
+		if didNotSendNack := msg.Ack(); didNotSendNack {
+			logger.Err(err).Msg("failed to acknowledge message, cuz Nack was already sent")
+		}
+		return
+	}
+
+	detectedEventState := store.DetectedEventStateAdded
+	if eventData.Action == CalendarEventAction_Update {
+		detectedEventState = store.DetectedEventStateUpdated
+	}
+	if err := c.setDetectedEventState(ctx, eventData.CustomerID, uid, detectedEventState); err != nil {
+		logger.Err(err).Msg("failed to set detected event state")
+	}
+
+	// the buffers of the event's old time are removed before the ones of its new time are added
+	if eventData.Action == CalendarEventAction_Update {
+		if err := c.removePrayerBuffers(ctx, eventData.CustomerID, uid); err != nil {
+			logger.Err(err).Msg("failed to remove prayer buffer events")
+		}
+	}
+	if conflicts.mode == store.PrayerConflictModeBuffer {
+		if err := c.addPrayerBuffers(ctx, eventData, uid, conflicts, hijriDate); err != nil {
+			logger.Err(err).Msg("failed to add prayer buffer events")
+		}
+	}
+	if err := c.warnAboutPrayerConflicts(ctx, eventData, conflicts); err != nil {
+		logger.Err(err).Msg("failed to send prayer conflict notification")
+	}
 
 	// Prepare data for notification
 	calendarName := whatsAppCalendarName
@@ -139,8 +328,8 @@ func (c *consumer) processMessage(ctx context.Context, msg *message.Message) {
 
 	err = c.notificationSvc.SendNotificationToCustomerDevices(ctx, &notificationsvc.SendNotificationToCustomerDevicesRequest{
 		CustomerId: eventData.CustomerID,
-		AlertTitle: "📅 New WhatsApp Event Added",                                                     // Title for the visible alert
-		AlertBody:  fmt.Sprintf("Event '%s' was added to your WhatsApp calendar", eventData.Summary), // Body for the visible alert
+		AlertTitle: alertTitle, // Title for the visible alert
+		AlertBody:  alertBody,  // Body for the visible alert
 		// Pass details for the background notification
 		EventUID:       &uidForNotification,
 		EventTitle:     &eventTitleForNotification,
@@ -161,6 +350,76 @@ func (c *consumer) processMessage(ctx context.Context, msg *message.Message) {
 	}
 }
 
+// setDetectedEventState moves the event detected in a chat along its lifecycle once the calendar reflects it
+func (c *consumer) setDetectedEventState(ctx context.Context, customerID uuid.UUID, uid string, state store.DetectedEventState) error {
+	path, err := c.calendarSvc.GetEventPath(ctx, &calendarsvc.GetEventPathRequest{
+		CustomerID: customerID,
+		PathSuffix: whatsAppCalendarPathSuffix,
+		UID:        uid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed running GetEventPath: %w", err)
+	}
+
+	params := store.SetDetectedEventStateByCaldavUidParams{
+		CustomerID: customerID,
+		CaldavUid:  sql.NullString{String: uid, Valid: true},
+		State:      state,
+	}
+	if state == store.DetectedEventStateAdded || state == store.DetectedEventStateTentative {
+		params.CaldavPath = sql.NullString{String: path, Valid: true}
+	}
+
+	err = c.store.SetDetectedEventStateByCaldavUid(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed running SetDetectedEventStateByCaldavUid: %w", err)
+	}
+	return nil
+}
+
+// annotateHijriDate appends the Hijri date of start to the description in the language the customer picked
+func annotateHijriDate(description string, start time.Time, annotation store.HijriDateAnnotation) string {
+	if annotation != store.HijriDateAnnotationArabic && annotation != store.HijriDateAnnotationEnglish {
+		return description
+	}
+
+	hijriDate, err := hijri.FromGregorian(start)
+	if err != nil {
+		return description
+	}
+
+	line := "Hijri date: " + hijriDate.String()
+	if annotation == store.HijriDateAnnotationArabic {
+		line = "التاريخ الهجري: " + hijriDate.ArabicString()
+	}
+
+	if description == "" {
+		return line
+	}
+	return description + "\n\n" + line
+}
+
+func mapEventGeo(geo *EventGeo) *calendarsvc.Geo {
+	if geo == nil {
+		return nil
+	}
+	return &calendarsvc.Geo{
+		Latitude:  geo.Latitude,
+		Longitude: geo.Longitude,
+	}
+}
+
+func mapEventAttendees(attendees []EventAttendee) []calendarsvc.Attendee {
+	res := make([]calendarsvc.Attendee, len(attendees))
+	for idx, attendee := range attendees {
+		res[idx] = calendarsvc.Attendee{
+			Name:        attendee.Name,
+			PhoneNumber: attendee.PhoneNumber,
+		}
+	}
+	return res
+}
+
 func (c *consumer) Stop(ctx context.Context) error {
 	log.Ctx(ctx).Info().Msg("stopping calendar consumer")
 	// if err := c.channel.Close(); err != nil {
@@ -171,13 +430,14 @@ func (c *consumer) Stop(ctx context.Context) error {
 }
 
 func NewConsumer(subscriber *amqp.Subscriber, calendarEventsQueueName string, store store.Queries, calendarSvc calendarsvc.Svc,
-	calDAVPasswordEncryptionKey string, notificationSvc notificationsvc.Svc) Consumer {
+	encryptionSvc encryptionsvc.Svc, notificationSvc notificationsvc.Svc, prayerSvc prayersvc.Svc) Consumer {
 	return &consumer{
-		subscriber:                  subscriber,
-		calendarEventsQueueName:     calendarEventsQueueName,
-		store:                       store,
-		calendarSvc:                 calendarSvc,
-		calDAVPasswordEncryptionKey: calDAVPasswordEncryptionKey,
-		notificationSvc:             notificationSvc,
+		subscriber:              subscriber,
+		calendarEventsQueueName: calendarEventsQueueName,
+		store:                   store,
+		calendarSvc:             calendarSvc,
+		encryptionSvc:           encryptionSvc,
+		notificationSvc:         notificationSvc,
+		prayerSvc:               prayerSvc,
 	}
 }
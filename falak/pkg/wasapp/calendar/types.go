@@ -7,15 +7,49 @@ import (
 	"github.com/google/uuid"
 )
 
+type CalendarEventAction string
+
+const (
+	CalendarEventAction_Add    CalendarEventAction = "add"
+	CalendarEventAction_Update CalendarEventAction = "update"
+	CalendarEventAction_Cancel CalendarEventAction = "cancel"
+	// CalendarEventAction_Release removes a tentative hold whose event was turned down
+	CalendarEventAction_Release CalendarEventAction = "release"
+)
+
 // CalendarEventData represents a WhatsApp calendar event
 // This is used in both producer and consumer to ensure consistency
 type CalendarEventData struct {
+	// Action is what to do with the event, an empty action means CalendarEventAction_Add
+	Action CalendarEventAction `json:"action"`
+	// UID identifies the event in the calendar, it is generated when adding an event without one
+	UID         string    `json:"uid"`
 	CustomerID  uuid.UUID `json:"customer_id"`
 	ChatID      string    `json:"chat_id"`
 	Summary     string    `json:"summary"`
 	Description string    `json:"description"`
 	StartTime   time.Time `json:"start_time"`
 	EndTime     time.Time `json:"end_time"`
+	// FlexibleTime is true when the chat did not settle on a time, so the event can be moved around
+	FlexibleTime bool `json:"flexible_time"`
+	// Tentative holds the time of an event that was suggested but not agreed on yet, adding the event again with
+	// the same UID and Tentative false confirms it
+	Tentative bool   `json:"tentative"`
+	Location  string `json:"location"`
+	// Geo is set when a maps link with coordinates was shared in the chat
+	Geo *EventGeo `json:"geo"`
+	// Attendees are the other people in the chat
+	Attendees []EventAttendee `json:"attendees"`
+}
+
+type EventGeo struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type EventAttendee struct {
+	Name        string `json:"name"`
+	PhoneNumber string `json:"phone_number"`
 }
 
 // Consumer defines the interface for consuming calendar events
@@ -0,0 +1,109 @@
+package wasappchatexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// MaxTextSize is the largest chat text that is read from an export, a chat exported without its media is far
+// smaller than it even after years of messages
+const MaxTextSize = 32 << 20
+
+var (
+	ErrUnsupportedFile = errors.New("unsupported file, the chat export has to be the .txt or .zip file WhatsApp exports")
+	ErrNoChat          = errors.New("no chat found in the archive")
+	ErrTooLarge        = errors.New("the chat export is too large")
+	ErrNoMessages      = errors.New("no messages found, the file is not a WhatsApp chat export")
+)
+
+var zipMagic = []byte("PK\x03\x04")
+
+// ReadText returns the text of the chat in the file, which is either the .txt WhatsApp exports the chat to or the
+// .zip it is put in along with the chat's media.
+func ReadText(fileName string, content []byte) (string, error) {
+	ext := strings.ToLower(path.Ext(fileName))
+	switch {
+	case bytes.HasPrefix(content, zipMagic):
+		return readZipText(content)
+	case ext == ".txt" || ext == "":
+		if len(content) > MaxTextSize {
+			return "", ErrTooLarge
+		}
+		return cleanText(content), nil
+	default:
+		return "", ErrUnsupportedFile
+	}
+}
+
+func readZipText(content []byte) (string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", fmt.Errorf("failed to open the archive: %w", err)
+	}
+
+	// iOS names the chat "_chat.txt", Android names it after the chat
+	var chatFile *zip.File
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() || strings.HasPrefix(file.Name, "__MACOSX/") || strings.ToLower(path.Ext(file.Name)) != ".txt" {
+			continue
+		}
+		if path.Base(file.Name) == "_chat.txt" {
+			chatFile = file
+			break
+		}
+		if chatFile == nil {
+			chatFile = file
+		}
+	}
+	if chatFile == nil {
+		return "", ErrNoChat
+	}
+
+	reader, err := chatFile.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", chatFile.Name, err)
+	}
+	defer reader.Close()
+
+	// the size in the archive's header can not be trusted, so the text is cut off at the limit
+	text, err := io.ReadAll(io.LimitReader(reader, MaxTextSize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", chatFile.Name, err)
+	}
+	if len(text) > MaxTextSize {
+		return "", ErrTooLarge
+	}
+	return cleanText(text), nil
+}
+
+func cleanText(text []byte) string {
+	text = bytes.TrimPrefix(text, []byte("\ufeff"))
+	return strings.ToValidUTF8(string(text), "")
+}
+
+// chatNamePrefixes are how the exported files are named in the English and Arabic locales, the chat name follows them
+var chatNamePrefixes = []string{
+	"WhatsApp Chat with ",
+	"WhatsApp Chat - ",
+	"دردشة واتساب مع ",
+	"محادثة واتساب مع ",
+	"دردشة WhatsApp مع ",
+	"محادثة WhatsApp مع ",
+}
+
+// ChatName returns the name of the chat the file was exported from, it is empty when the file name does not have it
+func ChatName(fileName string) string {
+	name := path.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	name = strings.TrimSuffix(name, path.Ext(name))
+	for _, prefix := range chatNamePrefixes {
+		if chatName, ok := strings.CutPrefix(name, prefix); ok {
+			return strings.TrimSpace(chatName)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,302 @@
+package wasappchatexport
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Message is a message of an exported chat
+type Message struct {
+	SenderName string
+	Body       string
+	SentAt     time.Time
+}
+
+// headerRegexp matches the date and time a message starts with, after the line went through normalizeLine. Android
+// separates them from the message with a dash, like "31/12/2024, 9:15 pm - Sara: hi", and iOS puts them in
+// brackets, like "[31/12/2024, 9:15:30 PM] Sara: hi". The Arabic locales write the same with Arabic digits, "ص" and
+// "م" for AM and PM, and a few direction marks in between.
+var headerRegexp = regexp.MustCompile(`(?i)^(\[)?(\d{1,4})[/.\-](\d{1,2})[/.\-](\d{1,4}),?\s+(\d{1,2}):(\d{2})(?::(\d{2}))?\s*(am|pm|a\.\s?m\.|p\.\s?m\.|ص|م)?(?:\]\s*|\s+[-–]\s+)`)
+
+// deletedBodies are what deleted messages and omitted media show as in Android exports, iOS starts them with a
+// left-to-right mark instead
+var deletedBodies = map[string]bool{
+	"<Media omitted>":           true,
+	"<الوسائط غير مضمنة>":       true,
+	"<تم استبعاد الوسائط>":      true,
+	"This message was deleted":  true,
+	"You deleted this message":  true,
+	"تم حذف هذه الرسالة":        true,
+	"لقد حذفت هذه الرسالة":      true,
+	"null":                      true,
+	"<View once voice message>": true,
+}
+
+var editedSuffixes = []string{
+	"<This message was edited>",
+	"<تم تعديل هذه الرسالة>",
+}
+
+type dateOrder int
+
+const (
+	dateOrder_DayFirst dateOrder = iota
+	dateOrder_MonthFirst
+	dateOrder_YearFirst
+)
+
+// header is the date and time a message starts with, the date parts are read once the order of the whole chat is known
+type header struct {
+	parts    [3]int
+	hour     int
+	minute   int
+	second   int
+	meridiem string
+	// rest is what follows the header in the line as it was exported
+	rest string
+}
+
+// monthFirstRegions are the regions whose locales put the month before the day in dates
+var monthFirstRegions = map[string]bool{
+	"US": true,
+	"PR": true,
+	"GU": true,
+	"VI": true,
+	"AS": true,
+	"MP": true,
+	"UM": true,
+	"PH": true,
+	"FM": true,
+	"MH": true,
+	"PW": true,
+}
+
+// Parse reads the messages of an exported chat, the times in the export are in the phone's timezone which is taken
+// to be loc. The phone's locale, like "en_SA" or "ar-SA", tells the order of the day and the month when none of the
+// dates do. System messages, omitted media and deleted messages are left out.
+func Parse(text string, loc *time.Location, locale string) ([]Message, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	headers := make([]*header, len(lines))
+	for idx, line := range lines {
+		headers[idx] = parseHeader(line)
+	}
+	order := findDateOrder(headers, locale)
+
+	var msgs []Message
+	// inMessage is false after a line that started a message which is left out, its remaining lines are left out too
+	inMessage := false
+	for idx, line := range lines {
+		var sentAt time.Time
+		ok := false
+		if headers[idx] != nil {
+			sentAt, ok = headers[idx].time(order, loc)
+		}
+		if !ok {
+			if inMessage {
+				msgs[len(msgs)-1].Body += "\n" + stripMarks(line)
+			}
+			continue
+		}
+
+		msg, ok := parseMessage(headers[idx].rest)
+		inMessage = ok
+		if !ok {
+			continue
+		}
+		msg.SentAt = sentAt
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil, ErrNoMessages
+	}
+	for idx := range msgs {
+		msgs[idx].Body = strings.TrimSpace(msgs[idx].Body)
+	}
+	return msgs, nil
+}
+
+func parseHeader(line string) *header {
+	normalized, offsets := normalizeLine(line)
+	match := headerRegexp.FindStringSubmatchIndex(normalized)
+	if match == nil {
+		return nil
+	}
+	group := func(idx int) string {
+		if match[2*idx] == -1 {
+			return ""
+		}
+		return normalized[match[2*idx]:match[2*idx+1]]
+	}
+	number := func(idx int) int {
+		n, _ := strconv.Atoi(group(idx))
+		return n
+	}
+
+	h := &header{
+		parts:    [3]int{number(2), number(3), number(4)},
+		hour:     number(5),
+		minute:   number(6),
+		second:   number(7),
+		meridiem: strings.ToLower(group(8)),
+		rest:     line[offsets[match[1]]:],
+	}
+	if len(group(2)) == 4 {
+		h.parts[0] = -h.parts[0]
+	}
+	return h
+}
+
+// findDateOrder tells how the chat writes its dates from a day or a month that can't be the other, the region of
+// the locale decides when none of the dates tell. The 12-hour clock tells nothing, as it is used with the day first
+// in Saudi Arabia and elsewhere too.
+func findDateOrder(headers []*header, locale string) dateOrder {
+	for _, h := range headers {
+		if h == nil {
+			continue
+		}
+		if h.parts[0] < 0 {
+			return dateOrder_YearFirst
+		}
+		if h.parts[0] > 12 {
+			return dateOrder_DayFirst
+		}
+		if h.parts[1] > 12 {
+			return dateOrder_MonthFirst
+		}
+	}
+	if monthFirstRegions[localeRegion(locale)] {
+		return dateOrder_MonthFirst
+	}
+	return dateOrder_DayFirst
+}
+
+// localeRegion returns the region of a locale like "en_SA", "ar-SA" or "en_US_POSIX", it is empty when the locale
+// has none
+func localeRegion(locale string) string {
+	parts := strings.FieldsFunc(locale, func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+	for idx := 1; idx < len(parts); idx++ {
+		// the script, like "Hant" in "zh-Hant-TW", comes before the region
+		if len(parts[idx]) == 2 {
+			return strings.ToUpper(parts[idx])
+		}
+	}
+	return ""
+}
+
+// time returns when the message was sent, ok is false when the header is not a valid date in the chat's date order
+// which means the line is part of the previous message
+func (h *header) time(order dateOrder, loc *time.Location) (time.Time, bool) {
+	var year, month, day int
+	switch order {
+	case dateOrder_YearFirst:
+		year, month, day = -h.parts[0], h.parts[1], h.parts[2]
+	case dateOrder_MonthFirst:
+		month, day, year = h.parts[0], h.parts[1], h.parts[2]
+	default:
+		day, month, year = h.parts[0], h.parts[1], h.parts[2]
+	}
+	if year < 100 {
+		year += 2000
+	}
+
+	hour := h.hour
+	switch h.meridiem {
+	case "":
+	case "م":
+		hour = hour%12 + 12
+	case "ص":
+		hour = hour % 12
+	default:
+		hour = hour % 12
+		if strings.HasPrefix(h.meridiem, "p") {
+			hour += 12
+		}
+	}
+
+	if year < 0 || month < 1 || month > 12 || day < 1 || hour > 23 || h.minute > 59 || h.second > 59 {
+		return time.Time{}, false
+	}
+	sentAt := time.Date(year, time.Month(month), day, hour, h.minute, h.second, 0, loc)
+	if sentAt.Day() != day {
+		// the day is past the end of the month
+		return time.Time{}, false
+	}
+	return sentAt, true
+}
+
+// parseMessage splits the sender from the body, ok is false for the messages that are left out
+func parseMessage(rest string) (Message, bool) {
+	sender, body, found := strings.Cut(rest, ": ")
+	if !found {
+		// system messages, like someone joining a group, have no sender
+		return Message{}, false
+	}
+	// iOS starts system messages and omitted media with a left-to-right mark
+	if strings.HasPrefix(strings.TrimLeft(body, " "), "\u200e") {
+		return Message{}, false
+	}
+
+	body = stripMarks(body)
+	for _, suffix := range editedSuffixes {
+		body = strings.TrimSpace(strings.TrimSuffix(body, suffix))
+	}
+	if body == "" || deletedBodies[body] {
+		return Message{}, false
+	}
+
+	// iOS puts a tilde before the names of senders who are not in the contacts
+	sender = strings.TrimSpace(strings.TrimPrefix(stripMarks(sender), "~"))
+	if sender == "" {
+		return Message{}, false
+	}
+	return Message{SenderName: sender, Body: body}, true
+}
+
+// normalizeLine makes the Arabic and English headers look the same, it turns Arabic digits into ASCII ones, drops
+// the direction marks and turns the Arabic comma and the special spaces into their plain counterparts. The offsets
+// have the byte in line every byte of the normalized line came from, along with one for the end of line.
+func normalizeLine(line string) (string, []int) {
+	var b strings.Builder
+	offsets := make([]int, 0, len(line)+1)
+	for idx, r := range line {
+		switch {
+		case isMark(r):
+			continue
+		case r >= '٠' && r <= '٩':
+			r = '0' + (r - '٠')
+		case r >= '۰' && r <= '۹':
+			r = '0' + (r - '۰')
+		case r == '،':
+			r = ','
+		case r == '\u00a0' || r == '\u202f':
+			r = ' '
+		}
+		for range utf8.RuneLen(r) {
+			offsets = append(offsets, idx)
+		}
+		b.WriteRune(r)
+	}
+	offsets = append(offsets, len(line))
+	return b.String(), offsets
+}
+
+// isMark tells if r is one of the invisible marks that set the direction of the text
+func isMark(r rune) bool {
+	return r == '\u200e' || r == '\u200f' || r == '\u061c' || (r >= '\u202a' && r <= '\u202e') || (r >= '\u2066' && r <= '\u2069')
+}
+
+func stripMarks(s string) string {
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		if isMark(r) {
+			return -1
+		}
+		return r
+	}, s))
+}
@@ -0,0 +1,127 @@
+package wasappchatexport
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	riyadh, err := time.LoadLocation("Asia/Riyadh")
+	if err != nil {
+		t.Fatalf("failed to load the timezone: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		locale   string
+		expected []Message
+	}{
+		{
+			name: "android en with the 12-hour clock in Saudi Arabia",
+			text: "03/10/2026, 9:15 pm - Messages and calls are end-to-end encrypted.\n" +
+				"03/10/2026, 9:15 pm - Sara: dinner tomorrow?\n" +
+				"03/10/2026, 9:16 pm - Ali: sure\n" +
+				"after isha\n" +
+				"03/10/2026, 9:17 pm - Sara: <Media omitted>\n",
+			locale: "en_SA",
+			expected: []Message{
+				{SenderName: "Sara", Body: "dinner tomorrow?", SentAt: time.Date(2026, 10, 3, 21, 15, 0, 0, riyadh)},
+				{SenderName: "Ali", Body: "sure\nafter isha", SentAt: time.Date(2026, 10, 3, 21, 16, 0, 0, riyadh)},
+			},
+		},
+		{
+			name: "android en in the US",
+			text: "03/10/26, 9:15 AM - Sara: dinner tomorrow?\n" +
+				"03/10/26, 9:16 AM - Ali: This message was deleted\n",
+			locale: "en_US",
+			expected: []Message{
+				{SenderName: "Sara", Body: "dinner tomorrow?", SentAt: time.Date(2026, 3, 10, 9, 15, 0, 0, riyadh)},
+			},
+		},
+		{
+			name: "android en with a day that can only come first",
+			text: "03/10/2026, 9:15 pm - Sara: dinner tomorrow?\n" +
+				"25/10/2026, 9:16 pm - Ali: sure <This message was edited>\n",
+			locale: "en_US",
+			expected: []Message{
+				{SenderName: "Sara", Body: "dinner tomorrow?", SentAt: time.Date(2026, 10, 3, 21, 15, 0, 0, riyadh)},
+				{SenderName: "Ali", Body: "sure", SentAt: time.Date(2026, 10, 25, 21, 16, 0, 0, riyadh)},
+			},
+		},
+		{
+			name: "android ar",
+			text: "‏٠٣‏/١٠‏/٢٠٢٦، ٩:١٥ م - سارة: نتعشى بكرة؟\n" +
+				"‏٠٣‏/١٠‏/٢٠٢٦، ٩:١٦ م - علي: <الوسائط غير مضمنة>\n" +
+				"‏٠٤‏/١٠‏/٢٠٢٦، ٨:٠٥ ص - علي: تمام\n",
+			locale: "ar_SA",
+			expected: []Message{
+				{SenderName: "سارة", Body: "نتعشى بكرة؟", SentAt: time.Date(2026, 10, 3, 21, 15, 0, 0, riyadh)},
+				{SenderName: "علي", Body: "تمام", SentAt: time.Date(2026, 10, 4, 8, 5, 0, 0, riyadh)},
+			},
+		},
+		{
+			name: "ios en with the 12-hour clock in Saudi Arabia",
+			text: "[03/10/2026, 9:15:30 PM] Sara: dinner tomorrow?\n" +
+				"[03/10/2026, 9:16:02 PM] ~ Ali: sure\n" +
+				"[03/10/2026, 9:17:45 PM] Sara: ‎image omitted\n",
+			locale: "en-SA",
+			expected: []Message{
+				{SenderName: "Sara", Body: "dinner tomorrow?", SentAt: time.Date(2026, 10, 3, 21, 15, 30, 0, riyadh)},
+				{SenderName: "Ali", Body: "sure", SentAt: time.Date(2026, 10, 3, 21, 16, 2, 0, riyadh)},
+			},
+		},
+		{
+			name:   "ios en in the US",
+			text:   "[3/10/26, 9:15:30 AM] Sara: dinner tomorrow?\n",
+			locale: "en_US_POSIX",
+			expected: []Message{
+				{SenderName: "Sara", Body: "dinner tomorrow?", SentAt: time.Date(2026, 3, 10, 9, 15, 30, 0, riyadh)},
+			},
+		},
+		{
+			name: "ios ar",
+			text: "[٠٣‏/١٠‏/٢٠٢٦، ٩:١٥:٣٠ م] سارة: نتعشى بكرة؟\n" +
+				"[٠٣‏/١٠‏/٢٠٢٦، ٩:١٦:٠٢ م] علي: ‎تم حذف هذه الرسالة\n" +
+				"[٠٤‏/١٠‏/٢٠٢٦، ١٢:٠٥:٠٠ ص] علي: تمام\n",
+			locale: "ar_SA",
+			expected: []Message{
+				{SenderName: "سارة", Body: "نتعشى بكرة؟", SentAt: time.Date(2026, 10, 3, 21, 15, 30, 0, riyadh)},
+				{SenderName: "علي", Body: "تمام", SentAt: time.Date(2026, 10, 4, 0, 5, 0, 0, riyadh)},
+			},
+		},
+		{
+			name:   "year first without a locale",
+			text:   "2026-10-03, 21:15 - Sara: dinner tomorrow?\n",
+			locale: "",
+			expected: []Message{
+				{SenderName: "Sara", Body: "dinner tomorrow?", SentAt: time.Date(2026, 10, 3, 21, 15, 0, 0, riyadh)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, err := Parse(tt.text, riyadh, tt.locale)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if len(msgs) != len(tt.expected) {
+				t.Fatalf("Parse() got %d messages %+v, expected %d", len(msgs), msgs, len(tt.expected))
+			}
+			for idx, msg := range msgs {
+				expected := tt.expected[idx]
+				if msg.SenderName != expected.SenderName || msg.Body != expected.Body || !msg.SentAt.Equal(expected.SentAt) {
+					t.Errorf("message %d = %+v, expected %+v", idx, msg, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestParseNoMessages(t *testing.T) {
+	_, err := Parse("just some notes\nnot a chat\n", time.UTC, "en_SA")
+	if err != ErrNoMessages {
+		t.Errorf("Parse() error = %v, expected %v", err, ErrNoMessages)
+	}
+}
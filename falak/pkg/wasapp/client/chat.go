@@ -0,0 +1,23 @@
+package wasappclient
+
+import "strings"
+
+const (
+	individualChatIDSuffix = "@c.us"
+	groupChatIDSuffix      = "@g.us"
+)
+
+// IsGroupChat tells if the chat id belongs to a group chat, like "120363000000000000@g.us"
+func IsGroupChat(chatID string) bool {
+	return strings.HasSuffix(chatID, groupChatIDSuffix)
+}
+
+// ChatURL returns a link that opens the chat in WhatsApp. Individual chat ids look like "966500000000@c.us", group
+// chats can't be linked to so an empty string is returned for them.
+func ChatURL(chatID string) string {
+	number, ok := strings.CutSuffix(chatID, individualChatIDSuffix)
+	if !ok || number == "" {
+		return ""
+	}
+	return "https://wa.me/" + number
+}
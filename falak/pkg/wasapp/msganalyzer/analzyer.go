@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -14,55 +15,178 @@ import (
 type analyzer struct {
 	llmCli    *openai.Client
 	modelName string
+	// structuredOutputs is set for providers that can be given the JSON schema of the response
+	structuredOutputs bool
+	promptRollout     PromptRollout
 }
 
 func (a *analyzer) AnalyzeMessages(ctx context.Context, r *AnalyzeMessagesRequest) (*AnalyzeMessagesResponse, error) {
 	logger := log.Ctx(ctx)
-	now := time.Now()
+	now := r.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	// the date and time the LLM is given are the customer's, so "tomorrow" is the same day the rules analyzer and the
+	// prayer anchors take it for
+	if r.Timezone != nil {
+		now = now.In(r.Timezone)
+	}
 
-	msgs := []openai.ChatCompletionMessageParamUnion{
-		openai.SystemMessage(analyzeMessagePrompt),
-		openai.UserMessage(CreateDateTag(now.Format("2006-01-02")) + "\n" + CreateTimeTag(now.Format("15:04")) + "\n" + CreateMessagesTag(r.Messages)),
+	promptVersion := a.promptRollout.version(r.CustomerID)
+	systemPrompt, err := renderAnalyzePrompt(promptVersion, analyzePromptData{
+		IsGroup: r.IsGroup,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	params := openai.ChatCompletionNewParams{
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage(systemPrompt),
+			openai.UserMessage(CreateDateTag(now.Format("2006-01-02")) + "\n" + CreateTimeTag(now.Format("15:04")) + "\n" + CreateEventsTag(r.Events) + "\n" + CreateSummaryTag(r.Summary) + "\n" + CreateMessagesTag(r.Messages)),
+		},
+		Model: a.modelName,
+	}
+	if a.structuredOutputs {
+		params.ResponseFormat = openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONSchema: &openai.ResponseFormatJSONSchemaParam{
+				JSONSchema: openai.ResponseFormatJSONSchemaJSONSchemaParam{
+					Name:   "analyze_messages_response",
+					Strict: openai.Bool(true),
+					Schema: analyzeMessagesResponseSchema,
+				},
+			},
+		}
 	}
 
 	logger.Debug().
 		Str("model", a.modelName).
+		Str("prompt_version", promptVersion).
+		Bool("structured_outputs", a.structuredOutputs).
 		Msg("analyzing messages with LLM")
 
-	chatResp, err := a.llmCli.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
-		Messages: msgs,
-		Model:    a.modelName,
-	})
+	body, usage, err := a.complete(ctx, params)
 	if err != nil {
-		logger.Error().Err(err).Msg("failed to analyze messages with LLM")
-		return nil, errors.New("failed to analyze messages")
+		return nil, &RequestError{
+			Model:         a.modelName,
+			PromptVersion: promptVersion,
+			Usage:         usage,
+			Err:           err,
+		}
 	}
 
-	body := chatResp.Choices[0].Message.Content
-	body = strings.TrimPrefix(body, "```")
-	body = strings.TrimPrefix(body, "json")
-	body = strings.TrimSuffix(body, "```")
-
-	var resp AnalyzeMessagesResponse
-	if err := json.Unmarshal([]byte(body), &resp); err != nil {
-		logger.Error().
+	resp, err := parseAnalyzeMessagesResponse(body, now)
+	if err != nil {
+		logger.Warn().
 			Err(err).
 			Str("body", body).
-			Msg("failed to parse LLM response as JSON")
-		return nil, errors.New("failed to parse the response of messages analysis")
+			Msg("invalid analysis response, asking the LLM to repair it")
+
+		params.Messages = append(params.Messages,
+			openai.AssistantMessage(body),
+			openai.UserMessage(fmt.Sprintf(repairResponsePrompt, err)),
+		)
+		var repairUsage Usage
+		body, repairUsage, err = a.complete(ctx, params)
+		if err != nil {
+			return nil, &RequestError{
+				Model:         a.modelName,
+				PromptVersion: promptVersion,
+				Usage:         usage.add(repairUsage),
+				Err:           err,
+			}
+		}
+		usage = usage.add(repairUsage)
+
+		resp, err = parseAnalyzeMessagesResponse(body, now)
+		if err != nil {
+			logger.Error().
+				Err(err).
+				Str("body", body).
+				Msg("repaired analysis response is still invalid")
+			return nil, &InvalidResponseError{
+				Backend:       Backend_LLM,
+				Model:         a.modelName,
+				PromptVersion: promptVersion,
+				Raw:           body,
+				Usage:         usage,
+				Err:           err,
+			}
+		}
+	}
+
+	if r.IsGroup {
+		for idx := range resp.Events {
+			analyzedStatus := resp.Events[idx].Status
+			applyAgreementRule(&resp.Events[idx], r)
+			logger.Debug().
+				Str("analyzed_status", string(analyzedStatus)).
+				Str("status", string(resp.Events[idx].Status)).
+				Str("agreement_rule", string(r.AgreementRule)).
+				Interface("participants", resp.Events[idx].Participants).
+				Msg("applied group agreement rule")
+		}
 	}
 
+	resp.Backend = Backend_LLM
+	resp.Model = a.modelName
+	resp.PromptVersion = promptVersion
+	resp.Raw = body
+	resp.Usage = usage
+
 	logger.Info().
-		Str("status", string(resp.Status)).
-		Interface("event", resp.Event).
+		Int("events_count", len(resp.Events)).
+		Interface("events", resp.Events).
 		Msg("successfully analyzed messages")
 
+	return resp, nil
+}
+
+// complete returns the content of the LLM response, with the Markdown fences some models wrap JSON in removed, and
+// the tokens it cost
+func (a *analyzer) complete(ctx context.Context, params openai.ChatCompletionNewParams) (string, Usage, error) {
+	chatResp, err := a.llmCli.Chat.Completions.New(ctx, params)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("failed to analyze messages with LLM")
+		return "", Usage{}, errors.New("failed to analyze messages")
+	}
+	usage := Usage{
+		PromptTokens:     chatResp.Usage.PromptTokens,
+		CompletionTokens: chatResp.Usage.CompletionTokens,
+	}
+	if len(chatResp.Choices) == 0 {
+		log.Ctx(ctx).Error().Msg("LLM responded without choices")
+		return "", usage, errors.New("failed to analyze messages")
+	}
+
+	body := strings.TrimSpace(chatResp.Choices[0].Message.Content)
+	body = strings.TrimPrefix(body, "```")
+	body = strings.TrimPrefix(body, "json")
+	body = strings.TrimSuffix(body, "```")
+	return body, usage, nil
+}
+
+// parseAnalyzeMessagesResponse parses and validates the analysis response, unknown keys are rejected as they mean
+// the response does not follow the schema.
+func parseAnalyzeMessagesResponse(body string, now time.Time) (*AnalyzeMessagesResponse, error) {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.DisallowUnknownFields()
+
+	var resp AnalyzeMessagesResponse
+	if err := decoder.Decode(&resp); err != nil {
+		return nil, fmt.Errorf("the response is not valid JSON of the expected shape: %w", err)
+	}
+	if err := validateAnalyzeMessagesResponse(&resp, now); err != nil {
+		return nil, err
+	}
 	return &resp, nil
 }
 
-func NewAnalyzer(llmCli *openai.Client, modelName string) Analyzer {
+func NewAnalyzer(llmCli *openai.Client, modelName string, structuredOutputs bool, promptRollout PromptRollout) Analyzer {
 	return &analyzer{
-		llmCli:    llmCli,
-		modelName: modelName,
+		llmCli:            llmCli,
+		modelName:         modelName,
+		structuredOutputs: structuredOutputs,
+		promptRollout:     promptRollout,
 	}
 }
@@ -0,0 +1,44 @@
+package wasappmsganalyzer
+
+import "fmt"
+
+// InvalidResponseError is returned when the LLM response is not a valid analysis even after it was asked to repair
+// it. Unlike failing to reach the LLM, analyzing the same messages again is not going to help.
+type InvalidResponseError struct {
+	// Backend, Model, PromptVersion and Raw tell where the invalid response came from, Raw being the last response of
+	// the LLM
+	Backend       Backend
+	Model         string
+	PromptVersion string
+	Raw           string
+	// Usage is what the invalid responses cost, as they are paid for all the same
+	Usage Usage
+	Err   error
+}
+
+func (e *InvalidResponseError) Error() string {
+	return fmt.Sprintf("invalid analysis response: %v", e.Err)
+}
+
+func (e *InvalidResponseError) Unwrap() error {
+	return e.Err
+}
+
+// RequestError is returned when the LLM could not be asked to analyze the messages, like when it is down or takes too
+// long, so analyzing them again later may work.
+type RequestError struct {
+	// Model and PromptVersion tell what the messages were going to be analyzed with
+	Model         string
+	PromptVersion string
+	// Usage is what the responses that did come back cost, it is zero when the LLM was not reached
+	Usage Usage
+	Err   error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("analysis request failed: %v", e.Err)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,65 @@
+package wasappmsganalyzer
+
+import "strings"
+
+// applyAgreementRule decides whether the event suggested in a group chat is agreed on for the customer, as people in
+// the group may agree to an event the customer is not going to. Only suggested events that were not called off are
+// looked at.
+func applyAgreementRule(analyzedEvent *AnalyzedEvent, r *AnalyzeMessagesRequest) {
+	if analyzedEvent.Status != AnalyzeMessagesStatus_HasEventAgreed && analyzedEvent.Status != AnalyzeMessagesStatus_HasEventButNotConfirmed {
+		return
+	}
+
+	customerResponse := participantResponse(analyzedEvent.Participants, MeParticipantName)
+	switch {
+	case customerResponse == ParticipantResponse_Declined:
+		analyzedEvent.Status = AnalyzeMessagesStatus_HasEventDenied
+	case isAgreedOn(analyzedEvent, r, customerResponse):
+		analyzedEvent.Status = AnalyzeMessagesStatus_HasEventAgreed
+	default:
+		// the event may be on for the others, but not for the customer yet
+		analyzedEvent.Status = AnalyzeMessagesStatus_HasEventButNotConfirmed
+	}
+}
+
+func isAgreedOn(analyzedEvent *AnalyzedEvent, r *AnalyzeMessagesRequest, customerResponse ParticipantResponse) bool {
+	organizerConfirmed := analyzedEvent.Organizer != nil && analyzedEvent.OrganizerConfirmed
+	isCustomerOrganizer := analyzedEvent.Organizer != nil && strings.EqualFold(*analyzedEvent.Organizer, MeParticipantName)
+
+	switch r.AgreementRule {
+	case AgreementRule_Majority:
+		agreedCount := 0
+		for _, participant := range analyzedEvent.Participants {
+			if participant.Response == ParticipantResponse_Agreed {
+				agreedCount++
+			}
+		}
+		return agreedCount*2 > countSenders(r.Messages)
+	case AgreementRule_Organizer:
+		return organizerConfirmed
+	default:
+		return customerResponse == ParticipantResponse_Agreed || (isCustomerOrganizer && organizerConfirmed)
+	}
+}
+
+func participantResponse(participants []Participant, name string) ParticipantResponse {
+	for _, participant := range participants {
+		if strings.EqualFold(participant.Name, name) {
+			return participant.Response
+		}
+	}
+	return ""
+}
+
+// countSenders returns how many people sent the messages, people in the group who never talked are not known
+func countSenders(messages []MessageForAnalysis) int {
+	senders := map[string]bool{}
+	for _, msg := range messages {
+		if msg.IsSenderMe {
+			senders[MeParticipantName] = true
+			continue
+		}
+		senders[msg.SenderName] = true
+	}
+	return len(senders)
+}
@@ -0,0 +1,371 @@
+package wasappmsganalyzer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RedactionKind is a kind of personal data that is kept from the LLM
+type RedactionKind string
+
+const (
+	// RedactionKind_Names and RedactionKind_Phones are replaced with pseudonyms that are swapped back in the results
+	RedactionKind_Names  RedactionKind = "names"
+	RedactionKind_Phones RedactionKind = "phones"
+	// the other kinds are masked, they are not needed to find events so they are never swapped back
+	RedactionKind_Emails      RedactionKind = "emails"
+	RedactionKind_Ibans       RedactionKind = "ibans"
+	RedactionKind_NationalIDs RedactionKind = "national_ids"
+	RedactionKind_Cards       RedactionKind = "cards"
+)
+
+var redactionKinds = []RedactionKind{
+	RedactionKind_Names,
+	RedactionKind_Phones,
+	RedactionKind_Emails,
+	RedactionKind_Ibans,
+	RedactionKind_NationalIDs,
+	RedactionKind_Cards,
+}
+
+// Redaction is the set of kinds that are redacted
+type Redaction map[RedactionKind]bool
+
+// ParseRedaction reads a comma separated list of kinds, like "names,phones". Everything is redacted when s is empty or
+// "all", and nothing when it is "none".
+func ParseRedaction(s string) (Redaction, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	redaction := Redaction{}
+	switch s {
+	case "none":
+		return redaction, nil
+	case "", "all":
+		for _, kind := range redactionKinds {
+			redaction[kind] = true
+		}
+		return redaction, nil
+	}
+
+	for _, name := range strings.Split(s, ",") {
+		kind := RedactionKind(strings.TrimSpace(name))
+		if !slices.Contains(redactionKinds, kind) {
+			return nil, fmt.Errorf("unknown redaction kind: %s", kind)
+		}
+		redaction[kind] = true
+	}
+	return redaction, nil
+}
+
+// digits are matched in Western and Arabic forms, as both are used in the chats
+const digit = `[0-9٠-٩]`
+
+var (
+	emailRegex = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	ibanRegex  = regexp.MustCompile(`\b[A-Z]{2}[0-9]{2}(?: ?[A-Z0-9]{4}){2,7}(?: ?[A-Z0-9]{1,4})?\b`)
+	cardRegex  = regexp.MustCompile(digit + `(?:[ -]?` + digit + `){12,18}`)
+	// nationalIDRegex matches Saudi national and iqama numbers, which are 10 digits starting with 1 or 2
+	nationalIDRegex = regexp.MustCompile(`(?:^|[^0-9٠-٩])([12١٢]` + digit + `{9})(?:$|[^0-9٠-٩])`)
+	// phoneRegex matches numbers in international form or starting with a 0, so dates and times are left alone
+	phoneRegex = regexp.MustCompile(`(?:\+|00|٠٠)` + digit + `(?:[ -]?` + digit + `){7,13}|[0٠]` + digit + `(?:[ -]?` + digit + `){7,10}`)
+)
+
+// pseudonyms redacts one request and remembers the pseudonyms it gave, so they can be swapped back in the response
+type pseudonyms struct {
+	redaction Redaction
+	// names has the pseudonyms of the sender names, they are also replaced when mentioned in the messages
+	names   map[string]string
+	byValue map[string]string
+	byToken map[string]string
+	counts  map[string]int
+}
+
+func newPseudonyms(redaction Redaction) *pseudonyms {
+	return &pseudonyms{
+		redaction: redaction,
+		names:     map[string]string{},
+		byValue:   map[string]string{},
+		byToken:   map[string]string{},
+		counts:    map[string]int{},
+	}
+}
+
+func (p *pseudonyms) pseudonym(prefix, value string) string {
+	if token, ok := p.byValue[prefix+value]; ok {
+		return token
+	}
+
+	p.counts[prefix]++
+	token := fmt.Sprintf("[%s_%d]", prefix, p.counts[prefix])
+	p.byValue[prefix+value] = token
+	p.byToken[token] = value
+	return token
+}
+
+// addNames gives the senders their pseudonyms before any text is redacted, so their names are replaced wherever they
+// are mentioned
+func (p *pseudonyms) addNames(msgs []MessageForAnalysis) {
+	if !p.redaction[RedactionKind_Names] {
+		return
+	}
+
+	for _, msg := range msgs {
+		if !msg.IsSenderMe && strings.TrimSpace(msg.SenderName) != "" {
+			p.names[msg.SenderName] = p.pseudonym("PERSON", msg.SenderName)
+		}
+		if quoted := msg.QuotedMessage; quoted != nil && !quoted.IsSenderMe && strings.TrimSpace(quoted.SenderName) != "" {
+			p.names[quoted.SenderName] = p.pseudonym("PERSON", quoted.SenderName)
+		}
+	}
+}
+
+func (p *pseudonyms) name(name string) string {
+	if token, ok := p.names[name]; ok {
+		return token
+	}
+	return name
+}
+
+func (p *pseudonyms) text(text string) string {
+	if p.redaction[RedactionKind_Emails] {
+		text = emailRegex.ReplaceAllString(text, "[EMAIL]")
+	}
+	if p.redaction[RedactionKind_Ibans] {
+		text = ibanRegex.ReplaceAllString(text, "[IBAN]")
+	}
+	if p.redaction[RedactionKind_Cards] {
+		text = cardRegex.ReplaceAllStringFunc(text, func(match string) string {
+			if isLuhnValid(match) {
+				return "[CARD]"
+			}
+			return match
+		})
+	}
+	if p.redaction[RedactionKind_NationalIDs] {
+		text = replaceSubmatch(nationalIDRegex, text, func(string) string {
+			return "[NATIONAL_ID]"
+		})
+	}
+	if p.redaction[RedactionKind_Phones] {
+		text = phoneRegex.ReplaceAllStringFunc(text, func(match string) string {
+			return p.pseudonym("PHONE", match)
+		})
+	}
+
+	// the longer names go first, so a name is not replaced inside a longer one
+	names := make([]string, 0, len(p.names))
+	for name := range p.names {
+		names = append(names, name)
+	}
+	slices.SortFunc(names, func(a, b string) int {
+		return utf8.RuneCountInString(b) - utf8.RuneCountInString(a)
+	})
+	for _, name := range names {
+		text = replaceWord(text, name, p.names[name])
+	}
+	return text
+}
+
+// restore swaps the pseudonyms in text back to what they stand for
+func (p *pseudonyms) restore(text string) string {
+	if len(p.byToken) == 0 {
+		return text
+	}
+
+	// the longer tokens go first, so [PERSON_1] is not restored inside [PERSON_12]
+	tokens := make([]string, 0, len(p.byToken))
+	for token := range p.byToken {
+		tokens = append(tokens, token)
+	}
+	slices.SortFunc(tokens, func(a, b string) int {
+		return len(b) - len(a)
+	})
+	for _, token := range tokens {
+		text = strings.ReplaceAll(text, token, p.byToken[token])
+	}
+	return text
+}
+
+func (p *pseudonyms) restorePtr(text *string) *string {
+	if text == nil {
+		return nil
+	}
+	restored := p.restore(*text)
+	return &restored
+}
+
+func (p *pseudonyms) message(msg MessageForAnalysis) MessageForAnalysis {
+	msg.SenderName = p.name(msg.SenderName)
+	msg.Body = p.text(msg.Body)
+	if msg.QuotedMessage != nil {
+		quoted := *msg.QuotedMessage
+		quoted.SenderName = p.name(quoted.SenderName)
+		quoted.Body = p.text(quoted.Body)
+		msg.QuotedMessage = &quoted
+	}
+	return msg
+}
+
+func (p *pseudonyms) messages(msgs []MessageForAnalysis) []MessageForAnalysis {
+	redacted := make([]MessageForAnalysis, len(msgs))
+	for idx, msg := range msgs {
+		redacted[idx] = p.message(msg)
+	}
+	return redacted
+}
+
+// replaceWord replaces word in text only where it is not a part of a longer word
+func replaceWord(text, word, replacement string) string {
+	var b strings.Builder
+	for {
+		idx := strings.Index(text, word)
+		if idx == -1 {
+			b.WriteString(text)
+			return b.String()
+		}
+
+		before, _ := utf8.DecodeLastRuneInString(text[:idx])
+		after, _ := utf8.DecodeRuneInString(text[idx+len(word):])
+		b.WriteString(text[:idx])
+		if isWordRune(before) || isWordRune(after) {
+			b.WriteString(word)
+		} else {
+			b.WriteString(replacement)
+		}
+		text = text[idx+len(word):]
+	}
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// replaceSubmatch replaces the first group of every match, for patterns that match what surrounds the value too
+func replaceSubmatch(re *regexp.Regexp, text string, replace func(string) string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+		b.WriteString(text[last:loc[2]])
+		b.WriteString(replace(text[loc[2]:loc[3]]))
+		last = loc[3]
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+func isLuhnValid(number string) bool {
+	var digits []int
+	for _, r := range number {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, int(r-'0'))
+		case r >= '٠' && r <= '٩':
+			digits = append(digits, int(r-'٠'))
+		}
+	}
+
+	sum := 0
+	for idx := range digits {
+		d := digits[len(digits)-1-idx]
+		if idx%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// redactingAnalyzer keeps personal data in the messages from the analyzer it wraps, the pseudonyms in the events it
+// finds are swapped back before they are returned
+type redactingAnalyzer struct {
+	next      Analyzer
+	redaction Redaction
+}
+
+func (a *redactingAnalyzer) AnalyzeMessages(ctx context.Context, r *AnalyzeMessagesRequest) (*AnalyzeMessagesResponse, error) {
+	p := newPseudonyms(a.redaction)
+	p.addNames(r.Messages)
+
+	redacted := *r
+	redacted.Messages = p.messages(r.Messages)
+	redacted.Summary = p.text(r.Summary)
+	redacted.Events = make([]KnownEvent, len(r.Events))
+	for idx, event := range r.Events {
+		event.Title = p.text(event.Title)
+		redacted.Events[idx] = event
+	}
+
+	resp, err := a.next.AnalyzeMessages(ctx, &redacted)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range resp.Events {
+		analyzedEvent := &resp.Events[idx]
+		analyzedEvent.Organizer = p.restorePtr(analyzedEvent.Organizer)
+		for participantIdx := range analyzedEvent.Participants {
+			analyzedEvent.Participants[participantIdx].Name = p.restore(analyzedEvent.Participants[participantIdx].Name)
+		}
+		if event := analyzedEvent.Event; event != nil {
+			event.Title = p.restorePtr(event.Title)
+			event.Location = p.restorePtr(event.Location)
+			event.Notes = p.restorePtr(event.Notes)
+		}
+	}
+	// Raw is left as the model answered it, so what was sent out stays redacted where it is stored
+	return resp, nil
+}
+
+// redactingSummarizer keeps personal data in the messages from the summarizer it wraps, the summary is stored with the
+// pseudonyms swapped back so it can be redacted again with the next messages
+type redactingSummarizer struct {
+	next      Summarizer
+	redaction Redaction
+}
+
+func (s *redactingSummarizer) SummarizeMessages(ctx context.Context, r *SummarizeMessagesRequest) (*SummarizeMessagesResponse, error) {
+	p := newPseudonyms(s.redaction)
+	p.addNames(r.Messages)
+
+	resp, err := s.next.SummarizeMessages(ctx, &SummarizeMessagesRequest{
+		CustomerID: r.CustomerID,
+		Summary:    p.text(r.Summary),
+		Messages:   p.messages(r.Messages),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Summary = p.restore(resp.Summary)
+	return resp, nil
+}
+
+// NewRedactingAnalyzer wraps an analyzer that sends the messages out, next is returned as is when nothing is redacted
+func NewRedactingAnalyzer(next Analyzer, redaction Redaction) Analyzer {
+	if len(redaction) == 0 {
+		return next
+	}
+	return &redactingAnalyzer{
+		next:      next,
+		redaction: redaction,
+	}
+}
+
+// NewRedactingSummarizer wraps a summarizer that sends the messages out, next is returned as is when nothing is
+// redacted
+func NewRedactingSummarizer(next Summarizer, redaction Redaction) Summarizer {
+	if len(redaction) == 0 {
+		return next
+	}
+	return &redactingSummarizer{
+		next:      next,
+		redaction: redaction,
+	}
+}
@@ -0,0 +1,203 @@
+package wasappmsganalyzer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Backend names an analyzer, it is stored with every analysis so it is known which analyzer produced it
+type Backend string
+
+const (
+	Backend_LLM   Backend = "llm"
+	Backend_Rules Backend = "rules"
+)
+
+// DefaultBackends tries the LLM and falls back to the rules, which do not fail
+var DefaultBackends = []Backend{Backend_LLM, Backend_Rules}
+
+const defaultBackendTimeout = 30 * time.Second
+
+// AnalysisOutcome is how a backend did with analyzing the messages
+type AnalysisOutcome struct {
+	// CustomerID is whose messages were analyzed
+	CustomerID    uuid.UUID
+	Backend       Backend
+	Model         string
+	PromptVersion string
+	// Usage is the tokens the backend used, failed analyses included, and Latency is how long it took
+	Usage   Usage
+	Latency time.Duration
+	// Err is nil when the backend analyzed the messages
+	Err error
+}
+
+// SummaryOutcome is how summarizing the older messages of a chat went
+type SummaryOutcome struct {
+	// CustomerID is whose messages were summarized
+	CustomerID uuid.UUID
+	Model      string
+	Usage      Usage
+	Latency    time.Duration
+	// Err is nil when the messages were summarized
+	Err error
+}
+
+// Recorder is told the outcome of every backend a chain tries, including the failures a later backend made up for,
+// and of every summary a recording summarizer writes
+type Recorder interface {
+	RecordAnalysis(ctx context.Context, outcome AnalysisOutcome)
+	RecordSummary(ctx context.Context, outcome SummaryOutcome)
+}
+
+// Registry holds the analyzers that can be picked by name from the config
+type Registry struct {
+	analyzers map[Backend]Analyzer
+	recorder  Recorder
+}
+
+func (r *Registry) Register(backend Backend, analyzer Analyzer) {
+	r.analyzers[backend] = analyzer
+}
+
+// SetRecorder makes the chains created afterwards tell recorder how every backend did
+func (r *Registry) SetRecorder(recorder Recorder) {
+	r.recorder = recorder
+}
+
+// Chain returns an analyzer that tries the backends in order, moving on to the next one when a backend fails or
+// takes longer than timeout, defaultBackendTimeout is used when timeout is not positive. The error of the last backend
+// is returned when all of them fail.
+func (r *Registry) Chain(backends []Backend, timeout time.Duration) (Analyzer, error) {
+	if len(backends) == 0 {
+		return nil, errors.New("no analyzer backends were given")
+	}
+
+	links := make([]chainLink, len(backends))
+	for idx, backend := range backends {
+		analyzer, ok := r.analyzers[backend]
+		if !ok {
+			return nil, fmt.Errorf("unknown analyzer backend: %s", backend)
+		}
+		links[idx] = chainLink{
+			backend:  backend,
+			analyzer: analyzer,
+		}
+	}
+
+	if timeout <= 0 {
+		timeout = defaultBackendTimeout
+	}
+
+	return &chainAnalyzer{
+		links:    links,
+		timeout:  timeout,
+		recorder: r.recorder,
+	}, nil
+}
+
+type chainLink struct {
+	backend  Backend
+	analyzer Analyzer
+}
+
+type chainAnalyzer struct {
+	links    []chainLink
+	timeout  time.Duration
+	recorder Recorder
+}
+
+func (a *chainAnalyzer) AnalyzeMessages(ctx context.Context, r *AnalyzeMessagesRequest) (*AnalyzeMessagesResponse, error) {
+	var err error
+	for idx, link := range a.links {
+		var resp *AnalyzeMessagesResponse
+		startedAt := time.Now()
+		resp, err = a.analyze(ctx, link, r)
+		if a.recorder != nil {
+			outcome := analysisOutcome(link.backend, resp, err)
+			outcome.CustomerID = r.CustomerID
+			outcome.Latency = time.Since(startedAt)
+			a.recorder.RecordAnalysis(ctx, outcome)
+		}
+		if err == nil {
+			return resp, nil
+		}
+
+		if idx < len(a.links)-1 {
+			log.Ctx(ctx).Warn().Err(err).
+				Str("backend", string(link.backend)).
+				Str("next_backend", string(a.links[idx+1].backend)).
+				Msg("analyzer backend failed, falling back to the next one")
+		}
+	}
+	return nil, err
+}
+
+func (a *chainAnalyzer) analyze(ctx context.Context, link chainLink, r *AnalyzeMessagesRequest) (*AnalyzeMessagesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	resp, err := link.analyzer.AnalyzeMessages(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Backend == "" {
+		resp.Backend = link.backend
+	}
+	return resp, nil
+}
+
+func analysisOutcome(backend Backend, resp *AnalyzeMessagesResponse, err error) AnalysisOutcome {
+	if err == nil {
+		return AnalysisOutcome{
+			Backend:       resp.Backend,
+			Model:         resp.Model,
+			PromptVersion: resp.PromptVersion,
+			Usage:         resp.Usage,
+		}
+	}
+
+	outcome := AnalysisOutcome{
+		Backend: backend,
+		Err:     err,
+	}
+	var requestErr *RequestError
+	var invalidResponseErr *InvalidResponseError
+	switch {
+	case errors.As(err, &requestErr):
+		outcome.Model, outcome.PromptVersion = requestErr.Model, requestErr.PromptVersion
+		outcome.Usage = requestErr.Usage
+	case errors.As(err, &invalidResponseErr):
+		outcome.Model, outcome.PromptVersion = invalidResponseErr.Model, invalidResponseErr.PromptVersion
+		outcome.Usage = invalidResponseErr.Usage
+	}
+	return outcome
+}
+
+// ParseBackends reads a comma separated list of backends, like "llm,rules", DefaultBackends is returned when it has
+// none
+func ParseBackends(s string) []Backend {
+	var backends []Backend
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			backends = append(backends, Backend(strings.ToLower(name)))
+		}
+	}
+	if len(backends) == 0 {
+		return DefaultBackends
+	}
+	return backends
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		analyzers: map[Backend]Analyzer{},
+	}
+}
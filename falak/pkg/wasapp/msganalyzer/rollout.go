@@ -0,0 +1,134 @@
+package wasappmsganalyzer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/google/uuid"
+)
+
+// the analyze prompts are kept as prompts/analyze-messages-v<version>.tmpl, a prompt that changes is added as a new
+// version next to the old one so both can be rolled out at once and every result traced back to its prompt
+//
+//go:embed prompts
+var promptsFS embed.FS
+
+var analyzePromptFileRegex = regexp.MustCompile(`^analyze-messages-v(\d+)\.tmpl$`)
+
+// analyzePrompts has the analyze prompt templates by version, latestPromptVersion is the one rolled out when no
+// rollout is configured
+var analyzePrompts, latestPromptVersion = loadAnalyzePrompts()
+
+type analyzePromptData struct {
+	IsGroup bool
+}
+
+func loadAnalyzePrompts() (map[string]*template.Template, string) {
+	entries, err := fs.ReadDir(promptsFS, "prompts")
+	if err != nil {
+		panic(fmt.Sprintf("failed to read the embedded prompts: %v", err))
+	}
+
+	prompts := map[string]*template.Template{}
+	latestVersion := 0
+	for _, entry := range entries {
+		match := analyzePromptFileRegex.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+
+		prompt := template.Must(template.ParseFS(promptsFS, "prompts/"+entry.Name()))
+		prompts[match[1]] = prompt
+		if version, _ := strconv.Atoi(match[1]); version > latestVersion {
+			latestVersion = version
+		}
+	}
+	if len(prompts) == 0 {
+		panic("no analyze prompts are embedded")
+	}
+	return prompts, strconv.Itoa(latestVersion)
+}
+
+func renderAnalyzePrompt(version string, data analyzePromptData) (string, error) {
+	prompt, ok := analyzePrompts[version]
+	if !ok {
+		return "", fmt.Errorf("unknown prompt version: %s", version)
+	}
+
+	var b bytes.Buffer
+	if err := prompt.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render prompt version %s: %w", version, err)
+	}
+	return strings.TrimRight(b.String(), "\n"), nil
+}
+
+// PromptRollout splits the customers between the prompt versions, a customer always gets the same version as long as
+// the rollout does not change
+type PromptRollout []PromptShare
+
+type PromptShare struct {
+	Version string
+	// Percent is the share of the customers that get Version, the shares of a rollout add up to 100
+	Percent int
+}
+
+// version picks the prompt version of the customer by hashing their id into one of 100 buckets
+func (r PromptRollout) version(customerID uuid.UUID) string {
+	hash := fnv.New32a()
+	hash.Write(customerID[:])
+	bucket := int(hash.Sum32() % 100)
+
+	for _, share := range r {
+		if bucket < share.Percent {
+			return share.Version
+		}
+		bucket -= share.Percent
+	}
+	return r[len(r)-1].Version
+}
+
+// ParsePromptRollout reads a rollout like "5:90,6:10", which gives version 5 to 90% of the customers and version 6 to
+// the rest. Everyone gets the latest version when s is empty.
+func ParsePromptRollout(s string) (PromptRollout, error) {
+	if strings.TrimSpace(s) == "" {
+		return PromptRollout{{Version: latestPromptVersion, Percent: 100}}, nil
+	}
+
+	var rollout PromptRollout
+	total := 0
+	for _, part := range strings.Split(s, ",") {
+		version, percentValue, ok := strings.Cut(strings.TrimSpace(part), ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid prompt share %q, it must look like version:percent", part)
+		}
+		if _, ok := analyzePrompts[version]; !ok {
+			return nil, fmt.Errorf("unknown prompt version: %s", version)
+		}
+		if slices.ContainsFunc(rollout, func(share PromptShare) bool { return share.Version == version }) {
+			return nil, fmt.Errorf("prompt version %s is given more than once", version)
+		}
+
+		percent, err := strconv.Atoi(percentValue)
+		if err != nil || percent < 0 {
+			return nil, fmt.Errorf("invalid percent %q for prompt version %s", percentValue, version)
+		}
+		total += percent
+
+		rollout = append(rollout, PromptShare{
+			Version: version,
+			Percent: percent,
+		})
+	}
+	if total != 100 {
+		return nil, fmt.Errorf("the prompt shares add up to %d%% instead of 100%%", total)
+	}
+	return rollout, nil
+}
@@ -0,0 +1,433 @@
+package wasappmsganalyzer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// rulesVersion must be bumped whenever the rules change, it is stored as the prompt version of their results
+const rulesVersion = "rules-1"
+
+const (
+	rulesModelName = "rules"
+	maxTitleLength = 60
+)
+
+var (
+	isoDateRegex   = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	shortDateRegex = regexp.MustCompile(`^(\d{1,2})/(\d{1,2})(?:/(\d{2}|\d{4}))?$`)
+	clockRegex     = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+	hourRegex      = regexp.MustCompile(`^(\d{1,2})(am|pm)?$`)
+)
+
+// rulesAnalyzer finds events with fixed Arabic and English phrases, it only understands simple chats but needs
+// nothing but the messages, so it can stand in when the LLM is not available.
+type rulesAnalyzer struct{}
+
+func (a *rulesAnalyzer) AnalyzeMessages(ctx context.Context, r *AnalyzeMessagesRequest) (*AnalyzeMessagesResponse, error) {
+	loc := r.Timezone
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	msgs := make([]ruleMessage, len(r.Messages))
+	for idx, msg := range r.Messages {
+		msgs[idx] = newRuleMessage(msg, loc)
+	}
+
+	resp := &AnalyzeMessagesResponse{
+		Events: []AnalyzedEvent{},
+	}
+	if analyzedEvent := analyzeRuleMessages(msgs, r.Events, r.IsGroup); analyzedEvent != nil {
+		resp.Events = append(resp.Events, *analyzedEvent)
+	}
+
+	if r.IsGroup {
+		for idx := range resp.Events {
+			applyAgreementRule(&resp.Events[idx], r)
+		}
+	}
+
+	raw, err := json.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the rules analysis: %w", err)
+	}
+	resp.Model = rulesModelName
+	resp.PromptVersion = rulesVersion
+	resp.Backend = Backend_Rules
+	resp.Raw = string(raw)
+
+	log.Ctx(ctx).Info().
+		Int("events_count", len(resp.Events)).
+		Interface("events", resp.Events).
+		Msg("successfully analyzed messages with rules")
+
+	return resp, nil
+}
+
+type ruleMessage struct {
+	body string
+	// text is the normalized body
+	text   string
+	sender string
+	sentAt time.Time
+	// when is nil for messages that do not say when something happens
+	when     *eventWhen
+	response ParticipantResponse
+	cancels  bool
+}
+
+func newRuleMessage(msg MessageForAnalysis, loc *time.Location) ruleMessage {
+	sender := msg.SenderName
+	if msg.IsSenderMe {
+		sender = MeParticipantName
+	}
+
+	text := normalizeText(msg.Body)
+	sentAt := time.Unix(msg.Timestamp, 0).In(loc)
+	return ruleMessage{
+		body:     msg.Body,
+		text:     text,
+		sender:   sender,
+		sentAt:   sentAt,
+		when:     findEventWhen(text, sentAt),
+		response: findResponse(text, msg.Body),
+		cancels:  containsPhrase(text, cancellationPhrases),
+	}
+}
+
+// analyzeRuleMessages looks at the latest suggestion in the messages and how the others answered it, only one event
+// is found at a time.
+func analyzeRuleMessages(msgs []ruleMessage, knownEvents []KnownEvent, isGroup bool) *AnalyzedEvent {
+	suggestionIdx := findSuggestion(msgs)
+
+	cancellationIdx := -1
+	for idx := len(msgs) - 1; idx > suggestionIdx; idx-- {
+		if msgs[idx].cancels && msgs[idx].when == nil {
+			cancellationIdx = idx
+			break
+		}
+	}
+	if cancellationIdx != -1 && len(knownEvents) == 1 {
+		return &AnalyzedEvent{
+			Status:   AnalyzeMessagesStatus_EventCancelled,
+			EventUID: &knownEvents[0].UID,
+		}
+	}
+	if suggestionIdx == -1 {
+		return nil
+	}
+
+	suggestion := msgs[suggestionIdx]
+	when := *suggestion.when
+	analyzedEvent := &AnalyzedEvent{}
+	var lastResponse ParticipantResponse
+	for _, msg := range msgs[suggestionIdx+1:] {
+		if msg.when != nil && msg.response != ParticipantResponse_Declined {
+			// later messages can settle the details, like "ok, make it 10"
+			when.merge(*msg.when)
+		}
+		if msg.response == "" {
+			continue
+		}
+
+		if msg.sender == suggestion.sender {
+			analyzedEvent.OrganizerConfirmed = analyzedEvent.OrganizerConfirmed || msg.response == ParticipantResponse_Agreed
+			continue
+		}
+		lastResponse = msg.response
+		analyzedEvent.Participants = slices.DeleteFunc(analyzedEvent.Participants, func(participant Participant) bool {
+			return participant.Name == msg.sender
+		})
+		analyzedEvent.Participants = append(analyzedEvent.Participants, Participant{
+			Name:     msg.sender,
+			Response: msg.response,
+		})
+	}
+
+	switch {
+	case cancellationIdx != -1:
+		analyzedEvent.Status = AnalyzeMessagesStatus_HasEventDenied
+	case isGroup:
+		analyzedEvent.Status = groupRuleStatus(analyzedEvent.Participants)
+	case lastResponse == ParticipantResponse_Agreed:
+		analyzedEvent.Status = AnalyzeMessagesStatus_HasEventAgreed
+	case lastResponse == ParticipantResponse_Declined:
+		analyzedEvent.Status = AnalyzeMessagesStatus_HasEventDenied
+	default:
+		analyzedEvent.Status = AnalyzeMessagesStatus_HasEventButNotConfirmed
+	}
+
+	if isGroup {
+		analyzedEvent.Organizer = &suggestion.sender
+	} else {
+		analyzedEvent.Participants = nil
+		analyzedEvent.OrganizerConfirmed = false
+	}
+
+	analyzedEvent.Event = when.toEvent(suggestion)
+	return analyzedEvent
+}
+
+// findSuggestion returns the index of the latest message that says when something happens without answering an
+// earlier one, or -1 when there is none.
+func findSuggestion(msgs []ruleMessage) int {
+	for idx := len(msgs) - 1; idx >= 0; idx-- {
+		if msgs[idx].when != nil && msgs[idx].response == "" {
+			return idx
+		}
+	}
+	// an answer can say when as well, like "ok, tomorrow at 9", but one turning it down is not a suggestion
+	for idx := len(msgs) - 1; idx >= 0; idx-- {
+		if msgs[idx].when != nil && msgs[idx].response != ParticipantResponse_Declined {
+			return idx
+		}
+	}
+	return -1
+}
+
+func groupRuleStatus(participants []Participant) AnalyzeMessagesStatus {
+	if len(participants) == 0 {
+		return AnalyzeMessagesStatus_HasEventButNotConfirmed
+	}
+
+	for _, participant := range participants {
+		if participant.Response == ParticipantResponse_Agreed {
+			return AnalyzeMessagesStatus_HasEventAgreed
+		}
+	}
+	return AnalyzeMessagesStatus_HasEventDenied
+}
+
+func findResponse(text, body string) ParticipantResponse {
+	switch {
+	case containsPhrase(text, noProblemPhrases):
+		return ParticipantResponse_Agreed
+	case containsPhrase(text, denialPhrases):
+		return ParticipantResponse_Declined
+	case containsPhrase(text, agreementPhrases):
+		return ParticipantResponse_Agreed
+	}
+
+	for _, emoji := range agreementEmojis {
+		if strings.Contains(body, emoji) {
+			return ParticipantResponse_Agreed
+		}
+	}
+	return ""
+}
+
+// eventWhen is what a message says about when something happens, the day is resolved from the time the message
+// was sent.
+type eventWhen struct {
+	day    *time.Time
+	clock  *time.Duration
+	anchor *PrayerAnchor
+	sentAt time.Time
+}
+
+func (w *eventWhen) merge(other eventWhen) {
+	if other.day != nil {
+		w.day = other.day
+	}
+	if other.clock != nil {
+		w.clock = other.clock
+		w.anchor = nil
+	}
+	if other.anchor != nil {
+		w.anchor = other.anchor
+		w.clock = nil
+	}
+}
+
+func (w eventWhen) toEvent(suggestion ruleMessage) *AnalyzeMessagesEvent {
+	day := dayOf(w.sentAt)
+	if w.day != nil {
+		day = *w.day
+	} else if w.clock != nil && day.Add(*w.clock).Before(w.sentAt) {
+		// "at 9" said at 10 means the next day
+		day = day.AddDate(0, 0, 1)
+	}
+
+	title := ruleEventTitle(suggestion.body)
+	notes := suggestion.body + "\n\nManaged by Jadwal"
+	startDate := day.Format("2006-01-02")
+	event := &AnalyzeMessagesEvent{
+		Title:             &title,
+		StartDate:         &startDate,
+		StartPrayerAnchor: w.anchor,
+		Notes:             &notes,
+	}
+	if w.clock != nil {
+		startTime := day.Add(*w.clock).Format("15:04")
+		event.StartTime = &startTime
+	}
+	return event
+}
+
+func findEventWhen(text string, sentAt time.Time) *eventWhen {
+	words := strings.Fields(text)
+	when := &eventWhen{
+		day:    findDay(text, words, sentAt),
+		clock:  findClock(words),
+		anchor: findPrayerAnchor(words),
+		sentAt: sentAt,
+	}
+	if when.day == nil && when.clock == nil && when.anchor == nil {
+		return nil
+	}
+	return when
+}
+
+func findDay(text string, words []string, sentAt time.Time) *time.Time {
+	today := dayOf(sentAt)
+
+	for _, relativeDay := range relativeDayPhrases {
+		if containsPhrase(text, []string{relativeDay.phrase}) {
+			day := today.AddDate(0, 0, relativeDay.days)
+			return &day
+		}
+	}
+
+	for idx, word := range words {
+		weekday, ok := weekdayWords[word]
+		if !ok {
+			// "والخميس" and "بالخميس" are the same day with a letter in front
+			weekday, ok = weekdayWords[strings.TrimLeft(word, "وب")]
+		}
+		if !ok {
+			continue
+		}
+
+		isNextWeek := (idx > 0 && slices.Contains(nextWeekWords, words[idx-1])) ||
+			(idx+1 < len(words) && slices.Contains(nextWeekWords, words[idx+1]))
+		daysAhead := (int(weekday) - int(today.Weekday()) + 7) % 7
+		if isNextWeek && daysAhead == 0 {
+			daysAhead = 7
+		}
+		day := today.AddDate(0, 0, daysAhead)
+		return &day
+	}
+
+	for _, word := range words {
+		if isoDateRegex.MatchString(word) {
+			day, err := time.ParseInLocation("2006-01-02", word, sentAt.Location())
+			if err == nil {
+				return &day
+			}
+		}
+
+		if match := shortDateRegex.FindStringSubmatch(word); match != nil {
+			dayOfMonth, _ := strconv.Atoi(match[1])
+			month, _ := strconv.Atoi(match[2])
+			year := today.Year()
+			if match[3] != "" {
+				year, _ = strconv.Atoi(match[3])
+				if year < 100 {
+					year += 2000
+				}
+			}
+			if dayOfMonth < 1 || dayOfMonth > 31 || month < 1 || month > 12 {
+				continue
+			}
+
+			day := time.Date(year, time.Month(month), dayOfMonth, 0, 0, 0, 0, sentAt.Location())
+			if match[3] == "" && day.Before(today) {
+				day = day.AddDate(1, 0, 0)
+			}
+			return &day
+		}
+	}
+
+	return nil
+}
+
+// findClock returns the time of day the words mention, hours from 1 to 7 without am or pm are taken as the evening
+// as that is when people meet far more often than before dawn.
+func findClock(words []string) *time.Duration {
+	for idx, word := range words {
+		hour, minute := -1, 0
+		period := ""
+		if idx+1 < len(words) {
+			period = words[idx+1]
+		}
+
+		if match := clockRegex.FindStringSubmatch(word); match != nil {
+			hour, _ = strconv.Atoi(match[1])
+			minute, _ = strconv.Atoi(match[2])
+		} else if match := hourRegex.FindStringSubmatch(word); match != nil {
+			hasPeriod := match[2] != "" || slices.Contains(amWords, period) || slices.Contains(pmWords, period)
+			hasAtWord := idx > 0 && slices.Contains(atWords, words[idx-1])
+			if !hasPeriod && !hasAtWord {
+				continue
+			}
+			hour, _ = strconv.Atoi(match[1])
+			if match[2] != "" {
+				period = match[2]
+			}
+		}
+		if hour < 0 || hour > 23 || minute > 59 {
+			continue
+		}
+
+		switch {
+		case slices.Contains(pmWords, period) && hour < 12:
+			hour += 12
+		case slices.Contains(amWords, period) && hour == 12:
+			hour = 0
+		case !slices.Contains(amWords, period) && hour >= 1 && hour <= 7:
+			hour += 12
+		}
+
+		clock := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute
+		return &clock
+	}
+	return nil
+}
+
+func findPrayerAnchor(words []string) *PrayerAnchor {
+	for idx, word := range words[:max(len(words)-1, 0)] {
+		relation, ok := anchorRelationWords[word]
+		if !ok {
+			continue
+		}
+
+		prayer, ok := prayerWords[words[idx+1]]
+		if !ok {
+			prayer, ok = prayerWords["ال"+words[idx+1]]
+		}
+		if !ok {
+			continue
+		}
+
+		return &PrayerAnchor{
+			Prayer:   prayer,
+			Relation: relation,
+		}
+	}
+	return nil
+}
+
+func ruleEventTitle(body string) string {
+	title := strings.TrimSpace(strings.SplitN(body, "\n", 2)[0])
+	if runes := []rune(title); len(runes) > maxTitleLength {
+		title = string(runes[:maxTitleLength]) + "…"
+	}
+	return title
+}
+
+func dayOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func NewRulesAnalyzer() Analyzer {
+	return &rulesAnalyzer{}
+}
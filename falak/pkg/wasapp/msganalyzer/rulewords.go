@@ -0,0 +1,144 @@
+package wasappmsganalyzer
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// the phrases are written normalized, see normalizeText, and matched against whole words only
+
+var agreementPhrases = normalizePhrases(
+	"تم", "تمام", "اوكي", "اوك", "اكيد", "موافق", "ابشر", "يلا", "مناسب", "ان شاء الله", "انشاء الله", "انشالله",
+	"ok", "okay", "sure", "yes", "yeah", "yep", "deal", "done", "agreed", "see you", "sounds good", "works for me",
+	"count me in",
+)
+
+// noProblemPhrases agree even though they have denial words in them, so they are looked for first
+var noProblemPhrases = normalizePhrases(
+	"لا مشكله", "ما في مشكله", "مافي مشكله", "ما عندي مانع", "no problem", "no worries",
+)
+
+var denialPhrases = normalizePhrases(
+	"لا", "ما اقدر", "ماقدر", "ما يمديني", "مايمديني", "ما امداني", "مشغول", "مشغوله", "اعتذر", "معليش",
+	"no", "nope", "cant", "can t", "cannot", "busy", "sorry", "not coming", "won t make it",
+)
+
+var cancellationPhrases = normalizePhrases(
+	"الغينا", "نلغي", "انلغت", "انلغي", "ملغي", "ملغيه", "تكنسل", "كنسلنا",
+	"cancel", "cancelled", "canceled", "called off",
+)
+
+// agreementEmojis are matched against the message as it is, as they are not words
+var agreementEmojis = []string{"👍", "👌", "✅"}
+
+var relativeDayPhrases = []struct {
+	phrase string
+	days   int
+}{
+	// the longer phrases come first so "day after tomorrow" is not read as "tomorrow"
+	{normalizeText("بعد بكره"), 2},
+	{normalizeText("بعد بكرا"), 2},
+	{normalizeText("day after tomorrow"), 2},
+	{normalizeText("اليوم"), 0},
+	{normalizeText("الليله"), 0},
+	{normalizeText("today"), 0},
+	{normalizeText("tonight"), 0},
+	{normalizeText("بكره"), 1},
+	{normalizeText("بكرا"), 1},
+	{normalizeText("باكر"), 1},
+	{normalizeText("غدا"), 1},
+	{normalizeText("tomorrow"), 1},
+}
+
+var weekdayWords = map[string]time.Weekday{
+	normalizeText("الاحد"):    time.Sunday,
+	normalizeText("الاثنين"):  time.Monday,
+	normalizeText("الثلاثاء"): time.Tuesday,
+	normalizeText("الاربعاء"): time.Wednesday,
+	normalizeText("الخميس"):   time.Thursday,
+	normalizeText("الجمعه"):   time.Friday,
+	normalizeText("السبت"):    time.Saturday,
+	"sunday":                  time.Sunday,
+	"monday":                  time.Monday,
+	"tuesday":                 time.Tuesday,
+	"wednesday":               time.Wednesday,
+	"thursday":                time.Thursday,
+	"friday":                  time.Friday,
+	"saturday":                time.Saturday,
+}
+
+// nextWeekWords follow or precede a weekday to mean the one in the coming week, like "next Tuesday" or "الخميس الجاي"
+var nextWeekWords = normalizePhrases("next", "الجاي", "الجايه", "القادم", "القادمه")
+
+var amWords = normalizePhrases("am", "ص", "صباحا", "الصبح", "الصباح", "الفجر")
+
+var pmWords = normalizePhrases("pm", "م", "مساء", "المسا", "المساء", "بالليل", "الليل", "العصر", "بالعصر")
+
+// atWords come before an hour given without minutes, like "at 9" or "الساعه 9"
+var atWords = normalizePhrases("at", "الساعه", "ساعه")
+
+var anchorRelationWords = map[string]PrayerAnchorRelation{
+	normalizeText("بعد"): PrayerAnchorRelation_After,
+	normalizeText("قبل"): PrayerAnchorRelation_Before,
+	"after":              PrayerAnchorRelation_After,
+	"before":             PrayerAnchorRelation_Before,
+}
+
+var prayerWords = map[string]string{
+	normalizeText("الفجر"):  "fajr",
+	normalizeText("الظهر"):  "dhuhr",
+	normalizeText("العصر"):  "asr",
+	normalizeText("المغرب"): "maghrib",
+	normalizeText("العشاء"): "isha",
+	"fajr":                  "fajr",
+	"dhuhr":                 "dhuhr",
+	"zuhr":                  "dhuhr",
+	"asr":                   "asr",
+	"maghrib":               "maghrib",
+	"isha":                  "isha",
+}
+
+// normalizeText lowercases the text, turns Arabic digits into Western ones and unifies the Arabic letters people
+// write interchangeably, then returns its words separated by single spaces.
+func normalizeText(text string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r >= '٠' && r <= '٩':
+			r = '0' + (r - '٠')
+		case r == 'أ' || r == 'إ' || r == 'آ':
+			r = 'ا'
+		case r == 'ى':
+			r = 'ي'
+		case r == 'ة':
+			r = 'ه'
+		case r == 'ء' || unicode.Is(unicode.Mn, r):
+			// hamza on its own and the diacritics are left out
+			continue
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != ':' && r != '/' && r != '-':
+			r = ' '
+		}
+		b.WriteRune(r)
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func normalizePhrases(phrases ...string) []string {
+	normalized := make([]string, len(phrases))
+	for idx, phrase := range phrases {
+		normalized[idx] = normalizeText(phrase)
+	}
+	return normalized
+}
+
+// containsPhrase tells if the normalized text has any of the phrases as whole words
+func containsPhrase(text string, phrases []string) bool {
+	padded := " " + text + " "
+	for _, phrase := range phrases {
+		if strings.Contains(padded, " "+phrase+" ") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package wasappmsganalyzer
+
+// analyzeMessagesResponseSchema is the JSON schema of the analysis response, it is sent to providers that support
+// structured outputs. Strict mode needs every key to be required, so the optional ones are nullable instead.
+var analyzeMessagesResponseSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"events": map[string]any{
+			"type":  "array",
+			"items": analyzedEventSchema,
+		},
+	},
+	"required":             []string{"events"},
+	"additionalProperties": false,
+}
+
+var analyzedEventSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"status": map[string]any{
+			"type": "string",
+			"enum": []AnalyzeMessagesStatus{
+				AnalyzeMessagesStatus_HasEventButNotConfirmed,
+				AnalyzeMessagesStatus_HasEventAgreed,
+				AnalyzeMessagesStatus_HasEventDenied,
+				AnalyzeMessagesStatus_EventUpdated,
+				AnalyzeMessagesStatus_EventCancelled,
+			},
+		},
+		"event": map[string]any{
+			"anyOf": []any{eventSchema, map[string]any{"type": "null"}},
+		},
+		"event_uid": nullableSchema("string"),
+		"participants": map[string]any{
+			"type": []string{"array", "null"},
+			"items": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{"type": "string"},
+					"response": map[string]any{
+						"type": "string",
+						"enum": []ParticipantResponse{ParticipantResponse_Agreed, ParticipantResponse_Declined},
+					},
+				},
+				"required":             []string{"name", "response"},
+				"additionalProperties": false,
+			},
+		},
+		"organizer":           nullableSchema("string"),
+		"organizer_confirmed": map[string]any{"type": "boolean"},
+	},
+	"required":             []string{"status", "event", "event_uid", "participants", "organizer", "organizer_confirmed"},
+	"additionalProperties": false,
+}
+
+var eventSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"title":               nullableSchema("string"),
+		"start_date":          nullableSchema("string"),
+		"end_date":            nullableSchema("string"),
+		"start_time":          nullableSchema("string"),
+		"end_time":            nullableSchema("string"),
+		"start_prayer_anchor": prayerAnchorSchema,
+		"end_prayer_anchor":   prayerAnchorSchema,
+		"location":            nullableSchema("string"),
+		"notes":               nullableSchema("string"),
+	},
+	"required":             []string{"title", "start_date", "end_date", "start_time", "end_time", "start_prayer_anchor", "end_prayer_anchor", "location", "notes"},
+	"additionalProperties": false,
+}
+
+var prayerAnchorSchema = map[string]any{
+	"anyOf": []any{
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"prayer": map[string]any{
+					"type": "string",
+					"enum": anchorPrayers,
+				},
+				"relation": map[string]any{
+					"type": "string",
+					"enum": []PrayerAnchorRelation{PrayerAnchorRelation_Before, PrayerAnchorRelation_After},
+				},
+				"offset_minutes": nullableSchema("integer"),
+			},
+			"required":             []string{"prayer", "relation", "offset_minutes"},
+			"additionalProperties": false,
+		},
+		map[string]any{"type": "null"},
+	},
+}
+
+func nullableSchema(schemaType string) map[string]any {
+	return map[string]any{"type": []string{schemaType, "null"}}
+}
@@ -0,0 +1,96 @@
+package wasappmsganalyzer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/openai/openai-go"
+	"github.com/rs/zerolog/log"
+)
+
+type summarizer struct {
+	llmCli    *openai.Client
+	modelName string
+}
+
+func (s *summarizer) SummarizeMessages(ctx context.Context, r *SummarizeMessagesRequest) (*SummarizeMessagesResponse, error) {
+	logger := log.Ctx(ctx)
+
+	msgs := []openai.ChatCompletionMessageParamUnion{
+		openai.SystemMessage(summarizeMessagesPrompt),
+		openai.UserMessage(CreateSummaryTag(r.Summary) + "\n" + CreateMessagesTag(r.Messages)),
+	}
+
+	logger.Debug().
+		Str("model", s.modelName).
+		Int("messages_count", len(r.Messages)).
+		Msg("summarizing messages with LLM")
+
+	chatResp, err := s.llmCli.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
+		Messages: msgs,
+		Model:    s.modelName,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to summarize messages with LLM")
+		return nil, errors.New("failed to summarize messages")
+	}
+	if len(chatResp.Choices) == 0 {
+		logger.Error().Msg("LLM responded without choices")
+		return nil, errors.New("failed to summarize messages")
+	}
+
+	summary := strings.TrimSpace(chatResp.Choices[0].Message.Content)
+	if summary == "" {
+		logger.Error().Msg("LLM responded with an empty summary")
+		return nil, errors.New("failed to summarize messages")
+	}
+
+	return &SummarizeMessagesResponse{
+		Summary: summary,
+		Model:   s.modelName,
+		Usage: Usage{
+			PromptTokens:     chatResp.Usage.PromptTokens,
+			CompletionTokens: chatResp.Usage.CompletionTokens,
+		},
+	}, nil
+}
+
+func NewSummarizer(llmCli *openai.Client, modelName string) Summarizer {
+	return &summarizer{
+		llmCli:    llmCli,
+		modelName: modelName,
+	}
+}
+
+// recordingSummarizer tells the recorder how every summary of the summarizer it wraps went, so the tokens it used
+// are added to the customer's usage like the ones of the analysis
+type recordingSummarizer struct {
+	next     Summarizer
+	recorder Recorder
+}
+
+func (s *recordingSummarizer) SummarizeMessages(ctx context.Context, r *SummarizeMessagesRequest) (*SummarizeMessagesResponse, error) {
+	startedAt := time.Now()
+	resp, err := s.next.SummarizeMessages(ctx, r)
+
+	outcome := SummaryOutcome{
+		CustomerID: r.CustomerID,
+		Latency:    time.Since(startedAt),
+		Err:        err,
+	}
+	if err == nil {
+		outcome.Model, outcome.Usage = resp.Model, resp.Usage
+	}
+	s.recorder.RecordSummary(ctx, outcome)
+
+	return resp, err
+}
+
+func NewRecordingSummarizer(next Summarizer, recorder Recorder) Summarizer {
+	return &recordingSummarizer{
+		next:     next,
+		recorder: recorder,
+	}
+}
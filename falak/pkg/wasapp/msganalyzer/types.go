@@ -1,17 +1,70 @@
 package wasappmsganalyzer
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type MessageForAnalysis struct {
 	SenderName string
+	// IsSenderMe is true for the messages the customer sent, the customer is the one the events are added for
+	IsSenderMe bool
 	Body       string
 	Timestamp  int64
+	// QuotedMessage is the message this one replies to, it is nil when the message is not a reply
+	QuotedMessage *QuotedMessage
+}
+
+// QuotedMessage is a message that was replied to, it may be long gone from the analyzed messages
+type QuotedMessage struct {
+	SenderName string
+	IsSenderMe bool
+	Body       string
+	Timestamp  int64
+}
+
+// KnownEvent is an event that was already added to the calendar from the same chat and has not passed yet
+type KnownEvent struct {
+	UID   string
+	Title string
+	Start time.Time
+	End   time.Time
 }
 
 type AnalyzeMessagesRequest struct {
-	Messages []MessageForAnalysis
+	// CustomerID is whose messages they are, it decides the prompt version the customer gets
+	CustomerID uuid.UUID
+	Messages   []MessageForAnalysis
+	// Summary is what was said in the chat before Messages, it is empty when none of the older messages were summarized
+	Summary string
+	// Events are the events the chat already produced, so changes to them can be told apart from new events
+	Events []KnownEvent
+	// IsGroup is true when the messages come from a group chat, AgreementRule then decides when an event counts as
+	// agreed on for the customer
+	IsGroup       bool
+	AgreementRule AgreementRule
+	// Timezone is where the customer is, the date and time of the analysis are read in it. The analyzers that resolve
+	// dates themselves take it as UTC when nil
+	Timezone *time.Location
+	// Now is the time the messages are analyzed at, it is only set to replay an analysis and is time.Now() when zero
+	Now time.Time
 }
 
+// AgreementRule decides when an event suggested in a group chat is agreed on for the customer, it is never agreed on
+// when the customer declined it.
+type AgreementRule string
+
+const (
+	// AgreementRule_Customer needs the customer to agree, it is used when no rule is given
+	AgreementRule_Customer AgreementRule = "customer"
+	// AgreementRule_Majority needs more than half of the people talking in the chat to agree
+	AgreementRule_Majority AgreementRule = "majority"
+	// AgreementRule_Organizer needs the person organizing the event to confirm it
+	AgreementRule_Organizer AgreementRule = "organizer"
+)
+
 type AnalyzeMessagesStatus string
 
 const (
@@ -19,23 +72,112 @@ const (
 	AnalyzeMessagesStatus_HasEventButNotConfirmed AnalyzeMessagesStatus = "HAS_EVENT_BUT_NOT_CONFIRMED"
 	AnalyzeMessagesStatus_HasEventAgreed          AnalyzeMessagesStatus = "HAS_EVENT_AGREED"
 	AnalyzeMessagesStatus_HasEventDenied          AnalyzeMessagesStatus = "HAS_EVENT_DENIED"
+	AnalyzeMessagesStatus_EventUpdated            AnalyzeMessagesStatus = "EVENT_UPDATED"
+	AnalyzeMessagesStatus_EventCancelled          AnalyzeMessagesStatus = "EVENT_CANCELLED"
 )
 
+type PrayerAnchorRelation string
+
+const (
+	PrayerAnchorRelation_Before PrayerAnchorRelation = "before"
+	PrayerAnchorRelation_After  PrayerAnchorRelation = "after"
+)
+
+// PrayerAnchor is a time given relative to a prayer, like "after Isha" or "30 minutes before Maghrib"
+type PrayerAnchor struct {
+	Prayer   string               `json:"prayer"`
+	Relation PrayerAnchorRelation `json:"relation"`
+	// OffsetMinutes is nil when no offset was mentioned
+	OffsetMinutes *int `json:"offset_minutes"`
+}
+
 type AnalyzeMessagesEvent struct {
-	Title     *string `json:"title"`
-	StartDate *string `json:"start_date"`
-	EndDate   *string `json:"end_date"`
-	StartTime *string `json:"start_time"`
-	EndTime   *string `json:"end_time"`
-	Location  *string `json:"location"`
-	Notes     *string `json:"notes"`
+	Title             *string       `json:"title"`
+	StartDate         *string       `json:"start_date"`
+	EndDate           *string       `json:"end_date"`
+	StartTime         *string       `json:"start_time"`
+	EndTime           *string       `json:"end_time"`
+	StartPrayerAnchor *PrayerAnchor `json:"start_prayer_anchor"`
+	EndPrayerAnchor   *PrayerAnchor `json:"end_prayer_anchor"`
+	Location          *string       `json:"location"`
+	Notes             *string       `json:"notes"`
 }
 
-type AnalyzeMessagesResponse struct {
+type ParticipantResponse string
+
+const (
+	ParticipantResponse_Agreed   ParticipantResponse = "agreed"
+	ParticipantResponse_Declined ParticipantResponse = "declined"
+)
+
+// Participant is someone in a group chat who answered the suggested event
+type Participant struct {
+	// Name is the sender name used in the messages, or MeParticipantName for the customer
+	Name     string              `json:"name"`
+	Response ParticipantResponse `json:"response"`
+}
+
+// AnalyzedEvent is one of the events found in the messages along with how far the chat got with it
+type AnalyzedEvent struct {
 	Status AnalyzeMessagesStatus `json:"status"`
 	Event  *AnalyzeMessagesEvent `json:"event"`
+	// EventUID is the UID of the known event that was updated or cancelled, it is only set with
+	// AnalyzeMessagesStatus_EventUpdated and AnalyzeMessagesStatus_EventCancelled
+	EventUID *string `json:"event_uid"`
+
+	// Participants, Organizer and OrganizerConfirmed are only set for group chats
+	Participants       []Participant `json:"participants"`
+	Organizer          *string       `json:"organizer"`
+	OrganizerConfirmed bool          `json:"organizer_confirmed"`
+}
+
+type AnalyzeMessagesResponse struct {
+	// Events has an item for every event the messages talk about, it is empty when they have none
+	Events []AnalyzedEvent `json:"events"`
+
+	// Backend, Model, PromptVersion and Raw tell where the analysis came from, Raw being the JSON the model answered with
+	Backend       Backend `json:"-"`
+	Model         string  `json:"-"`
+	PromptVersion string  `json:"-"`
+	Raw           string  `json:"-"`
+	// Usage is what the analysis cost in tokens, it is zero for the backends that do not use an LLM
+	Usage Usage `json:"-"`
+}
+
+// Usage counts the tokens the LLM was sent and answered with
+type Usage struct {
+	PromptTokens     int64
+	CompletionTokens int64
+}
+
+func (u Usage) add(other Usage) Usage {
+	return Usage{
+		PromptTokens:     u.PromptTokens + other.PromptTokens,
+		CompletionTokens: u.CompletionTokens + other.CompletionTokens,
+	}
 }
 
 type Analyzer interface {
 	AnalyzeMessages(ctx context.Context, r *AnalyzeMessagesRequest) (*AnalyzeMessagesResponse, error)
 }
+
+type SummarizeMessagesRequest struct {
+	// CustomerID is whose messages are summarized
+	CustomerID uuid.UUID
+	// Summary is the summary the messages are added to, it is empty for the first summary of a chat
+	Summary  string
+	Messages []MessageForAnalysis
+}
+
+type SummarizeMessagesResponse struct {
+	Summary string
+	// Model is what the summary was written with and Usage is what it cost in tokens
+	Model string
+	Usage Usage
+}
+
+// Summarizer folds the older messages of a chat into a short summary, so they stay known to the analysis without
+// sending all of them every time.
+type Summarizer interface {
+	SummarizeMessages(ctx context.Context, r *SummarizeMessagesRequest) (*SummarizeMessagesResponse, error)
+}
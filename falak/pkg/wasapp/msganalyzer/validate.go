@@ -0,0 +1,148 @@
+package wasappmsganalyzer
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/hijri"
+)
+
+var anchorPrayers = []string{"fajr", "dhuhr", "asr", "maghrib", "isha"}
+
+// validateAnalyzeMessagesResponse checks the analysis response has everything the consumer relies on, all the
+// problems found are returned together so the LLM can fix them at once.
+func validateAnalyzeMessagesResponse(resp *AnalyzeMessagesResponse, now time.Time) error {
+	if resp.Events == nil {
+		return errors.New(`"events" is missing`)
+	}
+
+	var errs []error
+	for idx, analyzedEvent := range resp.Events {
+		if err := validateAnalyzedEvent(analyzedEvent, now); err != nil {
+			errs = append(errs, fmt.Errorf("events[%d]: %w", idx, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateAnalyzedEvent(analyzedEvent AnalyzedEvent, now time.Time) error {
+	var errs []error
+
+	switch analyzedEvent.Status {
+	case AnalyzeMessagesStatus_HasEventAgreed, AnalyzeMessagesStatus_EventUpdated:
+		if analyzedEvent.Event == nil {
+			errs = append(errs, fmt.Errorf(`"event" is required with the status %s`, analyzedEvent.Status))
+		}
+	case AnalyzeMessagesStatus_HasEventButNotConfirmed, AnalyzeMessagesStatus_HasEventDenied, AnalyzeMessagesStatus_EventCancelled:
+	default:
+		errs = append(errs, fmt.Errorf(`"status" has the unknown value %q`, analyzedEvent.Status))
+	}
+
+	isChange := analyzedEvent.Status == AnalyzeMessagesStatus_EventUpdated || analyzedEvent.Status == AnalyzeMessagesStatus_EventCancelled
+	if analyzedEvent.EventUID != nil && !isChange {
+		errs = append(errs, fmt.Errorf(`"event_uid" must be null with the status %s`, analyzedEvent.Status))
+	}
+
+	if analyzedEvent.Event != nil {
+		if err := validateEvent(*analyzedEvent.Event, now); err != nil {
+			errs = append(errs, fmt.Errorf(`"event": %w`, err))
+		}
+	}
+
+	for idx, participant := range analyzedEvent.Participants {
+		if participant.Response != ParticipantResponse_Agreed && participant.Response != ParticipantResponse_Declined {
+			errs = append(errs, fmt.Errorf(`"participants[%d].response" has the unknown value %q`, idx, participant.Response))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateEvent(event AnalyzeMessagesEvent, now time.Time) error {
+	var errs []error
+
+	startDate, err := validateEventDate("start_date", event.StartDate, now)
+	errs = append(errs, err)
+	endDate, err := validateEventDate("end_date", event.EndDate, now)
+	errs = append(errs, err)
+	startTime, err := validateEventTime("start_time", event.StartTime)
+	errs = append(errs, err)
+	endTime, err := validateEventTime("end_time", event.EndTime)
+	errs = append(errs, err)
+	errs = append(errs, validatePrayerAnchor("start_prayer_anchor", event.StartPrayerAnchor))
+	errs = append(errs, validatePrayerAnchor("end_prayer_anchor", event.EndPrayerAnchor))
+
+	if event.StartTime != nil && event.StartPrayerAnchor != nil {
+		errs = append(errs, errors.New(`"start_time" must be null when "start_prayer_anchor" is set`))
+	}
+	if event.EndTime != nil && event.EndPrayerAnchor != nil {
+		errs = append(errs, errors.New(`"end_time" must be null when "end_prayer_anchor" is set`))
+	}
+
+	// the end can only be compared when it is on a known day, an end without a date is on the start's day
+	sameDay := endDate.IsZero() || startDate.Equal(endDate)
+	switch {
+	case !startDate.IsZero() && !endDate.IsZero() && endDate.Before(startDate):
+		errs = append(errs, errors.New(`"end_date" must not be before "start_date"`))
+	case sameDay && !startTime.IsZero() && !endTime.IsZero() && !endTime.After(startTime):
+		errs = append(errs, errors.New(`"end_time" must be after "start_time"`))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateEventDate returns the parsed date, or the zero time when it is null
+func validateEventDate(key string, date *string, now time.Time) (time.Time, error) {
+	if date == nil {
+		return time.Time{}, nil
+	}
+
+	parsedDate, err := time.Parse("2006-01-02", *date)
+	if err == nil {
+		return parsedDate, nil
+	}
+
+	hijriDate, err := hijri.Parse(*date, now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(`"%s" is %q, it must be in the format YYYY-MM-dd or a Hijri date like '15 Ramadan 1447'`, key, *date)
+	}
+	parsedDate, err = hijriDate.ToGregorian(time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(`"%s" is %q, which is not a valid Hijri date`, key, *date)
+	}
+	return parsedDate, nil
+}
+
+// validateEventTime returns the parsed time, or the zero time when it is null
+func validateEventTime(key string, eventTime *string) (time.Time, error) {
+	if eventTime == nil {
+		return time.Time{}, nil
+	}
+
+	parsedTime, err := time.Parse("15:04", *eventTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf(`"%s" is %q, it must be in the format HH:mm`, key, *eventTime)
+	}
+	return parsedTime, nil
+}
+
+func validatePrayerAnchor(key string, anchor *PrayerAnchor) error {
+	if anchor == nil {
+		return nil
+	}
+
+	var errs []error
+	if !slices.Contains(anchorPrayers, strings.ToLower(anchor.Prayer)) {
+		errs = append(errs, fmt.Errorf(`"%s.prayer" is %q, it must be one of %s`, key, anchor.Prayer, strings.Join(anchorPrayers, ", ")))
+	}
+	if anchor.Relation != PrayerAnchorRelation_Before && anchor.Relation != PrayerAnchorRelation_After {
+		errs = append(errs, fmt.Errorf(`"%s.relation" is %q, it must be before or after`, key, anchor.Relation))
+	}
+	if anchor.OffsetMinutes != nil && *anchor.OffsetMinutes < 0 {
+		errs = append(errs, fmt.Errorf(`"%s.offset_minutes" must not be negative`, key))
+	}
+	return errors.Join(errs...)
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 
 	"github.com/ThreeDotsLabs/watermill-amqp/v3/pkg/amqp"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/notificationsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
 	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
 	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
@@ -13,12 +16,19 @@ import (
 )
 
 type consumer struct {
-	subscriber                    *amqp.Subscriber
-	wasappMessagesQueueName       string
-	store                         store.Queries
-	msgAnalyzer                   wasappmsganalyzer.Analyzer
-	calendarProducer              wasappcalendar.Producer
-	whatsappMessagesEncryptionKey string
+	subscriber              *amqp.Subscriber
+	wasappMessagesQueueName string
+	store                   store.Queries
+	msgAnalyzer             wasappmsganalyzer.Analyzer
+	msgSummarizer           wasappmsganalyzer.Summarizer
+	window                  ChatWindow
+	debounce                ChatDebounce
+	quota                   LlmQuota
+	calendarProducer        wasappcalendar.Producer
+	encryptionSvc           encryptionsvc.Svc
+	prayerSvc               prayersvc.Svc
+	notificationSvc         notificationsvc.Svc
+	stop                    chan struct{}
 }
 
 func (c *consumer) Start(ctx context.Context) error {
@@ -31,6 +41,9 @@ func (c *consumer) Start(ctx context.Context) error {
 
 	log.Ctx(ctx).Info().Msg("successfully started consuming messages")
 
+	go c.analyzePendingChats(ctx)
+	go c.analyzeChatImports(ctx)
+
 	go func() {
 		for {
 			select {
@@ -58,119 +71,40 @@ func (c *consumer) Start(ctx context.Context) error {
 					Str("message_id", wasappMsg.ID).
 					Msg("processing new message")
 
-				msgs, err := c.store.AddMessageToChatReturningMessages(ctx, store.AddMessageToChatReturningMessagesParams{
-					CustomerID:    wasappMsg.CustomerID,
-					ChatID:        wasappMsg.ChatID,
-					MessageID:     wasappMsg.ID,
-					SenderName:    wasappMsg.SenderName,
-					SenderNumber:  wasappMsg.SenderNumber,
-					IsSenderMe:    wasappMsg.IsSenderMe,
-					Body:          wasappMsg.Body,
-					Timestamp:     wasappMsg.Timestamp,
-					EncryptionKey: c.whatsappMessagesEncryptionKey,
-				})
+				monitored, err := c.chatMonitored(ctx, wasappMsg)
 				if err != nil {
 					log.Ctx(ctx).Err(err).
 						Str("chat_id", wasappMsg.ChatID).
-						Msg("failed running AddMessageToChatReturningMessages")
+						Msg("failed running chatMonitored")
+					if didNotSendAck := msg.Nack(); didNotSendAck {
+						log.Ctx(ctx).Err(err).Msg("failed to Nack the message, cuz Ack already sent")
+					}
 					continue
 				}
-
-				log.Ctx(ctx).Debug().
-					Int("messages_count", len(msgs)).
-					Str("chat_id", wasappMsg.ChatID).
-					Msg("retrieved messages for analysis")
-
-				msgsForAnalysis := make([]wasappmsganalyzer.MessageForAnalysis, len(msgs))
-				for idx, msg := range msgs {
-					msgsForAnalysis[idx] = mapAddMessageToChatReturningMessagesRowToMessageForAnalysis(msg)
-				}
-				analysisResp, err := c.msgAnalyzer.AnalyzeMessages(ctx, &wasappmsganalyzer.AnalyzeMessagesRequest{
-					Messages: msgsForAnalysis,
-				})
-				if err != nil {
-					log.Ctx(ctx).Err(err).
+				if !monitored {
+					log.Ctx(ctx).Debug().
 						Str("chat_id", wasappMsg.ChatID).
-						Msg("failed running msgAnalyzer.AnalyzeMessages")
+						Msg("chat is not monitored, dropping the message")
+					if didNotSendNack := msg.Ack(); didNotSendNack {
+						log.Ctx(ctx).Error().Msg("failed to Ack message, cuz Nack was already sent")
+					}
 					continue
 				}
 
-				log.Ctx(ctx).Info().
-					Str("chat_id", wasappMsg.ChatID).
-					Str("analysis_status", string(analysisResp.Status)).
-					Msg("message analysis completed")
-
-				if len(msgs) > 0 {
-					chatID := msgs[0].ChatID
-
-					switch analysisResp.Status {
-					case wasappmsganalyzer.AnalyzeMessagesStatus_HasEventAgreed:
-						log.Ctx(ctx).Info().
-							Str("chat_id", chatID).
-							Msg("event agreed, proceeding to add to calendar queue")
-
-						eventData := mapAnalysisResponseToCalendarEvent(
-							ctx,
-							wasappMsg.CustomerID,
-							chatID,
-							analysisResp,
-						)
-
-						err = c.calendarProducer.PublishEvent(ctx, eventData)
-						if err != nil {
-							log.Ctx(ctx).Err(err).
-								Str("chat_id", chatID).
-								Msg("failed to publish event to calendar queue")
-						} else {
-							log.Ctx(ctx).Info().
-								Str("chat_id", chatID).
-								Msg("successfully published event to calendar queue")
-						}
-
-						err = c.store.DeleteChat(ctx, store.DeleteChatParams{
-							ChatID:     chatID,
-							CustomerID: wasappMsg.CustomerID,
-						})
-						if err != nil {
-							log.Ctx(ctx).Err(err).
-								Str("chat_id", chatID).
-								Msg("failed running store.DeleteChat")
-							if didNotSendAck := msg.Nack(); didNotSendAck {
-								log.Ctx(ctx).Err(err).Msg("failed to Nack the message, cuz Ack already sent")
-							}
-							continue
-						}
-					case wasappmsganalyzer.AnalyzeMessagesStatus_HasEventDenied:
-						log.Ctx(ctx).Info().
-							Str("chat_id", chatID).
-							Msg("event denied, proceeding to delete chat")
-						err = c.store.DeleteChat(ctx, store.DeleteChatParams{
-							ChatID:     chatID,
-							CustomerID: wasappMsg.CustomerID,
-						})
-						if err != nil {
-							log.Ctx(ctx).Err(err).
-								Str("chat_id", chatID).
-								Msg("failed running store.DeleteChat")
-							if didNotSendAck := msg.Nack(); didNotSendAck {
-								log.Ctx(ctx).Err(err).Msg("failed to Nack the message, cuz Ack already sent")
-							}
-							continue
-						}
-					case wasappmsganalyzer.AnalyzeMessagesStatus_HasEventButNotConfirmed:
-						log.Ctx(ctx).Debug().
-							Str("chat_id", chatID).
-							Msg("event not confirmed yet, keeping chat")
-						fallthrough
-					case wasappmsganalyzer.AnalyzeMessagesStatus_NoEvent:
-						log.Ctx(ctx).Debug().
-							Str("chat_id", chatID).
-							Msg("no event detected, keeping chat")
+				// the message is analyzed with the rest of the chat once it goes quiet, so it is done with once it is
+				// stored and its chat is marked as pending
+				if err := c.storeMessage(ctx, wasappMsg); err != nil {
+					log.Ctx(ctx).Err(err).
+						Str("chat_id", wasappMsg.ChatID).
+						Msg("failed running storeMessage")
+					if didNotSendAck := msg.Nack(); didNotSendAck {
+						log.Ctx(ctx).Err(err).Msg("failed to Nack the message, cuz Ack already sent")
 					}
+					continue
 				}
 
 				if didNotSendNack := msg.Ack(); didNotSendNack {
-					log.Ctx(ctx).Err(err).Msg("failed to Ack message, cuz Nack was already sent")
+					log.Ctx(ctx).Error().Msg("failed to Ack message, cuz Nack was already sent")
 				} else {
 					log.Ctx(ctx).Debug().Msg("acknowledged message successfully")
 				}
@@ -183,6 +117,7 @@ func (c *consumer) Start(ctx context.Context) error {
 
 func (c *consumer) Stop(ctx context.Context) error {
 	log.Ctx(ctx).Info().Msg("stopping consumer")
+	close(c.stop)
 	// if err := c.channel.Close(); err != nil {
 	// 	log.Ctx(ctx).Err(err).Msg("failed to close the channel")
 	// 	return err
@@ -191,13 +126,41 @@ func (c *consumer) Stop(ctx context.Context) error {
 	return nil
 }
 
-func NewConsumer(subscriber *amqp.Subscriber, wasappMessagesQueueName string, store store.Queries, msgAnalyzer wasappmsganalyzer.Analyzer, calendarProducer wasappcalendar.Producer, whatsappMessagesEncryptionKey string) Consumer {
+func NewConsumer(subscriber *amqp.Subscriber, wasappMessagesQueueName string, store store.Queries, msgAnalyzer wasappmsganalyzer.Analyzer, msgSummarizer wasappmsganalyzer.Summarizer, window ChatWindow, debounce ChatDebounce, quota LlmQuota, calendarProducer wasappcalendar.Producer, encryptionSvc encryptionsvc.Svc, prayerSvc prayersvc.Svc, notificationSvc notificationsvc.Svc) Consumer {
+	if window.MaxMessages <= 0 {
+		window.MaxMessages = defaultWindowMaxMessages
+	}
+	if window.MaxAge <= 0 {
+		window.MaxAge = defaultWindowMaxAge
+	}
+	if debounce.Quiet <= 0 {
+		debounce.Quiet = defaultDebounceQuiet
+	}
+	if debounce.MaxDelay <= 0 {
+		debounce.MaxDelay = defaultDebounceMaxDelay
+	}
+	// a chat that keeps getting messages waits at most MaxDelay, which can not be shorter than going quiet
+	debounce.MaxDelay = max(debounce.MaxDelay, debounce.Quiet)
+	if quota.Mode == QuotaMode_EveryNth && quota.EveryNth <= 0 {
+		quota.EveryNth = defaultQuotaEveryNth
+	}
+	if quota.Degraded == nil {
+		quota.Degraded = wasappmsganalyzer.NewRulesAnalyzer()
+	}
+
 	return &consumer{
-		subscriber:                    subscriber,
-		wasappMessagesQueueName:       wasappMessagesQueueName,
-		store:                         store,
-		msgAnalyzer:                   msgAnalyzer,
-		calendarProducer:              calendarProducer,
-		whatsappMessagesEncryptionKey: whatsappMessagesEncryptionKey,
+		subscriber:              subscriber,
+		wasappMessagesQueueName: wasappMessagesQueueName,
+		store:                   store,
+		msgAnalyzer:             msgAnalyzer,
+		msgSummarizer:           msgSummarizer,
+		window:                  window,
+		debounce:                debounce,
+		quota:                   quota,
+		calendarProducer:        calendarProducer,
+		encryptionSvc:           encryptionSvc,
+		prayerSvc:               prayerSvc,
+		notificationSvc:         notificationSvc,
+		stop:                    make(chan struct{}),
 	}
 }
@@ -0,0 +1,348 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappclient "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/client"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultDebounceQuiet    = 5 * time.Second
+	defaultDebounceMaxDelay = 30 * time.Second
+
+	pendingChatsPollInterval = time.Second
+	// pendingChatsBatchSize is how many due chats are claimed at a time
+	pendingChatsBatchSize = 10
+	// chatAnalysisLease is how long a claimed chat is left to the consumer that claimed it, a chat whose consumer
+	// died while analyzing it is claimed again once it is over
+	chatAnalysisLease = 5 * time.Minute
+	// chatAnalysisRetryDelay is how long a chat that failed to be analyzed waits to be analyzed again
+	chatAnalysisRetryDelay = 30 * time.Second
+	// maxChatAnalysisAttempts is how many times in a row a chat is tried before it is given up on until its next
+	// message comes in
+	maxChatAnalysisAttempts = 10
+)
+
+// ChatDebounce decides when the messages of a chat are analyzed, chats get their messages in bursts so the messages
+// are analyzed together once the chat goes quiet instead of one after the other.
+type ChatDebounce struct {
+	// Quiet is how long the chat has to go without a new message
+	Quiet time.Duration
+	// MaxDelay is the longest the first message that was not analyzed yet waits, so a busy chat is still analyzed
+	MaxDelay time.Duration
+}
+
+// storeMessage adds the message to its chat and marks the chat as pending analysis, the pending chats are kept in the
+// database so they are analyzed even when the consumer restarts before they are due.
+func (c *consumer) storeMessage(ctx context.Context, wasappMsg WasappMessage) error {
+	body, err := c.encryptionSvc.Encrypt(ctx, wasappMsg.CustomerID, wasappMsg.Body)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt the message: %w", err)
+	}
+
+	params := store.AddMessageToChatParams{
+		CustomerID:      wasappMsg.CustomerID,
+		ChatID:          wasappMsg.ChatID,
+		MessageID:       wasappMsg.ID,
+		SenderName:      wasappMsg.SenderName,
+		SenderNumber:    wasappMsg.SenderNumber,
+		IsSenderMe:      wasappMsg.IsSenderMe,
+		Timestamp:       wasappMsg.Timestamp,
+		QuietSeconds:    c.debounce.Quiet.Seconds(),
+		MaxDelaySeconds: c.debounce.MaxDelay.Seconds(),
+		Body:            body,
+	}
+	if quotedMsg := wasappMsg.QuotedMessage; quotedMsg != nil {
+		params.QuotedMessageID = sql.NullString{String: quotedMsg.ID, Valid: true}
+		params.QuotedSenderName = sql.NullString{String: quotedMsg.SenderName, Valid: true}
+		params.QuotedIsSenderMe = sql.NullBool{Bool: quotedMsg.IsSenderMe, Valid: true}
+		params.QuotedTimestamp = sql.NullInt64{Int64: quotedMsg.Timestamp, Valid: true}
+		params.QuotedBody, err = c.encryptionSvc.EncryptNull(ctx, wasappMsg.CustomerID, sql.NullString{String: quotedMsg.Body, Valid: true})
+		if err != nil {
+			return fmt.Errorf("failed to encrypt the quoted message: %w", err)
+		}
+	}
+
+	if err := c.store.AddMessageToChat(ctx, params); err != nil {
+		return fmt.Errorf("failed running AddMessageToChat: %w", err)
+	}
+	return nil
+}
+
+// analyzePendingChats analyzes the pending chats once they are due until the consumer stops
+func (c *consumer) analyzePendingChats(ctx context.Context) {
+	ticker := time.NewTicker(pendingChatsPollInterval)
+	defer ticker.Stop()
+
+	for {
+		c.analyzeDueChats(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Ctx(ctx).Info().Msg("context cancelled, stopping pending chats analysis")
+			return
+		case <-c.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *consumer) analyzeDueChats(ctx context.Context) {
+	dueChats, err := c.store.ClaimDueChats(ctx, store.ClaimDueChatsParams{
+		LeaseSeconds: chatAnalysisLease.Seconds(),
+		MaxChats:     pendingChatsBatchSize,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running store.ClaimDueChats")
+		return
+	}
+
+	for _, dueChat := range dueChats {
+		err := c.analyzeChat(ctx, dueChat.CustomerID, dueChat.ChatID)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", dueChat.ChatID).
+				Msg("failed running analyzeChat, the chat is analyzed again later")
+
+			gaveUp, err := c.store.PostponeChatAnalysis(ctx, store.PostponeChatAnalysisParams{
+				ChatID:       dueChat.ChatID,
+				CustomerID:   dueChat.CustomerID,
+				RetrySeconds: chatAnalysisRetryDelay.Seconds(),
+				MaxAttempts:  maxChatAnalysisAttempts,
+			})
+			if err != nil {
+				log.Ctx(ctx).Err(err).
+					Str("chat_id", dueChat.ChatID).
+					Msg("failed running store.PostponeChatAnalysis")
+				continue
+			}
+			if gaveUp {
+				log.Ctx(ctx).Warn().
+					Str("chat_id", dueChat.ChatID).
+					Int("attempts", maxChatAnalysisAttempts).
+					Msg("gave up analyzing the chat until its next message")
+			}
+			continue
+		}
+
+		// the chat stays pending when a message came in while it was being analyzed
+		err = c.store.FinishChatAnalysis(ctx, store.FinishChatAnalysisParams{
+			ChatID:         dueChat.ChatID,
+			CustomerID:     dueChat.CustomerID,
+			PendingVersion: dueChat.PendingVersion,
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", dueChat.ChatID).
+				Msg("failed running store.FinishChatAnalysis")
+		}
+	}
+}
+
+// analyzeChat analyzes the chat's messages and handles the events they talk about, an error means the chat has to be
+// analyzed again.
+func (c *consumer) analyzeChat(ctx context.Context, customerID uuid.UUID, chatID string) error {
+	upcomingEvents, err := c.upcomingChatEvents(ctx, customerID, chatID)
+	if err != nil {
+		return fmt.Errorf("failed running upcomingChatEvents: %w", err)
+	}
+	// the analyzer only knows about agreed events, the held one is still being discussed
+	chatEvents, hold := splitTentativeChatEvent(upcomingEvents)
+
+	preference, err := c.customerPreference(ctx, customerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chatID).
+			Msg("failed running customerPreference, using the default preference")
+		preference = store.DefaultCustomerPreference()
+	}
+
+	prayerSettings, err := c.prayerSvc.GetCustomerSettings(ctx, customerID)
+	if err != nil {
+		if !errors.Is(err, prayersvc.ErrNoSettings) {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chatID).
+				Msg("failed running prayerSvc.GetCustomerSettings, using the default prayer settings")
+		}
+		prayerSettings = prayersvc.DefaultSettings()
+	}
+
+	quota, err := c.checkQuota(ctx, customerID, chatID)
+	if err != nil {
+		// a broken quota check is not the customer's fault, so the messages are analyzed as usual
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chatID).
+			Msg("failed running checkQuota")
+	}
+	if quota.skip {
+		log.Ctx(ctx).Info().
+			Str("chat_id", chatID).
+			Msg("customer is over their daily LLM quota, the messages are analyzed with later ones")
+		return nil
+	}
+
+	msgs, err := c.listChatMessages(ctx, customerID, chatID)
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	summary, msgs, err := c.windowChatMessages(ctx, customerID, chatID, msgs, quota.overQuota)
+	if err != nil {
+		return fmt.Errorf("failed running windowChatMessages: %w", err)
+	}
+
+	log.Ctx(ctx).Debug().
+		Int("messages_count", len(msgs)).
+		Bool("has_summary", summary != "").
+		Int("events_count", len(chatEvents)).
+		Bool("has_hold", hold != nil).
+		Str("chat_id", chatID).
+		Msg("retrieved messages for analysis")
+
+	msgsForAnalysis := make([]wasappmsganalyzer.MessageForAnalysis, len(msgs))
+	for idx, msg := range msgs {
+		msgsForAnalysis[idx] = mapListChatMessagesRowToMessageForAnalysis(msg)
+	}
+	analysisResp, err := quota.analyzer.AnalyzeMessages(ctx, &wasappmsganalyzer.AnalyzeMessagesRequest{
+		CustomerID:    customerID,
+		Messages:      msgsForAnalysis,
+		Summary:       summary,
+		Events:        mapWasappChatEventsToKnownEvents(chatEvents, prayerSettings.Location.Timezone),
+		IsGroup:       wasappclient.IsGroupChat(chatID),
+		AgreementRule: agreementRules[preference.GroupAgreementRule],
+		Timezone:      prayerSettings.Location.Timezone,
+	})
+	if err != nil {
+		var invalidResponseErr *wasappmsganalyzer.InvalidResponseError
+		if !errors.As(err, &invalidResponseErr) {
+			// none of the analyzers could analyze the messages, so they are analyzed again later
+			return fmt.Errorf("failed running AnalyzeMessages: %w", err)
+		}
+
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chatID).
+			Msg("failed running AnalyzeMessages")
+
+		// analyzing the same messages again is not going to fix the response, so the failure is kept for looking into
+		// and the messages are done with
+		err = c.recordAnalysisFailure(ctx, customerID, chatID, msgs, invalidResponseErr)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chatID).
+				Msg("failed running recordAnalysisFailure")
+		}
+		return nil
+	}
+
+	log.Ctx(ctx).Info().
+		Str("chat_id", chatID).
+		Str("analyzer_backend", string(analysisResp.Backend)).
+		Str("model", analysisResp.Model).
+		Str("prompt_version", analysisResp.PromptVersion).
+		Bool("over_quota", quota.overQuota).
+		Int("messages_count", len(msgs)).
+		Int("events_count", len(analysisResp.Events)).
+		Msg("message analysis completed")
+
+	chat := &analyzedChat{
+		customerID:     customerID,
+		chatID:         chatID,
+		msgs:           msgs,
+		analysisResp:   analysisResp,
+		preference:     preference,
+		prayerSettings: prayerSettings,
+		events:         chatEvents,
+		hold:           hold,
+		hasSuggestion:  hasSuggestedEvent(analysisResp.Events),
+	}
+	for idx := range analysisResp.Events {
+		c.handleAnalyzedEvent(ctx, chat, &analysisResp.Events[idx])
+	}
+
+	if len(analysisResp.Events) > 0 {
+		err = c.store.TouchChatEventActivity(ctx, store.TouchChatEventActivityParams{
+			ChatID:     chat.chatID,
+			CustomerID: chat.customerID,
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed running store.TouchChatEventActivity")
+		}
+	}
+
+	if chat.dropChat && len(chat.events) == 0 && chat.hold == nil {
+		log.Ctx(ctx).Info().
+			Str("chat_id", chat.chatID).
+			Msg("chat has no events left, proceeding to delete chat")
+		err = c.store.DeleteChat(ctx, store.DeleteChatParams{
+			ChatID:     chat.chatID,
+			CustomerID: chat.customerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed running store.DeleteChat: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// listChatMessages returns the chat's messages ordered by their timestamp with their bodies decrypted, the quoted
+// body is the one of the quoted message when it is in the chat. A message that cannot be decrypted is deleted, as it
+// can never be read, so it does not keep the rest of the chat from being analyzed.
+func (c *consumer) listChatMessages(ctx context.Context, customerID uuid.UUID, chatID string) ([]store.ListChatMessagesRow, error) {
+	msgs, err := c.store.ListChatMessages(ctx, store.ListChatMessagesParams{
+		ChatID:     chatID,
+		CustomerID: customerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed running ListChatMessages: %w", err)
+	}
+
+	decryptedMsgs := msgs[:0]
+	for _, msg := range msgs {
+		msg.Body, err = c.encryptionSvc.Decrypt(ctx, customerID, msg.Body)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chatID).
+				Str("message_id", msg.MessageID).
+				Msg("failed to decrypt the message, deleting it")
+
+			err = c.store.DeleteChatMessage(ctx, msg.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed running DeleteChatMessage: %w", err)
+			}
+			continue
+		}
+
+		quotedBody := msg.QuotedBody
+		if msg.QuotedMessageBody.Valid {
+			quotedBody = msg.QuotedMessageBody
+		}
+		msg.QuotedBody, err = c.encryptionSvc.DecryptNull(ctx, customerID, quotedBody)
+		if err != nil {
+			// the message is still analyzed without what it quoted
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chatID).
+				Str("message_id", msg.MessageID).
+				Msg("failed to decrypt the message it quotes, analyzing it without it")
+			msg.QuotedBody = sql.NullString{}
+		}
+
+		decryptedMsgs = append(decryptedMsgs, msg)
+	}
+	return decryptedMsgs, nil
+}
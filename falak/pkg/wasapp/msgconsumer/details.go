@@ -0,0 +1,88 @@
+package wasappmsgconsumer
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+)
+
+var (
+	mapsLinkRegex = regexp.MustCompile(`(?i)(?:https?://\S*(?:google\.[a-z.]+/maps|maps\.google\.[a-z.]+|maps\.apple\.com)\S*|geo:\S+)`)
+	// coordinatesRegexes match the coordinates in the query of a maps link, after the "@" of a Google Maps place link,
+	// and in a geo: URI, the comma may be URL encoded
+	coordinatesRegexes = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)[?&](?:q|query|ll|sll|daddr|destination|coordinate)=(-?\d+(?:\.\d+)?)(?:,|%2C)\s*(-?\d+(?:\.\d+)?)`),
+		regexp.MustCompile(`@(-?\d+(?:\.\d+)?),(-?\d+(?:\.\d+)?)`),
+		regexp.MustCompile(`(?i)^geo:(-?\d+(?:\.\d+)?),(-?\d+(?:\.\d+)?)`),
+	}
+)
+
+// findSharedLocation returns the coordinates of the last maps link shared in the chat, links that don't carry
+// coordinates, like shortened ones, are skipped.
+func findSharedLocation(msgs []store.ListChatMessagesRow) *wasappcalendar.EventGeo {
+	for idx := len(msgs) - 1; idx >= 0; idx-- {
+		links := mapsLinkRegex.FindAllString(msgs[idx].Body, -1)
+		for linkIdx := len(links) - 1; linkIdx >= 0; linkIdx-- {
+			if geo := parseMapsLinkCoordinates(links[linkIdx]); geo != nil {
+				return geo
+			}
+		}
+	}
+	return nil
+}
+
+func parseMapsLinkCoordinates(link string) *wasappcalendar.EventGeo {
+	for _, re := range coordinatesRegexes {
+		matches := re.FindStringSubmatch(link)
+		if matches == nil {
+			continue
+		}
+
+		latitude, latErr := strconv.ParseFloat(matches[1], 64)
+		longitude, lngErr := strconv.ParseFloat(matches[2], 64)
+		if latErr != nil || lngErr != nil || latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+			continue
+		}
+
+		return &wasappcalendar.EventGeo{
+			Latitude:  latitude,
+			Longitude: longitude,
+		}
+	}
+	return nil
+}
+
+// chatAttendees returns the people the customer talked to in the chat, in the order they first spoke. When the
+// analysis tells who answered the event, like in group chats, only the people who agreed to it are returned.
+func chatAttendees(msgs []store.ListChatMessagesRow, participants []wasappmsganalyzer.Participant) []wasappcalendar.EventAttendee {
+	var agreed map[string]bool
+	if len(participants) > 0 {
+		agreed = map[string]bool{}
+		for _, participant := range participants {
+			if participant.Response == wasappmsganalyzer.ParticipantResponse_Agreed {
+				agreed[participant.Name] = true
+			}
+		}
+	}
+
+	var attendees []wasappcalendar.EventAttendee
+	seen := map[string]bool{}
+	for _, msg := range msgs {
+		if msg.IsSenderMe || msg.SenderNumber == "" || seen[msg.SenderNumber] {
+			continue
+		}
+		if agreed != nil && !agreed[msg.SenderName] {
+			continue
+		}
+		seen[msg.SenderNumber] = true
+
+		attendees = append(attendees, wasappcalendar.EventAttendee{
+			Name:        msg.SenderName,
+			PhoneNumber: msg.SenderNumber,
+		})
+	}
+	return attendees
+}
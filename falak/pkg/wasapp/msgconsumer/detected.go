@@ -0,0 +1,206 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/notificationsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+)
+
+// recordDetectedEvent stores an event the analyzer found in a chat along with the messages and the analysis it came
+// from, eventData is nil when the analyzed event has no details to show.
+func (c *consumer) recordDetectedEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent, state store.DetectedEventState, eventData *wasappcalendar.CalendarEventData) (store.DetectedEvent, error) {
+	messageIDs, err := mapMessagesToMessageIDs(chat.msgs)
+	if err != nil {
+		return store.DetectedEvent{}, err
+	}
+
+	params := store.CreateDetectedEventParams{
+		CustomerID:      chat.customerID,
+		ChatID:          chat.chatID,
+		MessageIds:      messageIDs,
+		AnalysisStatus:  string(analyzedEvent.Status),
+		RawAnalysis:     json.RawMessage(chat.analysisResp.Raw),
+		Model:           chat.analysisResp.Model,
+		PromptVersion:   chat.analysisResp.PromptVersion,
+		State:           state,
+		Attendees:       json.RawMessage("[]"),
+		AnalyzerBackend: string(chat.analysisResp.Backend),
+		ChatImportID:    chat.chatImportID,
+	}
+	if eventData != nil {
+		params.Summary = sql.NullString{String: eventData.Summary, Valid: true}
+		params.Description = sql.NullString{String: eventData.Description, Valid: true}
+		params.StartTime = sql.NullTime{Time: eventData.StartTime, Valid: true}
+		params.EndTime = sql.NullTime{Time: eventData.EndTime, Valid: true}
+		params.FlexibleTime = eventData.FlexibleTime
+		params.CaldavUid = sql.NullString{String: eventData.UID, Valid: eventData.UID != ""}
+		params.Location = sql.NullString{String: eventData.Location, Valid: eventData.Location != ""}
+		params.Latitude, params.Longitude = mapEventGeoToCoordinates(eventData.Geo)
+		params.Attendees, err = mapEventAttendeesToJson(eventData.Attendees)
+		if err != nil {
+			return store.DetectedEvent{}, err
+		}
+	}
+
+	detectedEvent, err := c.store.CreateDetectedEvent(ctx, params)
+	if err != nil {
+		return store.DetectedEvent{}, fmt.Errorf("failed running CreateDetectedEvent: %w", err)
+	}
+	return detectedEvent, nil
+}
+
+// recordDetectedEventChange points the detected event with the UID of eventData to the analysis that changed it,
+// its state is moved on by the calendar consumer once the change is in the calendar.
+func (c *consumer) recordDetectedEventChange(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent, eventData wasappcalendar.CalendarEventData) error {
+	messageIDs, err := mapMessagesToMessageIDs(chat.msgs)
+	if err != nil {
+		return err
+	}
+
+	attendees, err := mapEventAttendeesToJson(eventData.Attendees)
+	if err != nil {
+		return err
+	}
+	latitude, longitude := mapEventGeoToCoordinates(eventData.Geo)
+
+	err = c.store.UpdateDetectedEventAnalysisByCaldavUid(ctx, store.UpdateDetectedEventAnalysisByCaldavUidParams{
+		CustomerID:      eventData.CustomerID,
+		CaldavUid:       sql.NullString{String: eventData.UID, Valid: true},
+		MessageIds:      messageIDs,
+		AnalysisStatus:  string(analyzedEvent.Status),
+		RawAnalysis:     json.RawMessage(chat.analysisResp.Raw),
+		Model:           chat.analysisResp.Model,
+		PromptVersion:   chat.analysisResp.PromptVersion,
+		Summary:         sql.NullString{String: eventData.Summary, Valid: true},
+		Description:     sql.NullString{String: eventData.Description, Valid: true},
+		StartTime:       sql.NullTime{Time: eventData.StartTime, Valid: true},
+		EndTime:         sql.NullTime{Time: eventData.EndTime, Valid: true},
+		FlexibleTime:    eventData.FlexibleTime,
+		Location:        sql.NullString{String: eventData.Location, Valid: eventData.Location != ""},
+		Latitude:        latitude,
+		Longitude:       longitude,
+		Attendees:       attendees,
+		AnalyzerBackend: string(chat.analysisResp.Backend),
+	})
+	if err != nil {
+		return fmt.Errorf("failed running UpdateDetectedEventAnalysisByCaldavUid: %w", err)
+	}
+	return nil
+}
+
+// recordAnalysisFailure stores an analysis the LLM answered with an invalid response, along with the messages it was
+// given and what is wrong with the response.
+func (c *consumer) recordAnalysisFailure(ctx context.Context, customerID uuid.UUID, chatID string, msgs []store.ListChatMessagesRow, invalidResponseErr *wasappmsganalyzer.InvalidResponseError) error {
+	messageIDs, err := mapMessagesToMessageIDs(msgs)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.store.CreateWasappAnalysisFailure(ctx, store.CreateWasappAnalysisFailureParams{
+		CustomerID:      customerID,
+		ChatID:          chatID,
+		MessageIds:      messageIDs,
+		Model:           invalidResponseErr.Model,
+		PromptVersion:   invalidResponseErr.PromptVersion,
+		RawAnalysis:     invalidResponseErr.Raw,
+		Error:           invalidResponseErr.Err.Error(),
+		AnalyzerBackend: string(invalidResponseErr.Backend),
+	})
+	if err != nil {
+		return fmt.Errorf("failed running CreateWasappAnalysisFailure: %w", err)
+	}
+	return nil
+}
+
+// customerPreference returns how the customer wants the events found in their chats handled, customers who never
+// changed their preference get the default one.
+func (c *consumer) customerPreference(ctx context.Context, customerID uuid.UUID) (store.CustomerPreference, error) {
+	preference, err := c.store.GetCustomerPreferenceByCustomerId(ctx, customerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return store.DefaultCustomerPreference(), nil
+		}
+		return store.CustomerPreference{}, fmt.Errorf("failed running GetCustomerPreferenceByCustomerId: %w", err)
+	}
+	return preference, nil
+}
+
+// detectedEventState returns the state of the detected event with the given UID, an empty state is returned for
+// events that were added before detected events were recorded.
+func (c *consumer) detectedEventState(ctx context.Context, customerID uuid.UUID, uid string) (store.DetectedEventState, error) {
+	detectedEvent, err := c.store.GetDetectedEventByCaldavUid(ctx, store.GetDetectedEventByCaldavUidParams{
+		CustomerID: customerID,
+		CaldavUid:  sql.NullString{String: uid, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed running GetDetectedEventByCaldavUid: %w", err)
+	}
+	return detectedEvent.State, nil
+}
+
+// isInCalendar tells if a detected event in the given state was sent to the calendar, changes to events that are
+// still pending, or were dismissed, only touch the detected event.
+func isInCalendar(state store.DetectedEventState) bool {
+	switch state {
+	case store.DetectedEventStatePending, store.DetectedEventStateRejected, store.DetectedEventStateExpired, store.DetectedEventStateCancelled:
+		return false
+	}
+	return true
+}
+
+// askToAddDetectedEvent sends an alert with "Add" and "Dismiss" actions for an event waiting for the customer's approval
+func (c *consumer) askToAddDetectedEvent(ctx context.Context, detectedEvent store.DetectedEvent, loc *time.Location) error {
+	detectedEventID := detectedEvent.ID.String()
+	category := notificationsvc.Category_DetectedEvent
+
+	return c.notificationSvc.SendNotificationToCustomerDevices(ctx, &notificationsvc.SendNotificationToCustomerDevicesRequest{
+		CustomerId:      detectedEvent.CustomerID,
+		AlertTitle:      "📅 Add WhatsApp Event?",
+		AlertBody:       fmt.Sprintf("'%s' was agreed on in a chat, %s", detectedEvent.Summary.String, detectedEvent.StartTime.Time.In(loc).Format("Mon 2 Jan 15:04")),
+		Category:        &category,
+		DetectedEventID: &detectedEventID,
+	})
+}
+
+func mapMessagesToMessageIDs(msgs []store.ListChatMessagesRow) (json.RawMessage, error) {
+	messageIDs := make([]string, len(msgs))
+	for idx, msg := range msgs {
+		messageIDs[idx] = msg.MessageID
+	}
+
+	messageIDsJson, err := json.Marshal(messageIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal message ids: %w", err)
+	}
+	return messageIDsJson, nil
+}
+
+func mapEventGeoToCoordinates(geo *wasappcalendar.EventGeo) (sql.NullFloat64, sql.NullFloat64) {
+	if geo == nil {
+		return sql.NullFloat64{}, sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: geo.Latitude, Valid: true}, sql.NullFloat64{Float64: geo.Longitude, Valid: true}
+}
+
+func mapEventAttendeesToJson(attendees []wasappcalendar.EventAttendee) (json.RawMessage, error) {
+	if attendees == nil {
+		attendees = []wasappcalendar.EventAttendee{}
+	}
+
+	attendeesJson, err := json.Marshal(attendees)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal attendees: %w", err)
+	}
+	return attendeesJson, nil
+}
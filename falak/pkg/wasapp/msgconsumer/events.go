@@ -0,0 +1,120 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+)
+
+// upcomingChatEvents returns the events the chat produced that did not pass yet. The links to the events that passed
+// are dropped, and so are the chat's messages once no upcoming event is left, since they were only kept as context
+// for changes to those events.
+func (c *consumer) upcomingChatEvents(ctx context.Context, customerID uuid.UUID, chatID string) ([]store.WasappChatEvent, error) {
+	passedCount, err := c.store.DeletePassedWasappChatEvents(ctx, store.DeletePassedWasappChatEventsParams{
+		CustomerID: customerID,
+		ChatID:     chatID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed running DeletePassedWasappChatEvents: %w", err)
+	}
+
+	events, err := c.store.ListUpcomingWasappChatEvents(ctx, store.ListUpcomingWasappChatEventsParams{
+		CustomerID: customerID,
+		ChatID:     chatID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed running ListUpcomingWasappChatEvents: %w", err)
+	}
+
+	if passedCount > 0 && len(events) == 0 {
+		err = c.store.DeleteChat(ctx, store.DeleteChatParams{
+			ChatID:     chatID,
+			CustomerID: customerID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed running DeleteChat: %w", err)
+		}
+	}
+
+	return events, nil
+}
+
+func mapWasappChatEventsToKnownEvents(events []store.WasappChatEvent, loc *time.Location) []wasappmsganalyzer.KnownEvent {
+	knownEvents := make([]wasappmsganalyzer.KnownEvent, len(events))
+	for idx, event := range events {
+		knownEvents[idx] = wasappmsganalyzer.KnownEvent{
+			UID:   event.EventUid,
+			Title: event.Summary,
+			Start: event.StartTime.In(loc),
+			End:   event.EndTime.In(loc),
+		}
+	}
+	return knownEvents
+}
+
+// findChatEvent returns the event the analysis refers to, when the analysis did not say which event it is and the
+// chat has a single upcoming event then that event is returned.
+func findChatEvent(events []store.WasappChatEvent, uid *string) *store.WasappChatEvent {
+	if uid == nil {
+		if len(events) == 1 {
+			return &events[0]
+		}
+		return nil
+	}
+
+	for idx := range events {
+		if events[idx].EventUid == *uid {
+			return &events[idx]
+		}
+	}
+	return nil
+}
+
+// knownEventTolerance is how far apart an event and a known event with the same title can start and still be taken
+// for the same event, it covers the analyzer reading a loose time a bit differently but not the same plan made for
+// the next day.
+const knownEventTolerance = 2 * time.Hour
+
+// isKnownChatEvent tells if the chat already produced the event, the analyzer may report the agreement that led to
+// an event again as the messages are kept until the event passes. The event is known when the analyzer points at
+// one of the known events, or when a known event has the same title and starts around the same time.
+func isKnownChatEvent(events []store.WasappChatEvent, uid *string, eventData wasappcalendar.CalendarEventData) bool {
+	for _, event := range events {
+		if uid != nil && event.EventUid == *uid {
+			return true
+		}
+		if strings.EqualFold(strings.TrimSpace(event.Summary), strings.TrimSpace(eventData.Summary)) &&
+			absDuration(event.StartTime.Sub(eventData.StartTime)) < knownEventTolerance {
+			return true
+		}
+	}
+	return false
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// removeChatEvent drops the event with the given UID from the chat's events
+func removeChatEvent(events []store.WasappChatEvent, uid string) []store.WasappChatEvent {
+	kept := events[:0]
+	for _, event := range events {
+		if event.EventUid != uid {
+			kept = append(kept, event)
+		}
+	}
+	return kept
+}
+
+func newChatEventUID() string {
+	return fmt.Sprintf("%s@jadwal.app", uuid.New().String())
+}
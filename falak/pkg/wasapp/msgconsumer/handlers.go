@@ -0,0 +1,396 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+	"github.com/rs/zerolog/log"
+)
+
+// analyzedChat is a chat whose messages were analyzed, the events the analysis found are handled one after the other
+// and every handler keeps the chat's events up to date so the next one sees what the earlier ones did.
+type analyzedChat struct {
+	customerID     uuid.UUID
+	chatID         string
+	msgs           []store.ListChatMessagesRow
+	analysisResp   *wasappmsganalyzer.AnalyzeMessagesResponse
+	preference     store.CustomerPreference
+	prayerSettings *prayersvc.CustomerSettings
+	// chatImportID is set when the messages come from an exported chat instead of the connected account
+	chatImportID uuid.NullUUID
+
+	// events are the upcoming events the chat agreed on, and hold is the tentative one it is still discussing
+	events []store.WasappChatEvent
+	hold   *store.WasappChatEvent
+	// hasSuggestion is set when the analysis found an event that is not confirmed yet, the hold belongs to that event
+	// then and is left alone by the other events of the analysis. Only the first suggestion is held.
+	hasSuggestion  bool
+	suggestionHeld bool
+	// dropChat is set when an event of the chat was turned down or called off, the chat's messages are deleted once
+	// all its events are handled and none is left
+	dropChat bool
+}
+
+// hasSuggestedEvent tells if any of the analyzed events is suggested but not confirmed yet
+func hasSuggestedEvent(analyzedEvents []wasappmsganalyzer.AnalyzedEvent) bool {
+	for _, analyzedEvent := range analyzedEvents {
+		if analyzedEvent.Status == wasappmsganalyzer.AnalyzeMessagesStatus_HasEventButNotConfirmed {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *consumer) handleAnalyzedEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent) {
+	switch analyzedEvent.Status {
+	case wasappmsganalyzer.AnalyzeMessagesStatus_HasEventAgreed:
+		c.handleAgreedEvent(ctx, chat, analyzedEvent)
+	case wasappmsganalyzer.AnalyzeMessagesStatus_EventUpdated:
+		c.handleUpdatedEvent(ctx, chat, analyzedEvent)
+	case wasappmsganalyzer.AnalyzeMessagesStatus_EventCancelled:
+		c.handleCancelledEvent(ctx, chat, analyzedEvent)
+	case wasappmsganalyzer.AnalyzeMessagesStatus_HasEventDenied:
+		c.handleDeniedEvent(ctx, chat, analyzedEvent)
+	case wasappmsganalyzer.AnalyzeMessagesStatus_HasEventButNotConfirmed:
+		c.handleNotConfirmedEvent(ctx, chat, analyzedEvent)
+	default:
+		log.Ctx(ctx).Debug().
+			Str("chat_id", chat.chatID).
+			Str("analysis_status", string(analyzedEvent.Status)).
+			Msg("no event detected, keeping chat")
+	}
+}
+
+func (c *consumer) handleAgreedEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent) {
+	if analyzedEvent.Event == nil {
+		log.Ctx(ctx).Warn().
+			Str("chat_id", chat.chatID).
+			Msg("agreed event has no details, ignoring it")
+		return
+	}
+
+	log.Ctx(ctx).Info().
+		Str("chat_id", chat.chatID).
+		Msg("event agreed, proceeding to add to calendar queue")
+
+	eventData := mapAnalyzedEventToCalendarEvent(
+		ctx,
+		chat.customerID,
+		chat.chatID,
+		chat.msgs,
+		analyzedEvent,
+		chat.prayerSettings,
+	)
+	approvalMode := chat.preference.EventApprovalMode
+
+	if isKnownChatEvent(chat.events, analyzedEvent.EventUID, eventData) {
+		log.Ctx(ctx).Info().
+			Str("chat_id", chat.chatID).
+			Msg("agreed event was already added from this chat, skipping it")
+		return
+	}
+
+	if chat.hold != nil && !chat.hasSuggestion {
+		if approvalMode == store.EventApprovalModeAuto {
+			holdUID := chat.hold.EventUid
+			err := c.confirmHold(ctx, chat, analyzedEvent, eventData)
+			if err != nil {
+				log.Ctx(ctx).Err(err).
+					Str("chat_id", chat.chatID).
+					Msg("failed running confirmHold")
+				return
+			}
+			log.Ctx(ctx).Info().
+				Str("chat_id", chat.chatID).
+				Str("event_uid", holdUID).
+				Msg("successfully confirmed the held event")
+			return
+		}
+
+		// the customer is asked about agreed events instead, so the hold is not kept for them
+		err := c.releaseHold(ctx, chat, analyzedEvent)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed running releaseHold")
+		}
+	}
+
+	eventData.Action = wasappcalendar.CalendarEventAction_Add
+	eventData.UID = newChatEventUID()
+
+	detectedEventState := store.DetectedEventStateProposed
+	if approvalMode == store.EventApprovalModeAsk {
+		detectedEventState = store.DetectedEventStatePending
+	}
+	detectedEvent, err := c.recordDetectedEvent(ctx, chat, analyzedEvent, detectedEventState, &eventData)
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running recordDetectedEvent")
+		if approvalMode == store.EventApprovalModeAsk {
+			// there is nothing to ask the customer about without the detected event
+			return
+		}
+	}
+
+	if approvalMode == store.EventApprovalModeAsk {
+		err = c.askToAddDetectedEvent(ctx, detectedEvent, chat.prayerSettings.Location.Timezone)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed running askToAddDetectedEvent")
+		} else {
+			log.Ctx(ctx).Info().
+				Str("chat_id", chat.chatID).
+				Msg("asked customer to add the event")
+		}
+	} else {
+		err = c.calendarProducer.PublishEvent(ctx, eventData)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed to publish event to calendar queue")
+			return
+		}
+		log.Ctx(ctx).Info().
+			Str("chat_id", chat.chatID).
+			Msg("successfully published event to calendar queue")
+	}
+
+	// the chat is kept so later messages can change or cancel the event until it passes
+	chatEvent, err := c.store.AddWasappChatEvent(ctx, store.AddWasappChatEventParams{
+		CustomerID: chat.customerID,
+		ChatID:     chat.chatID,
+		EventUid:   eventData.UID,
+		Summary:    eventData.Summary,
+		StartTime:  eventData.StartTime,
+		EndTime:    eventData.EndTime,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running store.AddWasappChatEvent")
+		return
+	}
+	chat.events = append(chat.events, chatEvent)
+}
+
+func (c *consumer) handleUpdatedEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent) {
+	chatEvent := findChatEvent(chat.events, analyzedEvent.EventUID)
+	if chatEvent == nil || analyzedEvent.Event == nil {
+		log.Ctx(ctx).Warn().
+			Str("chat_id", chat.chatID).
+			Interface("event_uid", analyzedEvent.EventUID).
+			Msg("event update does not match an upcoming event of the chat, ignoring it")
+		return
+	}
+
+	log.Ctx(ctx).Info().
+		Str("chat_id", chat.chatID).
+		Str("event_uid", chatEvent.EventUid).
+		Msg("event updated, proceeding to update it in the calendar")
+
+	if analyzedEvent.Event.StartDate == nil {
+		startDate := chatEvent.StartTime.In(chat.prayerSettings.Location.Timezone).Format("2006-01-02")
+		analyzedEvent.Event.StartDate = &startDate
+	}
+	eventData := mapAnalyzedEventToCalendarEvent(
+		ctx,
+		chat.customerID,
+		chat.chatID,
+		chat.msgs,
+		analyzedEvent,
+		chat.prayerSettings,
+	)
+	eventData.Action = wasappcalendar.CalendarEventAction_Update
+	eventData.UID = chatEvent.EventUid
+
+	err := c.recordDetectedEventChange(ctx, chat, analyzedEvent, eventData)
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running recordDetectedEventChange")
+	}
+
+	detectedEventState, err := c.detectedEventState(ctx, chat.customerID, chatEvent.EventUid)
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running detectedEventState")
+	}
+	if isInCalendar(detectedEventState) {
+		err = c.calendarProducer.PublishEvent(ctx, eventData)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed to publish event update to calendar queue")
+			return
+		}
+	}
+
+	updatedChatEvent, err := c.store.UpdateWasappChatEvent(ctx, store.UpdateWasappChatEventParams{
+		CustomerID: chat.customerID,
+		EventUid:   chatEvent.EventUid,
+		Summary:    eventData.Summary,
+		StartTime:  eventData.StartTime,
+		EndTime:    eventData.EndTime,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running store.UpdateWasappChatEvent")
+		return
+	}
+	*chatEvent = updatedChatEvent
+}
+
+func (c *consumer) handleCancelledEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent) {
+	chatEvent := findChatEvent(chat.events, analyzedEvent.EventUID)
+	if chatEvent == nil {
+		log.Ctx(ctx).Warn().
+			Str("chat_id", chat.chatID).
+			Interface("event_uid", analyzedEvent.EventUID).
+			Msg("event cancellation does not match an upcoming event of the chat, ignoring it")
+		return
+	}
+
+	log.Ctx(ctx).Info().
+		Str("chat_id", chat.chatID).
+		Str("event_uid", chatEvent.EventUid).
+		Msg("event cancelled, proceeding to remove it from the calendar")
+
+	eventData := wasappcalendar.CalendarEventData{
+		Action:     wasappcalendar.CalendarEventAction_Cancel,
+		UID:        chatEvent.EventUid,
+		CustomerID: chat.customerID,
+		ChatID:     chat.chatID,
+		Summary:    chatEvent.Summary,
+		StartTime:  chatEvent.StartTime,
+		EndTime:    chatEvent.EndTime,
+	}
+
+	err := c.recordDetectedEventChange(ctx, chat, analyzedEvent, eventData)
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running recordDetectedEventChange")
+	}
+
+	detectedEventState, err := c.detectedEventState(ctx, chat.customerID, chatEvent.EventUid)
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running detectedEventState")
+	}
+	if detectedEventState == store.DetectedEventStatePending {
+		// the customer did not add it to the calendar yet, so there is nothing to delete
+		err = c.store.SetDetectedEventStateByCaldavUid(ctx, store.SetDetectedEventStateByCaldavUidParams{
+			CustomerID: chat.customerID,
+			CaldavUid:  sql.NullString{String: chatEvent.EventUid, Valid: true},
+			State:      store.DetectedEventStateCancelled,
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed running store.SetDetectedEventStateByCaldavUid")
+		}
+	} else if isInCalendar(detectedEventState) {
+		err = c.calendarProducer.PublishEvent(ctx, eventData)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed to publish event cancellation to calendar queue")
+			return
+		}
+	}
+
+	err = c.store.DeleteWasappChatEventByUid(ctx, store.DeleteWasappChatEventByUidParams{
+		CustomerID: chat.customerID,
+		EventUid:   chatEvent.EventUid,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running store.DeleteWasappChatEventByUid")
+		return
+	}
+	chat.events = removeChatEvent(chat.events, chatEvent.EventUid)
+	chat.dropChat = true
+}
+
+func (c *consumer) handleDeniedEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent) {
+	if chat.hold != nil && !chat.hasSuggestion {
+		err := c.releaseHold(ctx, chat, analyzedEvent)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed running releaseHold")
+		}
+	} else {
+		var deniedEvent *wasappcalendar.CalendarEventData
+		if analyzedEvent.Event != nil {
+			eventData := mapAnalyzedEventToCalendarEvent(
+				ctx,
+				chat.customerID,
+				chat.chatID,
+				chat.msgs,
+				analyzedEvent,
+				chat.prayerSettings,
+			)
+			deniedEvent = &eventData
+		}
+		_, err := c.recordDetectedEvent(ctx, chat, analyzedEvent, store.DetectedEventStateRejected, deniedEvent)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed running recordDetectedEvent")
+		}
+	}
+
+	log.Ctx(ctx).Info().
+		Str("chat_id", chat.chatID).
+		Msg("event denied")
+	chat.dropChat = true
+}
+
+func (c *consumer) handleNotConfirmedEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent) {
+	if !chat.preference.TentativeHolds || chat.preference.EventApprovalMode != store.EventApprovalModeAuto || analyzedEvent.Event == nil || chat.suggestionHeld {
+		log.Ctx(ctx).Debug().
+			Str("chat_id", chat.chatID).
+			Msg("event not confirmed yet, keeping chat")
+		return
+	}
+
+	eventData := mapAnalyzedEventToCalendarEvent(
+		ctx,
+		chat.customerID,
+		chat.chatID,
+		chat.msgs,
+		analyzedEvent,
+		chat.prayerSettings,
+	)
+	if isKnownChatEvent(chat.events, analyzedEvent.EventUID, eventData) {
+		log.Ctx(ctx).Debug().
+			Str("chat_id", chat.chatID).
+			Msg("suggested event was already agreed on in this chat, not holding it")
+		return
+	}
+
+	chat.suggestionHeld = true
+	err := c.holdEvent(ctx, chat, analyzedEvent, eventData)
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running holdEvent")
+		return
+	}
+	log.Ctx(ctx).Info().
+		Str("chat_id", chat.chatID).
+		Msg("event not confirmed yet, holding it in the calendar as tentative")
+}
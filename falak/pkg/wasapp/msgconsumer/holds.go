@@ -0,0 +1,168 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+)
+
+// splitTentativeChatEvent separates the events the chat agreed on from the tentative hold of the event that is still
+// being discussed, a chat has one hold at most.
+func splitTentativeChatEvent(events []store.WasappChatEvent) ([]store.WasappChatEvent, *store.WasappChatEvent) {
+	var agreed []store.WasappChatEvent
+	var hold *store.WasappChatEvent
+	for idx := range events {
+		if events[idx].Tentative {
+			hold = &events[idx]
+			continue
+		}
+		agreed = append(agreed, events[idx])
+	}
+	return agreed, hold
+}
+
+// holdEvent puts a suggested event in the calendar as tentative, or moves the chat's hold when the suggestion changed
+func (c *consumer) holdEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent, eventData wasappcalendar.CalendarEventData) error {
+	eventData.Tentative = true
+
+	hold := chat.hold
+	if hold == nil {
+		eventData.Action = wasappcalendar.CalendarEventAction_Add
+		eventData.UID = newChatEventUID()
+
+		_, err := c.recordDetectedEvent(ctx, chat, analyzedEvent, store.DetectedEventStateProposed, &eventData)
+		if err != nil {
+			return err
+		}
+
+		err = c.calendarProducer.PublishEvent(ctx, eventData)
+		if err != nil {
+			return fmt.Errorf("failed to publish hold to calendar queue: %w", err)
+		}
+
+		newHold, err := c.store.AddWasappChatEvent(ctx, store.AddWasappChatEventParams{
+			CustomerID: eventData.CustomerID,
+			ChatID:     eventData.ChatID,
+			EventUid:   eventData.UID,
+			Summary:    eventData.Summary,
+			StartTime:  eventData.StartTime,
+			EndTime:    eventData.EndTime,
+			Tentative:  true,
+		})
+		if err != nil {
+			return fmt.Errorf("failed running AddWasappChatEvent: %w", err)
+		}
+		chat.hold = &newHold
+		return nil
+	}
+
+	if hold.Summary == eventData.Summary && hold.StartTime.Equal(eventData.StartTime) && hold.EndTime.Equal(eventData.EndTime) {
+		return nil
+	}
+
+	eventData.Action = wasappcalendar.CalendarEventAction_Update
+	eventData.UID = hold.EventUid
+
+	err := c.recordDetectedEventChange(ctx, chat, analyzedEvent, eventData)
+	if err != nil {
+		return err
+	}
+
+	err = c.calendarProducer.PublishEvent(ctx, eventData)
+	if err != nil {
+		return fmt.Errorf("failed to publish hold update to calendar queue: %w", err)
+	}
+
+	updatedHold, err := c.store.UpdateWasappChatEvent(ctx, store.UpdateWasappChatEventParams{
+		CustomerID: eventData.CustomerID,
+		EventUid:   hold.EventUid,
+		Summary:    eventData.Summary,
+		StartTime:  eventData.StartTime,
+		EndTime:    eventData.EndTime,
+	})
+	if err != nil {
+		return fmt.Errorf("failed running UpdateWasappChatEvent: %w", err)
+	}
+	chat.hold = &updatedHold
+	return nil
+}
+
+// confirmHold turns the chat's hold into the agreed event, adding it again under the same UID replaces the tentative
+// one in the calendar.
+func (c *consumer) confirmHold(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent, eventData wasappcalendar.CalendarEventData) error {
+	hold := *chat.hold
+	eventData.Action = wasappcalendar.CalendarEventAction_Add
+	eventData.UID = hold.EventUid
+	eventData.Tentative = false
+
+	err := c.recordDetectedEventChange(ctx, chat, analyzedEvent, eventData)
+	if err != nil {
+		return err
+	}
+
+	err = c.calendarProducer.PublishEvent(ctx, eventData)
+	if err != nil {
+		return fmt.Errorf("failed to publish confirmed hold to calendar queue: %w", err)
+	}
+
+	confirmedEvent, err := c.store.UpdateWasappChatEvent(ctx, store.UpdateWasappChatEventParams{
+		CustomerID: eventData.CustomerID,
+		EventUid:   hold.EventUid,
+		Summary:    eventData.Summary,
+		StartTime:  eventData.StartTime,
+		EndTime:    eventData.EndTime,
+	})
+	if err != nil {
+		return fmt.Errorf("failed running UpdateWasappChatEvent: %w", err)
+	}
+
+	err = c.store.SetWasappChatEventTentative(ctx, store.SetWasappChatEventTentativeParams{
+		CustomerID: eventData.CustomerID,
+		EventUid:   hold.EventUid,
+		Tentative:  false,
+	})
+	if err != nil {
+		return fmt.Errorf("failed running SetWasappChatEventTentative: %w", err)
+	}
+	confirmedEvent.Tentative = false
+	chat.events = append(chat.events, confirmedEvent)
+	chat.hold = nil
+	return nil
+}
+
+// releaseHold removes the chat's hold from the calendar and drops the chat's link to it
+func (c *consumer) releaseHold(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent) error {
+	hold := *chat.hold
+	eventData := wasappcalendar.CalendarEventData{
+		Action:     wasappcalendar.CalendarEventAction_Release,
+		UID:        hold.EventUid,
+		CustomerID: hold.CustomerID,
+		ChatID:     hold.ChatID,
+		Summary:    hold.Summary,
+		StartTime:  hold.StartTime,
+		EndTime:    hold.EndTime,
+	}
+
+	err := c.recordDetectedEventChange(ctx, chat, analyzedEvent, eventData)
+	if err != nil {
+		return err
+	}
+
+	err = c.calendarProducer.PublishEvent(ctx, eventData)
+	if err != nil {
+		return fmt.Errorf("failed to publish hold release to calendar queue: %w", err)
+	}
+
+	err = c.store.DeleteWasappChatEventByUid(ctx, store.DeleteWasappChatEventByUidParams{
+		CustomerID: hold.CustomerID,
+		EventUid:   hold.EventUid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed running DeleteWasappChatEventByUid: %w", err)
+	}
+	chat.hold = nil
+	return nil
+}
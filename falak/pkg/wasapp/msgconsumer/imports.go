@@ -0,0 +1,449 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/encryptionsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/notificationsvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
+	wasappchatexport "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/chatexport"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	chatImportsPollInterval = 5 * time.Second
+	// chatImportsBatchSize is how many imports are claimed at a time, an import can take a while so they are claimed
+	// one by one
+	chatImportsBatchSize = 1
+	// chatImportLease is how long a claimed import is left to the consumer that claimed it, it is renewed after every
+	// window so it only runs out when the consumer died
+	chatImportLease = 10 * time.Minute
+	// chatImportRetryDelay is how long an import whose analysis failed waits to be picked up again
+	chatImportRetryDelay = time.Minute
+	// maxChatImportAttempts is how many times in a row an import is tried without getting through a window before
+	// it fails
+	maxChatImportAttempts = 10
+
+	// chatImportMaxAge is how far back the messages of an export are analyzed, the plans made before it are long done
+	chatImportMaxAge = 180 * 24 * time.Hour
+	// chatImportMaxMessages is how many of the latest messages of an export are analyzed
+	chatImportMaxMessages = 5000
+	// chatImportWindowOverlap is how many messages of a window are analyzed again with the next one, so an event
+	// agreed on across the two windows is not missed
+	chatImportWindowOverlap = 10
+)
+
+// importChatID is the chat id the events found in an exported chat are recorded under, exported chats do not have
+// the id WhatsApp gives the chat
+func importChatID(chatImportID uuid.UUID) string {
+	return "import:" + chatImportID.String()
+}
+
+// analyzeChatImports analyzes the exported chats customers imported until the consumer stops
+func (c *consumer) analyzeChatImports(ctx context.Context) {
+	ticker := time.NewTicker(chatImportsPollInterval)
+	defer ticker.Stop()
+
+	for {
+		c.analyzeClaimedChatImports(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Ctx(ctx).Info().Msg("context cancelled, stopping chat imports analysis")
+			return
+		case <-c.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *consumer) analyzeClaimedChatImports(ctx context.Context) {
+	chatImports, err := c.store.ClaimWasappChatImports(ctx, store.ClaimWasappChatImportsParams{
+		LeaseSeconds: chatImportLease.Seconds(),
+		MaxImports:   chatImportsBatchSize,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running store.ClaimWasappChatImports")
+		return
+	}
+
+	for _, chatImport := range chatImports {
+		importErr := c.analyzeChatImport(ctx, chatImport)
+		if importErr == nil {
+			continue
+		}
+
+		log.Ctx(ctx).Err(importErr).
+			Str("chat_import_id", chatImport.ID.String()).
+			Msg("failed running analyzeChatImport, the import is picked up again later")
+
+		attempts, err := c.store.PostponeWasappChatImport(ctx, store.PostponeWasappChatImportParams{
+			ID:           chatImport.ID,
+			RetrySeconds: chatImportRetryDelay.Seconds(),
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_import_id", chatImport.ID.String()).
+				Msg("failed running store.PostponeWasappChatImport")
+			continue
+		}
+		if attempts < maxChatImportAttempts {
+			continue
+		}
+
+		err = c.failChatImport(ctx, chatImport, fmt.Errorf("gave up after %d attempts: %w", attempts, importErr))
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_import_id", chatImport.ID.String()).
+				Msg("failed running failChatImport")
+		}
+	}
+}
+
+// analyzeChatImport analyzes the exported chat in windows, the events agreed on for after now are recorded as
+// pending detected events for the customer to add the ones they want. The windows that were analyzed are kept track
+// of, so an import that is picked up again goes on from where it stopped. An error means the import has to be
+// analyzed again.
+func (c *consumer) analyzeChatImport(ctx context.Context, chatImport store.ClaimWasappChatImportsRow) error {
+	chatID := importChatID(chatImport.ID)
+
+	preference, err := c.customerPreference(ctx, chatImport.CustomerID)
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chatID).
+			Msg("failed running customerPreference, using the default preference")
+		preference = store.DefaultCustomerPreference()
+	}
+
+	prayerSettings, err := c.prayerSvc.GetCustomerSettings(ctx, chatImport.CustomerID)
+	if err != nil {
+		if !errors.Is(err, prayersvc.ErrNoSettings) {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chatID).
+				Msg("failed running prayerSvc.GetCustomerSettings, using the default prayer settings")
+		}
+		prayerSettings = prayersvc.DefaultSettings()
+	}
+
+	export, err := c.encryptionSvc.DecryptNull(ctx, chatImport.CustomerID, chatImport.Export)
+	if errors.Is(err, encryptionsvc.ErrInvalidCiphertext) || errors.Is(err, encryptionsvc.ErrDataKeyShredded) {
+		// the export can never be read, so trying again is not going to go any better
+		return c.failChatImport(ctx, chatImport, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decrypt the export: %w", err)
+	}
+
+	exportedMsgs, err := wasappchatexport.Parse(export.String, prayerSettings.Location.Timezone, chatImport.Locale)
+	if err != nil {
+		// the export was read when it was uploaded, so reading it again is not going to go any better
+		return c.failChatImport(ctx, chatImport, err)
+	}
+	msgs := mapExportedMessagesToChatMessages(chatImport, exportedMsgs)
+	windows := chatImportWindows(len(msgs), c.window.MaxMessages)
+
+	chat := &analyzedChat{
+		customerID:     chatImport.CustomerID,
+		chatID:         chatID,
+		preference:     preference,
+		prayerSettings: prayerSettings,
+		chatImportID:   uuid.NullUUID{UUID: chatImport.ID, Valid: true},
+	}
+	for idx := int(chatImport.WindowsAnalyzed); idx < len(windows); idx++ {
+		chat.msgs = msgs[windows[idx][0]:windows[idx][1]]
+		if err := c.analyzeChatImportWindow(ctx, chat, chatImport.IsGroup, idx); err != nil {
+			return fmt.Errorf("failed running analyzeChatImportWindow: %w", err)
+		}
+
+		err = c.store.SetWasappChatImportProgress(ctx, store.SetWasappChatImportProgressParams{
+			ID:              chatImport.ID,
+			WindowsCount:    int32(len(windows)),
+			WindowsAnalyzed: int32(idx + 1),
+			LeaseSeconds:    chatImportLease.Seconds(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed running SetWasappChatImportProgress: %w", err)
+		}
+	}
+
+	pendingEvents, err := c.chatImportEvents(ctx, chat)
+	if err != nil {
+		return fmt.Errorf("failed running chatImportEvents: %w", err)
+	}
+	// the events were upcoming when they were found, the analysis of a long chat can outlast a few of them
+	var upcomingEvents []store.DetectedEvent
+	for _, pendingEvent := range pendingEvents {
+		if pendingEvent.StartTime.Time.After(time.Now()) {
+			upcomingEvents = append(upcomingEvents, pendingEvent)
+		}
+	}
+
+	err = c.store.FinishWasappChatImport(ctx, store.FinishWasappChatImportParams{
+		ID:          chatImport.ID,
+		EventsCount: int32(len(upcomingEvents)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed running FinishWasappChatImport: %w", err)
+	}
+
+	log.Ctx(ctx).Info().
+		Str("chat_import_id", chatImport.ID.String()).
+		Int("messages_count", len(msgs)).
+		Int("windows_count", len(windows)).
+		Int("events_count", len(upcomingEvents)).
+		Msg("chat import analysis completed")
+
+	err = c.notifyChatImportDone(ctx, chatImport, len(upcomingEvents))
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_import_id", chatImport.ID.String()).
+			Msg("failed running notifyChatImportDone")
+	}
+	return nil
+}
+
+// analyzeChatImportWindow analyzes the messages of the window at index window, the events found in the windows before
+// it are known to the analysis so it can tell when they changed or were called off
+func (c *consumer) analyzeChatImportWindow(ctx context.Context, chat *analyzedChat, isGroup bool, window int) error {
+	knownEvents, err := c.chatImportEvents(ctx, chat)
+	if err != nil {
+		return fmt.Errorf("failed running chatImportEvents: %w", err)
+	}
+
+	quota, err := c.checkChatImportQuota(ctx, chat.customerID, window)
+	if err != nil {
+		// a broken quota check is not the customer's fault, so the window is analyzed as usual
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chat.chatID).
+			Msg("failed running checkChatImportQuota")
+	}
+
+	msgsForAnalysis := make([]wasappmsganalyzer.MessageForAnalysis, len(chat.msgs))
+	for idx, msg := range chat.msgs {
+		msgsForAnalysis[idx] = mapListChatMessagesRowToMessageForAnalysis(msg)
+	}
+	analysisResp, err := quota.analyzer.AnalyzeMessages(ctx, &wasappmsganalyzer.AnalyzeMessagesRequest{
+		CustomerID:    chat.customerID,
+		Messages:      msgsForAnalysis,
+		Events:        mapDetectedEventsToKnownEvents(knownEvents),
+		IsGroup:       isGroup,
+		AgreementRule: agreementRules[chat.preference.GroupAgreementRule],
+		Timezone:      chat.prayerSettings.Location.Timezone,
+		// "tomorrow" in the window means the day after its last message was sent
+		Now: time.Unix(chat.msgs[len(chat.msgs)-1].Timestamp, 0),
+	})
+	if err != nil {
+		var invalidResponseErr *wasappmsganalyzer.InvalidResponseError
+		if !errors.As(err, &invalidResponseErr) {
+			return fmt.Errorf("failed running AnalyzeMessages: %w", err)
+		}
+
+		// the window is skipped, analyzing it again is not going to fix the response
+		err = c.recordAnalysisFailure(ctx, chat.customerID, chat.chatID, chat.msgs, invalidResponseErr)
+		if err != nil {
+			log.Ctx(ctx).Err(err).
+				Str("chat_id", chat.chatID).
+				Msg("failed running recordAnalysisFailure")
+		}
+		return nil
+	}
+	chat.analysisResp = analysisResp
+
+	knownUIDs := map[string]bool{}
+	for _, knownEvent := range knownEvents {
+		knownUIDs[knownEvent.CaldavUid.String] = true
+	}
+	for idx := range analysisResp.Events {
+		analyzedEvent := &analysisResp.Events[idx]
+		switch analyzedEvent.Status {
+		case wasappmsganalyzer.AnalyzeMessagesStatus_HasEventAgreed:
+			err = c.recordChatImportEvent(ctx, chat, analyzedEvent, knownEvents)
+		case wasappmsganalyzer.AnalyzeMessagesStatus_EventUpdated:
+			if analyzedEvent.EventUID == nil || !knownUIDs[*analyzedEvent.EventUID] {
+				continue
+			}
+			err = c.updateChatImportEvent(ctx, chat, analyzedEvent)
+		case wasappmsganalyzer.AnalyzeMessagesStatus_EventCancelled:
+			if analyzedEvent.EventUID == nil || !knownUIDs[*analyzedEvent.EventUID] {
+				continue
+			}
+			err = c.store.SetDetectedEventStateByCaldavUid(ctx, store.SetDetectedEventStateByCaldavUidParams{
+				CustomerID: chat.customerID,
+				CaldavUid:  sql.NullString{String: *analyzedEvent.EventUID, Valid: true},
+				State:      store.DetectedEventStateCancelled,
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordChatImportEvent records an event the exported chat agreed on, unless it already passed, has no date to tell
+// when it is, or was found in an earlier window which happens when it was agreed on in the messages the windows share
+func (c *consumer) recordChatImportEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent, knownEvents []store.DetectedEvent) error {
+	eventData, ok := c.chatImportEventData(ctx, chat, analyzedEvent)
+	if !ok {
+		return nil
+	}
+	for _, knownEvent := range knownEvents {
+		if knownEvent.StartTime.Time.Equal(eventData.StartTime) && strings.EqualFold(knownEvent.Summary.String, eventData.Summary) {
+			return nil
+		}
+	}
+
+	eventData.UID = newChatEventUID()
+	_, err := c.recordDetectedEvent(ctx, chat, analyzedEvent, store.DetectedEventStatePending, &eventData)
+	if err != nil {
+		return fmt.Errorf("failed running recordDetectedEvent: %w", err)
+	}
+	return nil
+}
+
+// updateChatImportEvent moves an event found in an earlier window to the details the chat changed it to
+func (c *consumer) updateChatImportEvent(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent) error {
+	eventData, ok := c.chatImportEventData(ctx, chat, analyzedEvent)
+	if !ok {
+		return nil
+	}
+
+	eventData.UID = *analyzedEvent.EventUID
+	err := c.recordDetectedEventChange(ctx, chat, analyzedEvent, eventData)
+	if err != nil {
+		return fmt.Errorf("failed running recordDetectedEventChange: %w", err)
+	}
+	return nil
+}
+
+// chatImportEventData builds the event an exported chat talks about, ok is false when the event has no date as the
+// missing date can not be taken to be tomorrow like it is for the messages that just came in
+func (c *consumer) chatImportEventData(ctx context.Context, chat *analyzedChat, analyzedEvent *wasappmsganalyzer.AnalyzedEvent) (eventData wasappcalendar.CalendarEventData, ok bool) {
+	if analyzedEvent.Event == nil || analyzedEvent.Event.StartDate == nil {
+		return eventData, false
+	}
+
+	eventData = mapAnalyzedEventToCalendarEvent(ctx, chat.customerID, chat.chatID, chat.msgs, analyzedEvent, chat.prayerSettings)
+	return eventData, eventData.StartTime.After(time.Now())
+}
+
+// chatImportEvents returns the events found in the exported chat so far that are still waiting for the customer
+func (c *consumer) chatImportEvents(ctx context.Context, chat *analyzedChat) ([]store.DetectedEvent, error) {
+	detectedEvents, err := c.store.ListDetectedEventsByChatImportId(ctx, store.ListDetectedEventsByChatImportIdParams{
+		CustomerID:   chat.customerID,
+		ChatImportID: chat.chatImportID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed running ListDetectedEventsByChatImportId: %w", err)
+	}
+
+	var pendingEvents []store.DetectedEvent
+	for _, detectedEvent := range detectedEvents {
+		if detectedEvent.State == store.DetectedEventStatePending {
+			pendingEvents = append(pendingEvents, detectedEvent)
+		}
+	}
+	return pendingEvents, nil
+}
+
+func (c *consumer) failChatImport(ctx context.Context, chatImport store.ClaimWasappChatImportsRow, importErr error) error {
+	log.Ctx(ctx).Err(importErr).
+		Str("chat_import_id", chatImport.ID.String()).
+		Msg("chat import failed")
+
+	err := c.store.FailWasappChatImport(ctx, store.FailWasappChatImportParams{
+		ID:    chatImport.ID,
+		Error: sql.NullString{String: importErr.Error(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed running FailWasappChatImport: %w", err)
+	}
+	return nil
+}
+
+// notifyChatImportDone lets the customer know the events of the exported chat are ready to be looked at
+func (c *consumer) notifyChatImportDone(ctx context.Context, chatImport store.ClaimWasappChatImportsRow, eventsCount int) error {
+	chatName := chatImport.ChatName
+	if chatName == "" {
+		chatName = "the chat"
+	}
+
+	body := fmt.Sprintf("No upcoming events were found in %s", chatName)
+	if eventsCount == 1 {
+		body = fmt.Sprintf("1 upcoming event was found in %s, pick the ones to add to your calendar", chatName)
+	} else if eventsCount > 1 {
+		body = fmt.Sprintf("%d upcoming events were found in %s, pick the ones to add to your calendar", eventsCount, chatName)
+	}
+
+	return c.notificationSvc.SendNotificationToCustomerDevices(ctx, &notificationsvc.SendNotificationToCustomerDevicesRequest{
+		CustomerId: chatImport.CustomerID,
+		AlertTitle: "📥 WhatsApp Chat Imported",
+		AlertBody:  body,
+	})
+}
+
+// mapExportedMessagesToChatMessages turns the latest messages of the export into chat messages, the messages keep
+// their place in the export in their ids so they are the same every time the export is read. How old the messages
+// can be is counted from when the chat was imported, so an import that is picked up again gets the same windows.
+func mapExportedMessagesToChatMessages(chatImport store.ClaimWasappChatImportsRow, exportedMsgs []wasappchatexport.Message) []store.ListChatMessagesRow {
+	oldest := chatImport.CreatedAt.Add(-chatImportMaxAge)
+	first := max(len(exportedMsgs)-chatImportMaxMessages, 0)
+	for first < len(exportedMsgs) && exportedMsgs[first].SentAt.Before(oldest) {
+		first++
+	}
+
+	chatID := importChatID(chatImport.ID)
+	msgs := make([]store.ListChatMessagesRow, 0, len(exportedMsgs)-first)
+	for idx := first; idx < len(exportedMsgs); idx++ {
+		exportedMsg := exportedMsgs[idx]
+		msgs = append(msgs, store.ListChatMessagesRow{
+			MessageID:  fmt.Sprintf("%s:%d", chatID, idx),
+			SenderName: exportedMsg.SenderName,
+			IsSenderMe: chatImport.SenderName != "" && strings.EqualFold(exportedMsg.SenderName, chatImport.SenderName),
+			Timestamp:  exportedMsg.SentAt.Unix(),
+			Body:       exportedMsg.Body,
+			ChatID:     chatID,
+			CustomerID: chatImport.CustomerID,
+		})
+	}
+	return msgs
+}
+
+// chatImportWindows splits msgsCount messages into windows of windowSize that overlap, every window is the start and
+// end of its messages
+func chatImportWindows(msgsCount, windowSize int) [][2]int {
+	step := max(windowSize-chatImportWindowOverlap, 1)
+
+	var windows [][2]int
+	for start := 0; start < msgsCount; start += step {
+		end := min(start+windowSize, msgsCount)
+		windows = append(windows, [2]int{start, end})
+		if end == msgsCount {
+			break
+		}
+	}
+	return windows
+}
+
+func mapDetectedEventsToKnownEvents(detectedEvents []store.DetectedEvent) []wasappmsganalyzer.KnownEvent {
+	knownEvents := make([]wasappmsganalyzer.KnownEvent, len(detectedEvents))
+	for idx, detectedEvent := range detectedEvents {
+		knownEvents[idx] = wasappmsganalyzer.KnownEvent{
+			UID:   detectedEvent.CaldavUid.String,
+			Title: detectedEvent.Summary.String,
+			Start: detectedEvent.StartTime.Time,
+			End:   detectedEvent.EndTime.Time,
+		}
+	}
+	return knownEvents
+}
@@ -2,55 +2,82 @@ package wasappmsgconsumer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/hijri"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/prayer"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/services/prayersvc"
 	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
 	wasappcalendar "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/calendar"
 	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
 	"github.com/rs/zerolog/log"
 )
 
-func mapAddMessageToChatReturningMessagesRowToMessageForAnalysis(row store.AddMessageToChatReturningMessagesRow) wasappmsganalyzer.MessageForAnalysis {
-	return wasappmsganalyzer.MessageForAnalysis{
+func mapListChatMessagesRowToMessageForAnalysis(row store.ListChatMessagesRow) wasappmsganalyzer.MessageForAnalysis {
+	msg := wasappmsganalyzer.MessageForAnalysis{
 		SenderName: row.SenderName,
-		Body:       row.DecryptedBody,
+		IsSenderMe: row.IsSenderMe,
+		Body:       row.Body,
 		Timestamp:  row.Timestamp,
 	}
+	if row.QuotedMessageID.Valid {
+		msg.QuotedMessage = &wasappmsganalyzer.QuotedMessage{
+			SenderName: row.QuotedSenderName.String,
+			IsSenderMe: row.QuotedIsSenderMe.Bool,
+			Body:       row.QuotedBody.String,
+			Timestamp:  row.QuotedTimestamp.Int64,
+		}
+	}
+	return msg
 }
 
-func mapAnalysisResponseToCalendarEvent(ctx context.Context, customerID uuid.UUID, chatID string, analysisResp *wasappmsganalyzer.AnalyzeMessagesResponse) wasappcalendar.CalendarEventData {
+var agreementRules = map[store.GroupAgreementRule]wasappmsganalyzer.AgreementRule{
+	store.GroupAgreementRuleCustomer:  wasappmsganalyzer.AgreementRule_Customer,
+	store.GroupAgreementRuleMajority:  wasappmsganalyzer.AgreementRule_Majority,
+	store.GroupAgreementRuleOrganizer: wasappmsganalyzer.AgreementRule_Organizer,
+}
+
+// mapAnalyzedEventToCalendarEvent builds the event the analysis found, msgs are the chat messages it was found in
+// and fill the details the analysis does not have, like who takes part in the event.
+func mapAnalyzedEventToCalendarEvent(ctx context.Context, customerID uuid.UUID, chatID string, msgs []store.ListChatMessagesRow, analyzedEvent *wasappmsganalyzer.AnalyzedEvent, prayerSettings *prayersvc.CustomerSettings) wasappcalendar.CalendarEventData {
 	title := fmt.Sprintf("WhatsApp Event: %s", chatID)
-	if analysisResp.Event.Title != nil {
-		title = *analysisResp.Event.Title
+	if analyzedEvent.Event.Title != nil {
+		title = *analyzedEvent.Event.Title
 	}
 
 	description := fmt.Sprintf("Event extracted from WhatsApp chat: %s", chatID)
-	if analysisResp.Event.Notes != nil {
-		description = *analysisResp.Event.Notes
+	if analyzedEvent.Event.Notes != nil {
+		description = *analyzedEvent.Event.Notes
+	}
+
+	location := ""
+	if analyzedEvent.Event.Location != nil {
+		location = *analyzedEvent.Event.Location
 	}
 
 	rawStartDate := ""
-	if analysisResp.Event.StartDate != nil {
-		rawStartDate = *analysisResp.Event.StartDate
+	if analyzedEvent.Event.StartDate != nil {
+		rawStartDate = *analyzedEvent.Event.StartDate
 	}
 
 	rawStartTime := ""
-	if analysisResp.Event.StartTime != nil {
-		rawStartTime = *analysisResp.Event.StartTime
+	if analyzedEvent.Event.StartTime != nil {
+		rawStartTime = *analyzedEvent.Event.StartTime
 	}
 
 	rawEndDate := ""
-	if analysisResp.Event.EndDate != nil {
-		rawEndDate = *analysisResp.Event.EndDate
+	if analyzedEvent.Event.EndDate != nil {
+		rawEndDate = *analyzedEvent.Event.EndDate
 	}
 
 	rawEndTime := ""
-	if analysisResp.Event.EndTime != nil {
-		rawEndTime = *analysisResp.Event.EndTime
+	if analyzedEvent.Event.EndTime != nil {
+		rawEndTime = *analyzedEvent.Event.EndTime
 	}
 
 	startTime, endTime := mapEventStringsToDateTimes(
@@ -59,25 +86,73 @@ func mapAnalysisResponseToCalendarEvent(ctx context.Context, customerID uuid.UUI
 		rawStartTime,
 		rawEndDate,
 		rawEndTime,
+		prayerSettings.Location.Timezone,
 	)
 
+	if anchor := analyzedEvent.Event.StartPrayerAnchor; anchor != nil {
+		// a prayer without a date is the next one after the chat's last message, so analyzing the same messages
+		// again later resolves it to the same day
+		agreedAt := time.Now()
+		if len(msgs) > 0 {
+			agreedAt = time.Unix(msgs[len(msgs)-1].Timestamp, 0)
+		}
+
+		day := startTime
+		if rawStartDate == "" {
+			day = agreedAt.In(prayerSettings.Location.Timezone)
+		}
+
+		resolved, err := resolvePrayerAnchor(day, *anchor, prayerSettings)
+		if err == nil && rawStartDate == "" && resolved.Before(agreedAt) {
+			// the prayer already passed today, so it must be meant for tomorrow
+			resolved, err = resolvePrayerAnchor(day.AddDate(0, 0, 1), *anchor, prayerSettings)
+		}
+		if err != nil {
+			log.Ctx(ctx).Warn().
+				Interface("anchor", anchor).
+				Err(err).
+				Msg("failed to resolve start prayer anchor")
+		} else {
+			startTime = resolved
+			if rawEndDate == "" && rawEndTime == "" {
+				endTime = startTime.Add(1 * time.Hour)
+			}
+		}
+	}
+
+	if anchor := analyzedEvent.Event.EndPrayerAnchor; anchor != nil {
+		resolved, err := resolvePrayerAnchor(startTime, *anchor, prayerSettings)
+		if err != nil {
+			log.Ctx(ctx).Warn().
+				Interface("anchor", anchor).
+				Err(err).
+				Msg("failed to resolve end prayer anchor")
+		} else if resolved.After(startTime) {
+			endTime = resolved
+		}
+	}
+
 	return wasappcalendar.CalendarEventData{
-		CustomerID:  customerID,
-		ChatID:      chatID,
-		Summary:     title,
-		Description: description,
-		StartTime:   startTime,
-		EndTime:     endTime,
+		CustomerID:   customerID,
+		ChatID:       chatID,
+		Summary:      title,
+		Description:  description,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		FlexibleTime: rawStartTime == "" && analyzedEvent.Event.StartPrayerAnchor == nil,
+		Location:     location,
+		Geo:          findSharedLocation(msgs),
+		Attendees:    chatAttendees(msgs, analyzedEvent.Participants),
 	}
 }
 
-func mapEventStringsToDateTimes(ctx context.Context, startDate, startTime, endDate, endTime string) (time.Time, time.Time) {
-	now := time.Now()
+func mapEventStringsToDateTimes(ctx context.Context, startDate, startTime, endDate, endTime string, loc *time.Location) (time.Time, time.Time) {
+	now := time.Now().In(loc)
 	startDateTime := now.Add(24 * time.Hour)
 	endDateTime := now.Add(25 * time.Hour)
 
 	if startDate != "" {
-		parsedDate, err := time.Parse("2006-01-02", startDate)
+		parsedDate, err := parseEventDate(startDate, now, loc)
 		if err == nil {
 			startDateTime = time.Date(
 				parsedDate.Year(),
@@ -115,7 +190,7 @@ func mapEventStringsToDateTimes(ctx context.Context, startDate, startTime, endDa
 	}
 
 	if endDate != "" {
-		parsedDate, err := time.Parse("2006-01-02", endDate)
+		parsedDate, err := parseEventDate(endDate, now, loc)
 		if err == nil {
 			endDateTime = time.Date(
 				parsedDate.Year(),
@@ -158,3 +233,51 @@ func mapEventStringsToDateTimes(ctx context.Context, startDate, startTime, endDa
 
 	return startDateTime, endDateTime
 }
+
+// parseEventDate parses a date in the YYYY-MM-DD format, or a Hijri date like "15 Ramadan 1447" or "١٥ رمضان"
+// as people in the chats often agree on dates in the Hijri calendar.
+func parseEventDate(date string, now time.Time, loc *time.Location) (time.Time, error) {
+	parsedDate, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err == nil {
+		return parsedDate, nil
+	}
+
+	hijriDate, hijriErr := hijri.Parse(date, now)
+	if hijriErr != nil {
+		return time.Time{}, errors.Join(err, hijriErr)
+	}
+
+	return hijriDate.ToGregorian(loc)
+}
+
+const (
+	// defaultPrayerAnchorOffset is used when the chat says "after Isha" without saying how long after,
+	// it roughly covers the time between the adhan and the end of the prayer.
+	defaultPrayerAnchorOffset = 30 * time.Minute
+)
+
+// resolvePrayerAnchor returns the time the anchor points to on the day of day, using the customer's prayer times.
+func resolvePrayerAnchor(day time.Time, anchor wasappmsganalyzer.PrayerAnchor, settings *prayersvc.CustomerSettings) (time.Time, error) {
+	times, err := prayer.Compute(day, settings.Location, settings.Config)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	prayerTime := times.Get(prayer.Prayer(strings.ToLower(anchor.Prayer)))
+	if prayerTime.IsZero() {
+		return time.Time{}, fmt.Errorf("unknown prayer %q", anchor.Prayer)
+	}
+
+	offset := defaultPrayerAnchorOffset
+	if anchor.OffsetMinutes != nil {
+		offset = time.Duration(*anchor.OffsetMinutes) * time.Minute
+	}
+
+	switch anchor.Relation {
+	case wasappmsganalyzer.PrayerAnchorRelation_Before:
+		return prayerTime.Add(-offset), nil
+	case wasappmsganalyzer.PrayerAnchorRelation_After:
+		return prayerTime.Add(offset), nil
+	}
+	return time.Time{}, fmt.Errorf("unknown prayer anchor relation %q", anchor.Relation)
+}
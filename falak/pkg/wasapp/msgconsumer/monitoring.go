@@ -0,0 +1,40 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+)
+
+// IsChatMonitored tells if the new messages of a chat are stored and analyzed, a muted chat whose mute is over
+// follows the customer's monitoring mode again
+func IsChatMonitored(monitoring store.ChatMonitoring, mutedUntil sql.NullTime, mode store.WasappMonitoringMode, now time.Time) bool {
+	switch monitoring {
+	case store.ChatMonitoringIncluded:
+		return true
+	case store.ChatMonitoringExcluded:
+		return false
+	case store.ChatMonitoringMuted:
+		if !mutedUntil.Valid || now.Before(mutedUntil.Time) {
+			return false
+		}
+	}
+	return mode != store.WasappMonitoringModeAllowlist
+}
+
+// chatMonitored records that the chat of the message is active, so the customer can find it to change its
+// monitoring, and tells if the message is monitored
+func (c *consumer) chatMonitored(ctx context.Context, wasappMsg WasappMessage) (bool, error) {
+	chatSetting, err := c.store.TouchWasappChatSetting(ctx, store.TouchWasappChatSettingParams{
+		CustomerID: wasappMsg.CustomerID,
+		ChatID:     wasappMsg.ChatID,
+		ChatName:   wasappMsg.ChatName,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed running TouchWasappChatSetting: %w", err)
+	}
+	return IsChatMonitored(chatSetting.Monitoring, chatSetting.MutedUntil, chatSetting.MonitoringMode, time.Now()), nil
+}
@@ -0,0 +1,79 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"time"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultChatInactiveAfter = 30 * 24 * time.Hour
+	chatPruneInterval        = time.Hour
+)
+
+// pruner deletes the chats that had no event activity for a while and have no upcoming event, their messages are
+// not needed anymore and the chat starts over with its next message.
+type pruner struct {
+	store         store.Queries
+	inactiveAfter time.Duration
+	stop          chan struct{}
+}
+
+func (p *pruner) Start(ctx context.Context) error {
+	log.Ctx(ctx).Info().
+		Dur("inactive_after", p.inactiveAfter).
+		Msg("starting chat pruner")
+
+	go func() {
+		ticker := time.NewTicker(chatPruneInterval)
+		defer ticker.Stop()
+
+		for {
+			p.pruneInactiveChats(ctx)
+
+			select {
+			case <-ctx.Done():
+				log.Ctx(ctx).Info().Msg("context cancelled, stopping chat pruner")
+				return
+			case <-p.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (p *pruner) pruneInactiveChats(ctx context.Context) {
+	prunedCount, err := p.store.DeleteInactiveChats(ctx, time.Now().Add(-p.inactiveAfter))
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running store.DeleteInactiveChats")
+		return
+	}
+
+	log.Ctx(ctx).Info().
+		Int64("pruned_count", prunedCount).
+		Msg("pruned inactive chats")
+}
+
+func (p *pruner) Stop(ctx context.Context) error {
+	log.Ctx(ctx).Info().Msg("stopping chat pruner")
+	close(p.stop)
+	log.Ctx(ctx).Info().Msg("chat pruner stopped successfully")
+	return nil
+}
+
+func NewPruner(store store.Queries, inactiveAfter time.Duration) Pruner {
+	if inactiveAfter <= 0 {
+		inactiveAfter = defaultChatInactiveAfter
+	}
+
+	return &pruner{
+		store:         store,
+		inactiveAfter: inactiveAfter,
+		stop:          make(chan struct{}),
+	}
+}
@@ -0,0 +1,117 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+)
+
+const defaultQuotaEveryNth = 5
+
+// QuotaMode is how the messages of a customer who used up their daily tokens are analyzed
+type QuotaMode string
+
+const (
+	// QuotaMode_Rules analyzes the messages with the rule based analyzer, which does not use the LLM
+	QuotaMode_Rules QuotaMode = "rules"
+	// QuotaMode_EveryNth keeps analyzing with the LLM but only every Nth time a chat is due, the messages of the
+	// skipped times are still analyzed along with the next one that is not skipped
+	QuotaMode_EveryNth QuotaMode = "every_nth"
+)
+
+// LlmQuota limits the LLM tokens the analysis of a customer's messages can use in a day
+type LlmQuota struct {
+	// DailyTokens is how many prompt and completion tokens a customer can use in a day, there is no limit when it is
+	// not positive
+	DailyTokens int64
+	Mode        QuotaMode
+	// EveryNth is how often a chat is analyzed with QuotaMode_EveryNth
+	EveryNth int
+	// Degraded is the analyzer used with QuotaMode_Rules
+	Degraded wasappmsganalyzer.Analyzer
+}
+
+// ParseQuotaMode reads the quota mode from the config, QuotaMode_Rules is used when s is empty
+func ParseQuotaMode(s string) (QuotaMode, error) {
+	switch mode := QuotaMode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case "":
+		return QuotaMode_Rules, nil
+	case QuotaMode_Rules, QuotaMode_EveryNth:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown quota mode: %s", s)
+	}
+}
+
+// quotaDecision is how a chat is analyzed once the quota was checked
+type quotaDecision struct {
+	analyzer wasappmsganalyzer.Analyzer
+	// skip is true when the chat is not analyzed this time
+	skip bool
+	// overQuota is true when the customer used up their daily tokens
+	overQuota bool
+}
+
+// checkQuota picks the analyzer for the chat, it is the usual analyzer unless the customer used up their daily tokens
+func (c *consumer) checkQuota(ctx context.Context, customerID uuid.UUID, chatID string) (quotaDecision, error) {
+	decision := quotaDecision{analyzer: c.msgAnalyzer}
+	overQuota, err := c.isOverQuota(ctx, customerID)
+	if err != nil || !overQuota {
+		return decision, err
+	}
+	decision.overQuota = true
+
+	if c.quota.Mode == QuotaMode_Rules {
+		decision.analyzer = c.quota.Degraded
+		return decision, nil
+	}
+
+	skippedMessages, err := c.store.CountSkippedChatMessage(ctx, store.CountSkippedChatMessageParams{
+		ChatID:     chatID,
+		CustomerID: customerID,
+		EveryNth:   int32(c.quota.EveryNth),
+	})
+	if err != nil {
+		return decision, fmt.Errorf("failed running CountSkippedChatMessage: %w", err)
+	}
+	// the count goes back to zero every Nth time
+	decision.skip = skippedMessages != 0
+	return decision, nil
+}
+
+// checkChatImportQuota picks the analyzer for a window of an imported chat the way checkQuota does for a chat. The
+// windows of an import can not wait to be analyzed with later ones, so with QuotaMode_EveryNth every Nth window is
+// analyzed with the LLM and the ones in between with the degraded analyzer.
+func (c *consumer) checkChatImportQuota(ctx context.Context, customerID uuid.UUID, window int) (quotaDecision, error) {
+	decision := quotaDecision{analyzer: c.msgAnalyzer}
+	overQuota, err := c.isOverQuota(ctx, customerID)
+	if err != nil || !overQuota {
+		return decision, err
+	}
+	decision.overQuota = true
+
+	if c.quota.Mode == QuotaMode_Rules || window%c.quota.EveryNth != 0 {
+		decision.analyzer = c.quota.Degraded
+	}
+	return decision, nil
+}
+
+// isOverQuota tells if the customer used up their daily tokens
+func (c *consumer) isOverQuota(ctx context.Context, customerID uuid.UUID) (bool, error) {
+	if c.quota.DailyTokens <= 0 {
+		return false, nil
+	}
+
+	tokens, err := c.store.GetWasappLlmTokensForDays(ctx, store.GetWasappLlmTokensForDaysParams{
+		CustomerID: customerID,
+		Days:       1,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed running GetWasappLlmTokensForDays: %w", err)
+	}
+	return tokens >= c.quota.DailyTokens, nil
+}
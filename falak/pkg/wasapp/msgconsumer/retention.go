@@ -0,0 +1,167 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultMessageRetention = store.DefaultWasappMessageRetentionHours * time.Hour
+	retentionPurgeInterval  = time.Hour
+	// retentionPurgeBatchSize is how many messages are deleted at once, so a large backlog of expired messages does
+	// not hold locks on the table for long
+	retentionPurgeBatchSize = 1000
+)
+
+// retentionMetrics counts what the pruner purged and how often it failed since the process started, they are
+// published with expvar under "wasapp_retention". The daily totals are kept in wasapp_retention_stat too.
+var retentionMetrics = expvar.NewMap("wasapp_retention")
+
+// retentionPruner hard deletes the messages that were stored longer than their customer keeps messages for, along
+// with the chat summaries made of them and the chats left empty. What the analysis took from the messages goes too,
+// the events detected in them are stripped of it and deleted once they are over, and the failed analyses are
+// deleted. Customers who did not pick how long their messages are kept get the default retention.
+type retentionPruner struct {
+	store            store.Queries
+	defaultRetention time.Duration
+	stop             chan struct{}
+}
+
+func (p *retentionPruner) Start(ctx context.Context) error {
+	log.Ctx(ctx).Info().
+		Dur("default_retention", p.defaultRetention).
+		Msg("starting message retention pruner")
+
+	go func() {
+		ticker := time.NewTicker(retentionPurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			p.purgeExpired(ctx)
+
+			select {
+			case <-ctx.Done():
+				log.Ctx(ctx).Info().Msg("context cancelled, stopping message retention pruner")
+				return
+			case <-p.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (p *retentionPruner) purgeExpired(ctx context.Context) {
+	defaultRetentionHours := int32(p.defaultRetention / time.Hour)
+
+	var messagesPurged int64
+	for {
+		purgedCount, err := p.store.PurgeExpiredMessages(ctx, store.PurgeExpiredMessagesParams{
+			DefaultRetentionHours: defaultRetentionHours,
+			BatchSize:             retentionPurgeBatchSize,
+		})
+		if err != nil {
+			log.Ctx(ctx).Err(err).Msg("failed running store.PurgeExpiredMessages")
+			retentionMetrics.Add("purge_failures", 1)
+			break
+		}
+		messagesPurged += purgedCount
+		if purgedCount < retentionPurgeBatchSize {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.stop:
+			return
+		default:
+		}
+	}
+
+	summariesPurged, err := p.store.PurgeExpiredChatSummaries(ctx, defaultRetentionHours)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running store.PurgeExpiredChatSummaries")
+		retentionMetrics.Add("purge_failures", 1)
+	}
+
+	detectedEventsPurged, err := p.store.PurgeExpiredDetectedEvents(ctx, defaultRetentionHours)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running store.PurgeExpiredDetectedEvents")
+		retentionMetrics.Add("purge_failures", 1)
+	}
+
+	// the events that are not over yet are kept without what was taken from the messages, so they can still be
+	// confirmed and tracked in the calendar
+	detectedEventsScrubbed, err := p.store.ScrubExpiredDetectedEvents(ctx, defaultRetentionHours)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running store.ScrubExpiredDetectedEvents")
+		retentionMetrics.Add("purge_failures", 1)
+	}
+
+	analysisFailuresPurged, err := p.store.PurgeExpiredAnalysisFailures(ctx, defaultRetentionHours)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running store.PurgeExpiredAnalysisFailures")
+		retentionMetrics.Add("purge_failures", 1)
+	}
+
+	chatsPurged, err := p.store.DeleteEmptyChats(ctx)
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running store.DeleteEmptyChats")
+		retentionMetrics.Add("purge_failures", 1)
+	}
+
+	log.Ctx(ctx).Info().
+		Int64("messages_purged", messagesPurged).
+		Int64("summaries_purged", summariesPurged).
+		Int64("chats_purged", chatsPurged).
+		Int64("detected_events_purged", detectedEventsPurged).
+		Int64("detected_events_scrubbed", detectedEventsScrubbed).
+		Int64("analysis_failures_purged", analysisFailuresPurged).
+		Msg("purged expired messages")
+	retentionMetrics.Add("messages_purged", messagesPurged)
+	retentionMetrics.Add("summaries_purged", summariesPurged)
+	retentionMetrics.Add("chats_purged", chatsPurged)
+	retentionMetrics.Add("detected_events_purged", detectedEventsPurged)
+	retentionMetrics.Add("detected_events_scrubbed", detectedEventsScrubbed)
+	retentionMetrics.Add("analysis_failures_purged", analysisFailuresPurged)
+
+	if messagesPurged == 0 && summariesPurged == 0 && chatsPurged == 0 {
+		return
+	}
+	err = p.store.AddWasappRetentionStat(ctx, store.AddWasappRetentionStatParams{
+		MessagesPurged:  messagesPurged,
+		SummariesPurged: summariesPurged,
+		ChatsPurged:     chatsPurged,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).Msg("failed running store.AddWasappRetentionStat")
+	}
+}
+
+func (p *retentionPruner) Stop(ctx context.Context) error {
+	log.Ctx(ctx).Info().Msg("stopping message retention pruner")
+	close(p.stop)
+	log.Ctx(ctx).Info().Msg("message retention pruner stopped successfully")
+	return nil
+}
+
+// NewRetentionPruner returns a pruner that purges expired messages, defaultRetention is how long messages are kept
+// for customers who did not pick it, it is rounded down to whole hours.
+func NewRetentionPruner(store store.Queries, defaultRetention time.Duration) Pruner {
+	if defaultRetention < time.Hour {
+		defaultRetention = defaultMessageRetention
+	}
+
+	return &retentionPruner{
+		store:            store,
+		defaultRetention: defaultRetention,
+		stop:             make(chan struct{}),
+	}
+}
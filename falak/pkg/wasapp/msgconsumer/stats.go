@@ -0,0 +1,72 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+	"github.com/rs/zerolog/log"
+)
+
+// analysisRecorder counts the analyses of every day by backend, prompt version and model, so the prompt versions
+// that are rolled out together can be compared, and adds up the LLM usage of every customer, summaries included
+type analysisRecorder struct {
+	store store.Queries
+}
+
+func (r *analysisRecorder) RecordAnalysis(ctx context.Context, outcome wasappmsganalyzer.AnalysisOutcome) {
+	params := store.IncrementWasappAnalysisStatParams{
+		AnalyzerBackend: string(outcome.Backend),
+		PromptVersion:   outcome.PromptVersion,
+		Model:           outcome.Model,
+		Successes:       1,
+	}
+	if outcome.Err != nil {
+		params.Successes, params.Failures = 0, 1
+	}
+
+	if err := r.store.IncrementWasappAnalysisStat(ctx, params); err != nil {
+		// losing a count is not worth failing the analysis for
+		log.Ctx(ctx).Warn().Err(err).
+			Str("analyzer_backend", params.AnalyzerBackend).
+			Str("prompt_version", params.PromptVersion).
+			Msg("failed running store.IncrementWasappAnalysisStat")
+	}
+
+	if outcome.Backend != wasappmsganalyzer.Backend_LLM {
+		return
+	}
+	r.addLlmUsage(ctx, outcome.CustomerID, outcome.Model, outcome.Usage, outcome.Latency)
+}
+
+func (r *analysisRecorder) RecordSummary(ctx context.Context, outcome wasappmsganalyzer.SummaryOutcome) {
+	if outcome.Err != nil {
+		return
+	}
+	r.addLlmUsage(ctx, outcome.CustomerID, outcome.Model, outcome.Usage, outcome.Latency)
+}
+
+func (r *analysisRecorder) addLlmUsage(ctx context.Context, customerID uuid.UUID, model string, usage wasappmsganalyzer.Usage, latency time.Duration) {
+	err := r.store.AddWasappLlmUsage(ctx, store.AddWasappLlmUsageParams{
+		CustomerID:       customerID,
+		Model:            model,
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		LatencyMs:        latency.Milliseconds(),
+	})
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).
+			Str("customer_id", customerID.String()).
+			Str("model", model).
+			Msg("failed running store.AddWasappLlmUsage")
+	}
+}
+
+func NewAnalysisRecorder(store store.Queries) wasappmsganalyzer.Recorder {
+	return &analysisRecorder{
+		store: store,
+	}
+}
@@ -10,6 +10,7 @@ type WasappMessage struct {
 	CustomerID    uuid.UUID      `json:"customer_id"`
 	ID            string         `json:"id"`
 	ChatID        string         `json:"chat_id"`
+	ChatName      string         `json:"chat_name"`
 	SenderName    string         `json:"sender_name"`
 	SenderNumber  string         `json:"sender_number"`
 	IsSenderMe    bool           `json:"is_sender_me"`
@@ -22,3 +23,8 @@ type Consumer interface {
 	Start(context.Context) error
 	Stop(context.Context) error
 }
+
+type Pruner interface {
+	Start(context.Context) error
+	Stop(context.Context) error
+}
@@ -0,0 +1,112 @@
+package wasappmsgconsumer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jadwalapp/symmetrical-spoon/falak/pkg/store"
+	wasappmsganalyzer "github.com/jadwalapp/symmetrical-spoon/falak/pkg/wasapp/msganalyzer"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultWindowMaxMessages = 50
+	defaultWindowMaxAge      = 72 * time.Hour
+)
+
+// ChatWindow limits the messages of a chat that are sent for analysis, the messages before the window are folded into
+// the chat's summary and deleted.
+type ChatWindow struct {
+	// MaxMessages is how many of the latest messages are in the window
+	MaxMessages int
+	// MaxAge is how long before the latest message the messages in the window can be
+	MaxAge time.Duration
+}
+
+// windowChatMessages returns the chat's summary along with the messages in the window, msgs must be ordered by their
+// timestamp. When summarizing fails the older messages are kept to be summarized with the next message, and so they
+// are when the customer is over their daily LLM quota, as summarizing uses the LLM.
+func (c *consumer) windowChatMessages(ctx context.Context, customerID uuid.UUID, chatID string, msgs []store.ListChatMessagesRow, overQuota bool) (string, []store.ListChatMessagesRow, error) {
+	encryptedSummary, err := c.store.GetChatSummary(ctx, store.GetChatSummaryParams{
+		ChatID:     chatID,
+		CustomerID: customerID,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return "", nil, fmt.Errorf("failed running GetChatSummary: %w", err)
+	}
+	decryptedSummary, err := c.encryptionSvc.DecryptNull(ctx, customerID, encryptedSummary)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to decrypt the chat summary: %w", err)
+	}
+	summary := decryptedSummary.String
+
+	older, recent := splitChatWindow(msgs, c.window)
+	if len(older) == 0 || overQuota {
+		return summary, recent, nil
+	}
+
+	msgsForSummary := make([]wasappmsganalyzer.MessageForAnalysis, len(older))
+	for idx, msg := range older {
+		msgsForSummary[idx] = mapListChatMessagesRowToMessageForAnalysis(msg)
+	}
+	summaryResp, err := c.msgSummarizer.SummarizeMessages(ctx, &wasappmsganalyzer.SummarizeMessagesRequest{
+		CustomerID: customerID,
+		Summary:    summary,
+		Messages:   msgsForSummary,
+	})
+	if err != nil {
+		log.Ctx(ctx).Err(err).
+			Str("chat_id", chatID).
+			Msg("failed running msgSummarizer.SummarizeMessages, keeping the older messages")
+		return summary, recent, nil
+	}
+
+	newSummary, err := c.encryptionSvc.Encrypt(ctx, customerID, summaryResp.Summary)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to encrypt the chat summary: %w", err)
+	}
+
+	err = c.store.SummarizeChat(ctx, store.SummarizeChatParams{
+		ChatID:          chatID,
+		CustomerID:      customerID,
+		Summary:         newSummary,
+		SummarizedUntil: recent[0].Timestamp,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed running SummarizeChat: %w", err)
+	}
+
+	log.Ctx(ctx).Debug().
+		Str("chat_id", chatID).
+		Int("summarized_count", len(older)).
+		Msg("summarized the messages before the window")
+
+	return summaryResp.Summary, recent, nil
+}
+
+// splitChatWindow splits the messages into the ones before the window and the ones in it. Messages sent at the same
+// second are never split, as the ones before the window are deleted by their timestamp.
+func splitChatWindow(msgs []store.ListChatMessagesRow, window ChatWindow) ([]store.ListChatMessagesRow, []store.ListChatMessagesRow) {
+	if len(msgs) == 0 {
+		return nil, msgs
+	}
+
+	start := 0
+	if window.MaxMessages > 0 && len(msgs) > window.MaxMessages {
+		start = len(msgs) - window.MaxMessages
+	}
+	if window.MaxAge > 0 {
+		oldestTimestamp := msgs[len(msgs)-1].Timestamp - int64(window.MaxAge.Seconds())
+		for start < len(msgs)-1 && msgs[start].Timestamp < oldestTimestamp {
+			start++
+		}
+	}
+	for start > 0 && msgs[start-1].Timestamp == msgs[start].Timestamp {
+		start--
+	}
+
+	return msgs[:start], msgs[start:]
+}
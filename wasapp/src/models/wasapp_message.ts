@@ -2,6 +2,7 @@ export interface WasappMessage {
   customer_id: string;
   id: string;
   chat_id: string;
+  chat_name: string;
   sender_name: string;
   sender_number: string;
   is_sender_me: boolean;
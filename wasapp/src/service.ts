@@ -147,6 +147,7 @@ export class WasappService {
             customer_id: customerId,
             id: quotedMsg.id._serialized,
             chat_id: quotedMsgChat.id._serialized,
+            chat_name: quotedMsgChat.name,
             sender_name: quotedMsgContact.name ?? quotedMsgContact.pushname,
             sender_number: quotedMsgContact.number,
             is_sender_me: quotedMsg.fromMe,
@@ -160,6 +161,7 @@ export class WasappService {
           customer_id: customerId,
           id: msg.id._serialized,
           chat_id: chat.id._serialized,
+          chat_name: chat.name,
           sender_name: contact.name ?? contact.pushname,
           sender_number: contact.number,
           is_sender_me: contact.isMe,